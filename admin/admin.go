@@ -8,7 +8,9 @@ import (
 	"lxtian-blog/admin/internal/config"
 	"lxtian-blog/admin/internal/handler"
 	"lxtian-blog/admin/internal/svc"
+	"lxtian-blog/common/pkg/utils"
 	"net/http"
+	"os"
 )
 
 var configFile = flag.String("f", "etc/admin-api.yaml", "the config file")
@@ -18,6 +20,8 @@ func main() {
 
 	var c config.Config
 	conf.MustLoad(*configFile, &c)
+	// 使用通用方法解析Etcd主机列表字符串
+	c.PaymentRpc.Etcd.Hosts = utils.ParseHosts(os.Getenv("ETCD_HOSTS"))
 
 	// server := rest.MustNewServer(c.RestConf)
 	// 解决跨域
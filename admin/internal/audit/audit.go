@@ -0,0 +1,290 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"time"
+
+	"lxtian-blog/common/model"
+	"lxtian-blog/common/pkg/utils"
+	"lxtian-blog/common/repository/admin_repo"
+
+	"github.com/zeromicro/go-zero/core/logc"
+	"gorm.io/gorm"
+)
+
+// maskedValue 敏感字段脱敏后的值
+const maskedValue = "******"
+
+// maxErrorMsgRunes 失败原因最大字数，与表字段长度一致
+const maxErrorMsgRunes = 512
+
+// sensitiveKeys 字段名包含这些关键字时脱敏，如密码、密钥、令牌
+var sensitiveKeys = []string{"password", "secret", "private", "token", "cert"}
+
+// Recorder 后台操作审计：记录写操作的操作人、路由、操作对象、变更前后的字段差异、IP 和结果
+type Recorder struct {
+	db   *gorm.DB
+	repo admin_repo.LxtAdminAuditLogsRepo
+}
+
+// NewRecorder 创建审计记录器
+func NewRecorder(db *gorm.DB) *Recorder {
+	return &Recorder{
+		db:   db,
+		repo: admin_repo.NewLxtAdminAuditLogsRepo(db),
+	}
+}
+
+// Operation 一次进行中的操作
+type Operation struct {
+	rec     *Recorder
+	log     *model.LxtAdminAuditLog
+	target  *target
+	request map[string]interface{}
+	before  interface{}
+	start   time.Time
+}
+
+// Begin 开始记录操作：解析操作人和操作对象，body 为请求体
+// snapshot 为 true 时记录操作对象变更前的快照，无权限的请求不查询操作对象
+func (rc *Recorder) Begin(r *http.Request, body []byte, snapshot bool) *Operation {
+	ctx := r.Context()
+	userId, _ := ctx.Value("user_id").(uint)
+	username, _ := ctx.Value("username").(string)
+	op := &Operation{
+		rec: rc,
+		log: &model.LxtAdminAuditLog{
+			UserID:   int64(userId),
+			Username: username,
+			Method:   r.Method,
+			Route:    r.URL.Path,
+			Path:     r.URL.Path,
+			ClientIP: utils.GetClientIP(r),
+		},
+		request: parseRequest(body),
+		start:   time.Now(),
+	}
+
+	match, ok := auditTargets.Lookup(r.Method, r.URL.Path)
+	if !ok {
+		return op
+	}
+	op.target = &match.Value
+	op.log.Route = match.Pattern
+	op.log.Entity = op.target.entity
+	if id, ok := match.Params[op.target.param]; ok {
+		op.log.EntityID = id
+	} else if id, ok := op.request[op.target.param]; ok && id != nil {
+		op.log.EntityID = fmt.Sprint(id)
+	}
+	if op.log.EntityID == "0" {
+		op.log.EntityID = "" // 新增操作的请求ID为0
+	}
+	if !snapshot {
+		return op
+	}
+
+	before, err := rc.snapshot(ctx, op.target, op.log.EntityID)
+	if err != nil {
+		logc.Errorf(ctx, "audit snapshot before error: route=%s, entity_id=%s, err=%s", op.log.Route, op.log.EntityID, err)
+	}
+	op.before = before
+	return op
+}
+
+// Finish 记录操作结果和变更后的快照并写入审计日志，写入失败只记录日志，不影响请求
+func (op *Operation) Finish(ctx context.Context, statusCode int, respBody []byte) {
+	op.log.StatusCode = int32(statusCode)
+	op.log.Duration = time.Since(op.start).Milliseconds()
+	op.log.Result, op.log.ErrorMsg = parseResult(statusCode, respBody)
+	if msg := []rune(op.log.ErrorMsg); len(msg) > maxErrorMsgRunes {
+		op.log.ErrorMsg = string(msg[:maxErrorMsgRunes])
+	}
+	op.log.Request = toJSON(maskValue(op.request))
+
+	// 操作失败时数据未变更，不记录差异
+	if op.target != nil && op.log.Result == 1 {
+		after, err := op.rec.snapshot(ctx, op.target, op.log.EntityID)
+		if err != nil {
+			logc.Errorf(ctx, "audit snapshot after error: route=%s, entity_id=%s, err=%s", op.log.Route, op.log.EntityID, err)
+		}
+		// 新增操作无法定位新记录，以请求参数作为变更后的内容
+		if op.log.EntityID == "" && op.target.table != "" {
+			after = op.request
+		}
+		before, after := diff(op.before, after)
+		op.log.BeforeData = toJSON(maskValue(before))
+		op.log.AfterData = toJSON(maskValue(after))
+	}
+
+	if err := op.rec.repo.CreateLog(ctx, op.log); err != nil {
+		logc.Errorf(ctx, "audit save log error: user_id=%d, %s %s, err=%s", op.log.UserID, op.log.Method, op.log.Path, err)
+	}
+}
+
+// snapshot 查询操作对象当前的数据，对象不存在时返回 nil
+func (rc *Recorder) snapshot(ctx context.Context, t *target, id string) (interface{}, error) {
+	if t.table == "" || id == "" {
+		return nil, nil
+	}
+	var rows []map[string]interface{}
+	db := rc.db.WithContext(ctx).Table(t.table).Where(fmt.Sprintf("`%s` = ?", t.column), id)
+	if !t.multi {
+		db = db.Limit(1)
+	}
+	if err := db.Find(&rows).Error; err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		for k, v := range row {
+			row[k] = normalize(v)
+		}
+	}
+	if t.multi {
+		return rows, nil
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+	return rows[0], nil
+}
+
+// diff 比较变更前后的快照，都是单行记录时只保留有变化的字段
+func diff(before, after interface{}) (interface{}, interface{}) {
+	b, bok := before.(map[string]interface{})
+	a, aok := after.(map[string]interface{})
+	if !bok || !aok {
+		if reflect.DeepEqual(before, after) {
+			return nil, nil
+		}
+		return before, after
+	}
+
+	changedBefore := make(map[string]interface{})
+	changedAfter := make(map[string]interface{})
+	for k, v := range a {
+		if old, ok := b[k]; !ok || !reflect.DeepEqual(old, v) {
+			changedBefore[k] = b[k]
+			changedAfter[k] = v
+		}
+	}
+	for k, v := range b {
+		if _, ok := a[k]; !ok {
+			changedBefore[k] = v
+			changedAfter[k] = nil
+		}
+	}
+	if len(changedAfter) == 0 {
+		return nil, nil
+	}
+	return changedBefore, changedAfter
+}
+
+// parseRequest 解析 JSON 请求体，非 JSON 对象（如文件上传）不记录请求参数
+func parseRequest(body []byte) map[string]interface{} {
+	if len(body) == 0 {
+		return nil
+	}
+	var data map[string]interface{}
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	if err := decoder.Decode(&data); err != nil {
+		return nil
+	}
+	return data
+}
+
+// parseResult 根据 HTTP 状态码和响应体中的业务码判断操作结果
+func parseResult(statusCode int, respBody []byte) (int32, string) {
+	var body struct {
+		Code *uint32 `json:"code"`
+		Msg  string  `json:"msg"`
+	}
+	_ = json.Unmarshal(respBody, &body)
+	if statusCode < http.StatusBadRequest && (body.Code == nil || *body.Code == 0) {
+		return 1, ""
+	}
+	if body.Msg == "" {
+		body.Msg = http.StatusText(statusCode)
+	}
+	return 0, body.Msg
+}
+
+// normalize 将查询结果转为可比较、可序列化的值
+func normalize(v interface{}) interface{} {
+	switch val := v.(type) {
+	case []byte:
+		return string(val)
+	case time.Time:
+		return val.Format("2006-01-02 15:04:05")
+	case *time.Time:
+		if val == nil {
+			return nil
+		}
+		return val.Format("2006-01-02 15:04:05")
+	default:
+		return v
+	}
+}
+
+// maskValue 递归脱敏敏感字段
+func maskValue(v interface{}) interface{} {
+	switch val := v.(type) {
+	case map[string]interface{}:
+		if val == nil {
+			return nil
+		}
+		masked := make(map[string]interface{}, len(val))
+		for k, item := range val {
+			if isSensitive(k) && item != nil && item != "" {
+				masked[k] = maskedValue
+				continue
+			}
+			masked[k] = maskValue(item)
+		}
+		return masked
+	case []map[string]interface{}:
+		masked := make([]interface{}, 0, len(val))
+		for _, item := range val {
+			masked = append(masked, maskValue(item))
+		}
+		return masked
+	case []interface{}:
+		masked := make([]interface{}, 0, len(val))
+		for _, item := range val {
+			masked = append(masked, maskValue(item))
+		}
+		return masked
+	default:
+		return v
+	}
+}
+
+func isSensitive(key string) bool {
+	key = strings.ToLower(key)
+	for _, s := range sensitiveKeys {
+		if strings.Contains(key, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// toJSON 序列化为 JSON 字符串，空值返回 nil
+func toJSON(v interface{}) *string {
+	if v == nil || reflect.ValueOf(v).IsZero() {
+		return nil
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil
+	}
+	s := string(data)
+	return &s
+}
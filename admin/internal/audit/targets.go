@@ -0,0 +1,53 @@
+package audit
+
+import (
+	"net/http"
+
+	"lxtian-blog/admin/internal/pathmatch"
+)
+
+// target 操作对象：对象类型、快照所在的表和列，以及对象ID在请求中的位置
+type target struct {
+	entity string // 对象类型
+	table  string // 快照所在的表，为空时不记录快照
+	column string // 按对象ID查询快照的列
+	param  string // 对象ID：路径参数或请求体字段名
+	multi  bool   // 快照为多行，如角色的权限列表
+}
+
+// auditTargets 后台写操作与操作对象的映射，未登记的写操作仍记录日志，但不记录操作对象和变更前后的快照
+var auditTargets = pathmatch.New(map[string]target{
+	// 内容管理
+	http.MethodPost + " /admin/article/save":           {entity: "article", table: "txy_article", column: "id", param: "id"},
+	http.MethodPost + " /admin/column/save":            {entity: "book", table: "txy_book", column: "id", param: "id"},
+	http.MethodPost + " /admin/book/chapter/save":      {entity: "chapter", table: "txy_chapter", column: "id", param: "id"},
+	http.MethodDelete + " /admin/book/chapter/:id":     {entity: "chapter", table: "txy_chapter", column: "id", param: "id"},
+	http.MethodPost + " /admin/book/chapter/data/save": {entity: "chapter_data", table: "txy_chapter_data", column: "id", param: "id"},
+	http.MethodPost + " /admin/docs/save":              {entity: "docs", table: "txy_docs", column: "id", param: "id"},
+	http.MethodDelete + " /admin/docs/:id":             {entity: "docs", table: "txy_docs", column: "id", param: "id"},
+	http.MethodPost + " /admin/tag/save":               {entity: "tag", table: "txy_tag", column: "id", param: "id"},
+	http.MethodDelete + " /admin/tag/:id":              {entity: "tag", table: "txy_tag", column: "id", param: "id"},
+	http.MethodPost + " /admin/upload":                 {entity: "file"},
+
+	// 支付管理
+	http.MethodPost + " /admin/payment/close-payment":                 {entity: "payment_order", table: "lxt_payment_orders", column: "payment_id", param: "payment_id"},
+	http.MethodPost + " /admin/payment/manual-refund":                 {entity: "payment_order", table: "lxt_payment_orders", column: "payment_id", param: "payment_id"},
+	http.MethodPost + " /admin/payment/resend-notify":                 {entity: "payment_notify", table: "lxt_payment_notifies", column: "notify_id", param: "notify_id"},
+	http.MethodPost + " /admin/payment/config/save":                   {entity: "payment_config", table: "lxt_payment_configs", column: "id", param: "id"},
+	http.MethodPost + " /admin/payment/coupon/save":                   {entity: "coupon", table: "lxt_payment_coupons", column: "id", param: "id"},
+	http.MethodDelete + " /admin/payment/coupon/:id":                  {entity: "coupon", table: "lxt_payment_coupons", column: "id", param: "id"},
+	http.MethodPost + " /admin/payment/gift-code/generate":            {entity: "gift_code"},
+	http.MethodPost + " /admin/payment/goods/list":                    {entity: "goods"},
+	http.MethodPost + " /admin/payment/goods/file/save":               {entity: "goods", table: "lxt_payment_goods", column: "id", param: "id"},
+	http.MethodPost + " /admin/payment/goods/review/status":           {entity: "goods_review", table: "lxt_payment_goods_reviews", column: "id", param: "id"},
+	http.MethodPost + " /admin/payment/refund-request/review":         {entity: "refund_request", table: "lxt_payment_refund_requests", column: "request_id", param: "request_id"},
+	http.MethodPost + " /admin/payment/reconcile/run":                 {entity: "reconcile"},
+	http.MethodPost + " /admin/payment/reconcile/discrepancy/resolve": {entity: "reconcile_discrepancy", table: "lxt_payment_reconcile_discrepancies", column: "id", param: "id"},
+
+	// 系统管理
+	http.MethodPost + " /admin/logout":            {entity: "session"},
+	http.MethodPost + " /admin/menu/save":         {entity: "menu", table: "txy_menu", column: "id", param: "id"},
+	http.MethodPost + " /admin/prem/save":         {entity: "role_permission", table: "txy_role_permissions", column: "role_id", param: "role_id", multi: true},
+	http.MethodPost + " /admin/user/save":         {entity: "user", table: "txy_user", column: "id", param: "id"},
+	http.MethodPost + " /admin/user/force-logout": {entity: "user", param: "user_id"},
+})
@@ -1,14 +1,19 @@
 package config
 
 import (
+	"lxtian-blog/common/pkg/jwts"
+
 	"github.com/zeromicro/go-zero/rest"
+	"github.com/zeromicro/go-zero/zrpc"
 )
 
 type Config struct {
 	rest.RestConf
 	Auth struct { // JWT 认证需要的密钥和过期时间配置
-		AccessSecret string `json:",env=ACCESS_SECRET"`
-		AccessExpire int64
+		AccessSecret  string          `json:",optional,env=ACCESS_SECRET"` // HS256 密钥，未配置签名密钥时用于签发，并用于验证不带 kid 的旧 token
+		AccessExpire  int64           // 访问令牌有效期（秒）
+		RefreshExpire int64           `json:",default=1209600"` // 刷新令牌有效期（秒），强制下线记录需保留到刷新令牌过期
+		KeySet        jwts.KeySetConf `json:",optional"`        // 非对称签名密钥集，支持按 kid 轮换
 	}
 	RedisConfig struct {
 		Host string `json:",env=REDIS_HOST"`
@@ -30,4 +35,5 @@ type Config struct {
 		Domain    string `json:",env=Domain"`
 		Region    string `json:",env=Region"`
 	}
+	PaymentRpc zrpc.RpcClientConf
 }
@@ -0,0 +1,28 @@
+package payment
+
+import (
+	"github.com/zeromicro/go-zero/core/logc"
+	"lxtian-blog/admin/internal/logic/payment"
+	"lxtian-blog/common/restful/response"
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+	"lxtian-blog/admin/internal/svc"
+	"lxtian-blog/admin/internal/types"
+)
+
+// 优惠券列表
+func CouponsHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.CouponsReq
+		if err := httpx.Parse(r, &req); err != nil {
+			logc.Errorf(r.Context(), "CouponsHandler error message: %s", err)
+			response.Response(r, w, nil, err)
+			return
+		}
+
+		l := payment.NewCouponsLogic(r.Context(), svcCtx)
+		resp, err := l.Coupons(&req)
+		response.Response(r, w, resp, err)
+	}
+}
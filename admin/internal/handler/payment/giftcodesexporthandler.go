@@ -0,0 +1,39 @@
+package payment
+
+import (
+	"fmt"
+	"github.com/zeromicro/go-zero/core/logc"
+	"lxtian-blog/admin/internal/logic/payment"
+	"lxtian-blog/common/restful/response"
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+	"lxtian-blog/admin/internal/svc"
+	"lxtian-blog/admin/internal/types"
+)
+
+// 礼品码导出，成功时直接输出 CSV 文件，失败时返回统一的错误响应
+func GiftCodesExportHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.GiftCodesExportReq
+		if err := httpx.Parse(r, &req); err != nil {
+			logc.Errorf(r.Context(), "GiftCodesExportHandler error message: %s", err)
+			response.Response(r, w, nil, err)
+			return
+		}
+
+		l := payment.NewGiftCodesExportLogic(r.Context(), svcCtx)
+		data, filename, err := l.GiftCodesExport(&req)
+		if err != nil {
+			response.Response(r, w, nil, err)
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write(data); err != nil {
+			logc.Errorf(r.Context(), "GiftCodesExportHandler write error: %s", err)
+		}
+	}
+}
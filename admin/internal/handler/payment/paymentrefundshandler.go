@@ -1,7 +1,9 @@
 package payment
 
 import (
+	"github.com/zeromicro/go-zero/core/logc"
 	"lxtian-blog/admin/internal/logic/payment"
+	"lxtian-blog/common/restful/response"
 	"net/http"
 
 	"github.com/zeromicro/go-zero/rest/httpx"
@@ -14,16 +16,13 @@ func PaymentRefundsHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		var req types.PaymentRefundsReq
 		if err := httpx.Parse(r, &req); err != nil {
-			httpx.ErrorCtx(r.Context(), w, err)
+			logc.Errorf(r.Context(), "PaymentRefundsHandler error message: %s", err)
+			response.Response(r, w, nil, err)
 			return
 		}
 
 		l := payment.NewPaymentRefundsLogic(r.Context(), svcCtx)
 		resp, err := l.PaymentRefunds(&req)
-		if err != nil {
-			httpx.ErrorCtx(r.Context(), w, err)
-		} else {
-			httpx.OkJsonCtx(r.Context(), w, resp)
-		}
+		response.Response(r, w, resp, err)
 	}
 }
@@ -0,0 +1,28 @@
+package payment
+
+import (
+	"github.com/zeromicro/go-zero/core/logc"
+	"lxtian-blog/admin/internal/logic/payment"
+	"lxtian-blog/common/restful/response"
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+	"lxtian-blog/admin/internal/svc"
+	"lxtian-blog/admin/internal/types"
+)
+
+// 对账差异处理
+func ReconcileDiscrepancyResolveHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.ReconcileDiscrepancyResolveReq
+		if err := httpx.Parse(r, &req); err != nil {
+			logc.Errorf(r.Context(), "ReconcileDiscrepancyResolveHandler error message: %s", err)
+			response.Response(r, w, nil, err)
+			return
+		}
+
+		l := payment.NewReconcileDiscrepancyResolveLogic(r.Context(), svcCtx)
+		resp, err := l.ReconcileDiscrepancyResolve(&req)
+		response.Response(r, w, resp, err)
+	}
+}
@@ -17,7 +17,7 @@ import (
 func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 	server.AddRoutes(
 		rest.WithMiddlewares(
-			[]rest.Middleware{serverCtx.JwtMiddleware},
+			[]rest.Middleware{serverCtx.JwtMiddleware, serverCtx.AuditMiddleware, serverCtx.PermissionMiddleware},
 			[]rest.Route{
 				{
 					// 文章详情
@@ -146,7 +146,7 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 
 	server.AddRoutes(
 		rest.WithMiddlewares(
-			[]rest.Middleware{serverCtx.JwtMiddleware},
+			[]rest.Middleware{serverCtx.JwtMiddleware, serverCtx.AuditMiddleware, serverCtx.PermissionMiddleware},
 			[]rest.Route{
 				{
 					// 关闭支付订单
@@ -166,12 +166,66 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 					Path:    "/configs",
 					Handler: payment.PaymentConfigsHandler(serverCtx),
 				},
+				{
+					// 优惠券删除
+					Method:  http.MethodDelete,
+					Path:    "/coupon/:id",
+					Handler: payment.CouponDelHandler(serverCtx),
+				},
+				{
+					// 优惠券保存
+					Method:  http.MethodPost,
+					Path:    "/coupon/save",
+					Handler: payment.CouponSaveHandler(serverCtx),
+				},
+				{
+					// 优惠券列表
+					Method:  http.MethodGet,
+					Path:    "/coupons",
+					Handler: payment.CouponsHandler(serverCtx),
+				},
+				{
+					// 礼品码生成
+					Method:  http.MethodPost,
+					Path:    "/gift-code/generate",
+					Handler: payment.GiftCodeGenerateHandler(serverCtx),
+				},
+				{
+					// 礼品码列表
+					Method:  http.MethodGet,
+					Path:    "/gift-codes",
+					Handler: payment.GiftCodesHandler(serverCtx),
+				},
+				{
+					// 礼品码导出
+					Method:  http.MethodGet,
+					Path:    "/gift-codes/export",
+					Handler: payment.GiftCodesExportHandler(serverCtx),
+				},
+				{
+					// 商品文件设置
+					Method:  http.MethodPost,
+					Path:    "/goods/file/save",
+					Handler: payment.GoodsFileSaveHandler(serverCtx),
+				},
 				{
 					// 商品管理
 					Method:  http.MethodPost,
 					Path:    "/goods/list",
 					Handler: payment.GoodsListHandler(serverCtx),
 				},
+				{
+					// 商品评价隐藏/展示
+					Method:  http.MethodPost,
+					Path:    "/goods/review/status",
+					Handler: payment.GoodsReviewStatusHandler(serverCtx),
+				},
+				{
+					// 商品评价列表
+					Method:  http.MethodGet,
+					Path:    "/goods/reviews",
+					Handler: payment.GoodsReviewsHandler(serverCtx),
+				},
 				{
 					// 手动退款
 					Method:  http.MethodPost,
@@ -202,12 +256,48 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 					Path:    "/orders",
 					Handler: payment.PaymentOrdersHandler(serverCtx),
 				},
+				{
+					// 对账批次列表
+					Method:  http.MethodGet,
+					Path:    "/reconcile/batches",
+					Handler: payment.ReconcileBatchesHandler(serverCtx),
+				},
+				{
+					// 对账差异列表
+					Method:  http.MethodGet,
+					Path:    "/reconcile/discrepancies",
+					Handler: payment.ReconcileDiscrepanciesHandler(serverCtx),
+				},
+				{
+					// 对账差异处理
+					Method:  http.MethodPost,
+					Path:    "/reconcile/discrepancy/resolve",
+					Handler: payment.ReconcileDiscrepancyResolveHandler(serverCtx),
+				},
+				{
+					// 手动对账
+					Method:  http.MethodPost,
+					Path:    "/reconcile/run",
+					Handler: payment.ReconcileRunHandler(serverCtx),
+				},
 				{
 					// 退款记录管理
 					Method:  http.MethodGet,
 					Path:    "/refunds",
 					Handler: payment.PaymentRefundsHandler(serverCtx),
 				},
+				{
+					// 退款申请审批
+					Method:  http.MethodPost,
+					Path:    "/refund-request/review",
+					Handler: payment.RefundRequestReviewHandler(serverCtx),
+				},
+				{
+					// 退款申请列表
+					Method:  http.MethodGet,
+					Path:    "/refund-requests",
+					Handler: payment.RefundRequestsHandler(serverCtx),
+				},
 				{
 					// 重发支付通知
 					Method:  http.MethodPost,
@@ -233,20 +323,38 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 				Path:    "/login",
 				Handler: user.LoginHandler(serverCtx),
 			},
+			{
+				// 刷新令牌
+				Method:  http.MethodPost,
+				Path:    "/token/refresh",
+				Handler: user.TokenRefreshHandler(serverCtx),
+			},
 		},
 		rest.WithPrefix("/admin"),
 	)
 
 	server.AddRoutes(
 		rest.WithMiddlewares(
-			[]rest.Middleware{serverCtx.JwtMiddleware},
+			[]rest.Middleware{serverCtx.JwtMiddleware, serverCtx.AuditMiddleware, serverCtx.PermissionMiddleware},
 			[]rest.Route{
+				{
+					// 审计日志
+					Method:  http.MethodGet,
+					Path:    "/audit/logs",
+					Handler: user.AuditLogsHandler(serverCtx),
+				},
 				{
 					// 用户信息
 					Method:  http.MethodGet,
 					Path:    "/info",
 					Handler: user.InfoHandler(serverCtx),
 				},
+				{
+					// 退出登录
+					Method:  http.MethodPost,
+					Path:    "/logout",
+					Handler: user.LogoutHandler(serverCtx),
+				},
 				{
 					// 菜单保存
 					Method:  http.MethodPost,
@@ -271,6 +379,12 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 					Path:    "/roles",
 					Handler: user.RolesHandler(serverCtx),
 				},
+				{
+					// 强制用户下线
+					Method:  http.MethodPost,
+					Path:    "/user/force-logout",
+					Handler: user.UserForceLogoutHandler(serverCtx),
+				},
 				{
 					// 用户保存
 					Method:  http.MethodPost,
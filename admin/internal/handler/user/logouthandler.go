@@ -0,0 +1,22 @@
+package user
+
+import (
+	"lxtian-blog/admin/internal/logic/user"
+	"lxtian-blog/common/restful/response"
+	"net/http"
+
+	"lxtian-blog/admin/internal/svc"
+)
+
+// 退出登录
+func LogoutHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		l := user.NewLogoutLogic(r.Context(), svcCtx)
+		resp, err := l.Logout()
+		if err != nil {
+			response.Response(r, w, nil, err)
+		} else {
+			response.Response(r, w, resp.Data, err)
+		}
+	}
+}
@@ -0,0 +1,28 @@
+package user
+
+import (
+	"github.com/zeromicro/go-zero/core/logc"
+	"lxtian-blog/admin/internal/logic/user"
+	"lxtian-blog/common/restful/response"
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+	"lxtian-blog/admin/internal/svc"
+	"lxtian-blog/admin/internal/types"
+)
+
+// 刷新令牌
+func TokenRefreshHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.TokenRefreshReq
+		if err := httpx.Parse(r, &req); err != nil {
+			logc.Errorf(r.Context(), "TokenRefreshHandler error message: %s", err)
+			response.Response(r, w, nil, err)
+			return
+		}
+
+		l := user.NewTokenRefreshLogic(r.Context(), svcCtx)
+		resp, err := l.TokenRefresh(&req)
+		response.Response(r, w, resp, err)
+	}
+}
@@ -0,0 +1,30 @@
+package user
+
+import (
+	"lxtian-blog/common/restful/response"
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+	"lxtian-blog/admin/internal/logic/user"
+	"lxtian-blog/admin/internal/svc"
+	"lxtian-blog/admin/internal/types"
+)
+
+// 强制用户下线
+func UserForceLogoutHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.UserForceLogoutReq
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := user.NewUserForceLogoutLogic(r.Context(), svcCtx)
+		resp, err := l.UserForceLogout(&req)
+		if err != nil {
+			response.Response(r, w, nil, err)
+		} else {
+			response.Response(r, w, resp.Data, err)
+		}
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"gorm.io/gorm"
 	"lxtian-blog/admin/internal/svc"
 	"lxtian-blog/admin/internal/types"
+	"lxtian-blog/common/constant"
 	"lxtian-blog/common/pkg/model/mysql"
 	"strings"
 
@@ -46,6 +47,9 @@ func (l *ArticleLogic) Article(req *types.ArticleReq) (resp *types.ArticleResp,
 		path = l.svcCtx.QiniuClient.PrivateURL(path, 3600)
 	}
 	result["path"] = path
+	if result["required_permission"], err = contentPermissionKey(l.ctx, l.svcCtx, constant.ContentTypeArticle, int64(req.Id)); err != nil {
+		return nil, err
+	}
 	resp.Data = result
 	return
 }
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"encoding/json"
 	"fmt"
+	"lxtian-blog/common/constant"
 	"lxtian-blog/common/pkg/model/mysql"
 	"lxtian-blog/common/pkg/utils"
 	"time"
@@ -31,6 +32,10 @@ func NewArticleSaveLogic(ctx context.Context, svcCtx *svc.ServiceContext) *Artic
 }
 
 func (l *ArticleSaveLogic) ArticleSave(req *types.ArticleSaveReq) (resp *types.ArticleSaveResp, err error) {
+	permissionKey, err := checkContentPermission(l.ctx, l.svcCtx, req.RequiredPermission)
+	if err != nil {
+		return nil, err
+	}
 	db := l.svcCtx.DB
 
 	// 开启事务
@@ -90,6 +95,11 @@ func (l *ArticleSaveLogic) ArticleSave(req *types.ArticleSaveReq) (resp *types.A
 		return nil, err
 	}
 
+	// 保存会员专享权限设置
+	if err = saveContentPermission(l.ctx, l.svcCtx, constant.ContentTypeArticle, int64(data.Id), permissionKey); err != nil {
+		return nil, err
+	}
+
 	// 4. 删除文章缓存
 	cacheUtil := utils.NewCacheUtil(l.svcCtx.Rds)
 	if err = cacheUtil.DeleteArticleCache(l.ctx, data.Id); err != nil {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"lxtian-blog/common/constant"
 	"lxtian-blog/common/pkg/model/mysql"
 	"lxtian-blog/common/pkg/utils"
 	"time"
@@ -34,6 +35,10 @@ func (l *BookChapterDataSaveLogic) BookChapterDataSave(req *types.BookChapterDat
 	if req.Id == 0 {
 		return nil, fmt.Errorf("章节ID不能为空")
 	}
+	permissionKey, err := checkContentPermission(l.ctx, l.svcCtx, req.RequiredPermission)
+	if err != nil {
+		return nil, err
+	}
 
 	now := time.Now()
 	data := mysql.TxyChapterData{
@@ -81,6 +86,10 @@ func (l *BookChapterDataSaveLogic) BookChapterDataSave(req *types.BookChapterDat
 			l.Infof("章节记录不存在，已自动插入: id=%d", req.Id)
 		}
 	}
+	// 保存会员专享权限设置
+	if err = saveContentPermission(l.ctx, l.svcCtx, constant.ContentTypeChapter, req.Id, permissionKey); err != nil {
+		return nil, err
+	}
 	// 删除缓存（如果 Redis 可用）
 	if l.svcCtx.Rds != nil {
 		cacheUtil := utils.NewCacheUtil(l.svcCtx.Rds)
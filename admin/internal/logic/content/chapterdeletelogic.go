@@ -6,6 +6,8 @@ import (
 
 	"lxtian-blog/admin/internal/svc"
 	"lxtian-blog/admin/internal/types"
+	"lxtian-blog/common/constant"
+	"lxtian-blog/common/model"
 	"lxtian-blog/common/pkg/model/mysql"
 	"lxtian-blog/common/pkg/utils"
 
@@ -58,7 +60,10 @@ func (l *ChapterDeleteLogic) ChapterDelete(req *types.ChapterDeleteReq) (resp *t
 		if chapterTx.RowsAffected == 0 {
 			return gorm.ErrRecordNotFound
 		}
-		return nil
+
+		// 删除章节的会员专享权限设置
+		return tx.Where("content_type = ? AND content_id = ?", constant.ContentTypeChapter, req.Id).
+			Delete(&model.LxtContentPermission{}).Error
 	})
 
 	if err != nil {
@@ -6,6 +6,7 @@ import (
 	"gorm.io/gorm"
 	"lxtian-blog/admin/internal/svc"
 	"lxtian-blog/admin/internal/types"
+	"lxtian-blog/common/constant"
 	"lxtian-blog/common/pkg/model/mysql"
 
 	"github.com/zeromicro/go-zero/core/logx"
@@ -40,6 +41,9 @@ func (l *ChapterDetailLogic) ChapterDetail(req *types.ChapterDetailReq) (resp *t
 		}
 		return nil, err // 其他数据库错误
 	}
+	if result["required_permission"], err = contentPermissionKey(l.ctx, l.svcCtx, constant.ContentTypeChapter, int64(req.Id)); err != nil {
+		return nil, err
+	}
 	resp.Data = result
 
 	return
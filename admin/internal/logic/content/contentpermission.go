@@ -0,0 +1,37 @@
+package content
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"lxtian-blog/admin/internal/svc"
+	"lxtian-blog/common/repository/user_repo"
+	"lxtian-blog/common/repository/web_repo"
+)
+
+// checkContentPermission 校验内容所需的会员权限标识，须为已在会员类型中配置的权限，为空表示不限制
+func checkContentPermission(ctx context.Context, svcCtx *svc.ServiceContext, permissionKey string) (string, error) {
+	permissionKey = strings.TrimSpace(permissionKey)
+	if permissionKey == "" {
+		return "", nil
+	}
+	exists, err := user_repo.NewMembershipPermissionRepository(svcCtx.DB).PermissionKeyExists(ctx, permissionKey)
+	if err != nil {
+		return "", err
+	}
+	if !exists {
+		return "", fmt.Errorf("会员权限标识不存在: %s", permissionKey)
+	}
+	return permissionKey, nil
+}
+
+// contentPermissionKey 查询内容所需的会员权限标识，未设置时返回空字符串
+func contentPermissionKey(ctx context.Context, svcCtx *svc.ServiceContext, contentType string, contentId int64) (string, error) {
+	return web_repo.NewContentPermissionRepository(svcCtx.DB).GetPermissionKey(ctx, contentType, contentId)
+}
+
+// saveContentPermission 保存内容所需的会员权限标识，为空时取消限制
+func saveContentPermission(ctx context.Context, svcCtx *svc.ServiceContext, contentType string, contentId int64, permissionKey string) error {
+	return web_repo.NewContentPermissionRepository(svcCtx.DB).SetPermissionKey(ctx, contentType, contentId, permissionKey)
+}
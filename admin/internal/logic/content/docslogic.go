@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"lxtian-blog/admin/internal/svc"
 	"lxtian-blog/admin/internal/types"
+	"lxtian-blog/common/constant"
 	"lxtian-blog/common/pkg/utils"
 	"lxtian-blog/common/repository/web_repo"
 	"strings"
@@ -55,7 +56,17 @@ func (l *DocsLogic) Docs(req *types.DocsReq) (resp *types.DocsResp, err error) {
 	if err != nil {
 		return nil, err
 	}
+	// 会员专享权限设置
+	docIds := make([]int64, 0, len(result))
+	for _, doc := range result {
+		docIds = append(docIds, int64(doc.ID))
+	}
+	permissionKeys, err := web_repo.NewContentPermissionRepository(l.svcCtx.DB).GetPermissionKeys(l.ctx, constant.ContentTypeDocs, docIds)
+	if err != nil {
+		return nil, err
+	}
 	for k, item := range list {
+		item["required_permission"] = permissionKeys[int64(result[k].ID)]
 		// tags 可能是 json 字符串
 		switch v := item["tags"].(type) {
 		case string:
@@ -3,6 +3,7 @@ package content
 import (
 	"context"
 	"encoding/json"
+	"lxtian-blog/common/constant"
 	"lxtian-blog/common/model"
 	"lxtian-blog/common/repository/web_repo"
 	"time"
@@ -29,6 +30,10 @@ func NewDocsSaveLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DocsSave
 }
 
 func (l *DocsSaveLogic) DocsSave(req *types.DocsSaveReq) (resp *types.DocsSaveResp, err error) {
+	permissionKey, err := checkContentPermission(l.ctx, l.svcCtx, req.RequiredPermission)
+	if err != nil {
+		return nil, err
+	}
 	repo := web_repo.NewTxyDocsRepository(l.svcCtx.DB)
 
 	// 序列化 tags 为 JSON 字符串
@@ -105,6 +110,15 @@ func (l *DocsSaveLogic) DocsSave(req *types.DocsSaveReq) (resp *types.DocsSaveRe
 		}
 	}
 
+	// 保存会员专享权限设置
+	docId := int64(data.ID)
+	if req.Id != 0 {
+		docId = req.Id
+	}
+	if err = saveContentPermission(l.ctx, l.svcCtx, constant.ContentTypeDocs, docId, permissionKey); err != nil {
+		return nil, err
+	}
+
 	resp = &types.DocsSaveResp{
 		Data: true,
 	}
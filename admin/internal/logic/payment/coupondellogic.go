@@ -0,0 +1,45 @@
+package payment
+
+import (
+	"context"
+	"fmt"
+
+	"lxtian-blog/admin/internal/svc"
+	"lxtian-blog/admin/internal/types"
+	"lxtian-blog/common/repository/payment_repo"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CouponDelLogic struct {
+	logx.Logger
+	ctx           context.Context
+	svcCtx        *svc.ServiceContext
+	couponService payment_repo.LxtPaymentCouponsRepo
+}
+
+// 优惠券删除
+func NewCouponDelLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CouponDelLogic {
+	return &CouponDelLogic{
+		Logger:        logx.WithContext(ctx),
+		ctx:           ctx,
+		svcCtx:        svcCtx,
+		couponService: payment_repo.NewLxtPaymentCouponsRepo(svcCtx.DB),
+	}
+}
+
+// CouponDel 软删除优惠券，删除后优惠码不可再使用，已下单的订单仍按原优惠金额支付
+func (l *CouponDelLogic) CouponDel(req *types.CouponDelReq) (resp *types.CouponDelResp, err error) {
+	if req.Id <= 0 {
+		return nil, fmt.Errorf("优惠券ID不能为空")
+	}
+
+	if err = l.couponService.Delete(l.ctx, uint64(req.Id)); err != nil {
+		l.Errorf("Failed to delete coupon: id=%d, err=%v", req.Id, err)
+		return nil, err
+	}
+
+	return &types.CouponDelResp{
+		Data: true,
+	}, nil
+}
@@ -0,0 +1,189 @@
+package payment
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"lxtian-blog/admin/internal/svc"
+	"lxtian-blog/admin/internal/types"
+	"lxtian-blog/common/constant"
+	"lxtian-blog/common/model"
+	"lxtian-blog/common/pkg/utils"
+	"lxtian-blog/common/repository/payment_repo"
+
+	"github.com/shopspring/decimal"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// couponCodeMaxLen 优惠码最大长度
+const couponCodeMaxLen = 32
+
+type CouponSaveLogic struct {
+	logx.Logger
+	ctx           context.Context
+	svcCtx        *svc.ServiceContext
+	couponService payment_repo.LxtPaymentCouponsRepo
+}
+
+// 优惠券保存
+func NewCouponSaveLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CouponSaveLogic {
+	return &CouponSaveLogic{
+		Logger:        logx.WithContext(ctx),
+		ctx:           ctx,
+		svcCtx:        svcCtx,
+		couponService: payment_repo.NewLxtPaymentCouponsRepo(svcCtx.DB),
+	}
+}
+
+// CouponSave 新增或更新优惠券，已使用次数由支付服务维护，更新时不修改
+func (l *CouponSaveLogic) CouponSave(req *types.CouponSaveReq) (resp *types.CouponSaveResp, err error) {
+	coupon, err := buildCoupon(req)
+	if err != nil {
+		return nil, err
+	}
+
+	exists, err := l.couponService.CodeExists(l.ctx, coupon.Code, req.Id)
+	if err != nil {
+		l.Errorf("Failed to check coupon code: code=%s, err=%v", coupon.Code, err)
+		return nil, fmt.Errorf("failed to check coupon code: %w", err)
+	}
+	if exists {
+		return nil, fmt.Errorf("优惠码 %s 已存在", coupon.Code)
+	}
+
+	if req.Id == 0 {
+		// 新增
+		if err = l.couponService.Create(l.ctx, coupon); err != nil {
+			l.Errorf("Failed to create coupon: code=%s, err=%v", coupon.Code, err)
+			return nil, err
+		}
+	} else {
+		// 更新
+		if _, err = l.couponService.GetByID(l.ctx, uint64(req.Id)); err != nil {
+			return nil, fmt.Errorf("优惠券不存在")
+		}
+		coupon.ID = req.Id
+		err = l.couponService.UpdateByCondition(l.ctx, map[string]interface{}{"id = ?": req.Id}, map[string]interface{}{
+			"code":                coupon.Code,
+			"name":                coupon.Name,
+			"type":                coupon.Type,
+			"value":               coupon.Value,
+			"max_discount":        coupon.MaxDiscount,
+			"min_amount":          coupon.MinAmount,
+			"buy_type":            coupon.BuyType,
+			"membership_type_id":  coupon.MembershipTypeID,
+			"classify_id":         coupon.ClassifyID,
+			"first_purchase_only": coupon.FirstPurchaseOnly,
+			"total_limit":         coupon.TotalLimit,
+			"per_user_limit":      coupon.PerUserLimit,
+			"start_time":          coupon.StartTime,
+			"end_time":            coupon.EndTime,
+			"status":              coupon.Status,
+			"remark":              coupon.Remark,
+		})
+		if err != nil {
+			l.Errorf("Failed to update coupon: id=%d, err=%v", req.Id, err)
+			return nil, err
+		}
+	}
+
+	l.Infof("Saved coupon: id=%d, code=%s, type=%s, value=%s", coupon.ID, coupon.Code, coupon.Type, coupon.Value.String())
+
+	return &types.CouponSaveResp{
+		Data: true,
+		Id:   coupon.ID,
+	}, nil
+}
+
+// buildCoupon 校验请求参数并转换为优惠券记录
+func buildCoupon(req *types.CouponSaveReq) (*model.LxtPaymentCoupon, error) {
+	code := strings.ToUpper(strings.TrimSpace(req.Code))
+	if code == "" {
+		return nil, fmt.Errorf("优惠码不能为空")
+	}
+	if len(code) > couponCodeMaxLen {
+		return nil, fmt.Errorf("优惠码长度不能超过%d", couponCodeMaxLen)
+	}
+	if strings.TrimSpace(req.Name) == "" {
+		return nil, fmt.Errorf("优惠券名称不能为空")
+	}
+
+	value := utils.AmountFromFloat(req.Value)
+	switch req.Type {
+	case constant.CouponTypePercent:
+		if !value.IsPositive() || value.GreaterThan(decimal.NewFromInt(100)) {
+			return nil, fmt.Errorf("折扣券减免百分比必须在0到100之间")
+		}
+	case constant.CouponTypeFixed:
+		if !value.IsPositive() {
+			return nil, fmt.Errorf("立减金额必须大于0")
+		}
+	default:
+		return nil, fmt.Errorf("优惠类型只能为 %s 或 %s", constant.CouponTypePercent, constant.CouponTypeFixed)
+	}
+	if req.MaxDiscount < 0 || req.MinAmount < 0 {
+		return nil, fmt.Errorf("金额不能为负数")
+	}
+	if req.TotalLimit < 0 || req.PerUserLimit < 0 {
+		return nil, fmt.Errorf("使用次数上限不能为负数")
+	}
+
+	switch req.BuyType {
+	case 0, constant.BuyTypeMembership, constant.BuyTypeGoods:
+	default:
+		return nil, fmt.Errorf("优惠券只适用于会员或商品订单")
+	}
+	if req.MembershipTypeId > 0 && req.BuyType != constant.BuyTypeMembership {
+		return nil, fmt.Errorf("限定会员类型时适用购买类型须为会员")
+	}
+	if req.ClassifyId > 0 && req.BuyType != constant.BuyTypeGoods {
+		return nil, fmt.Errorf("限定商品分类时适用购买类型须为商品")
+	}
+	if req.Status != constant.CouponStatusEnabled && req.Status != constant.CouponStatusDisabled {
+		return nil, fmt.Errorf("状态只能为0或1")
+	}
+
+	coupon := &model.LxtPaymentCoupon{
+		Code:             code,
+		Name:             strings.TrimSpace(req.Name),
+		Type:             req.Type,
+		Value:            value,
+		MaxDiscount:      utils.AmountFromFloat(req.MaxDiscount),
+		MinAmount:        utils.AmountFromFloat(req.MinAmount),
+		BuyType:          int32(req.BuyType),
+		MembershipTypeID: req.MembershipTypeId,
+		ClassifyID:       int32(req.ClassifyId),
+		TotalLimit:       int32(req.TotalLimit),
+		PerUserLimit:     int32(req.PerUserLimit),
+		Status:           int32(req.Status),
+		Remark:           req.Remark,
+	}
+	if req.FirstPurchaseOnly {
+		coupon.FirstPurchaseOnly = 1
+	}
+
+	if req.StartTime != "" {
+		start, err := parseQueryTime(req.StartTime)
+		if err != nil {
+			return nil, fmt.Errorf("生效时间格式错误: %s", req.StartTime)
+		}
+		coupon.StartTime = &start
+	}
+	if req.EndTime != "" {
+		end, err := parseQueryTime(req.EndTime)
+		if err != nil {
+			return nil, fmt.Errorf("过期时间格式错误: %s", req.EndTime)
+		}
+		if len(req.EndTime) == len("2006-01-02") {
+			end = end.Add(24*time.Hour - time.Second)
+		}
+		coupon.EndTime = &end
+	}
+	if coupon.StartTime != nil && coupon.EndTime != nil && !coupon.EndTime.After(*coupon.StartTime) {
+		return nil, fmt.Errorf("过期时间必须晚于生效时间")
+	}
+
+	return coupon, nil
+}
@@ -0,0 +1,104 @@
+package payment
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"lxtian-blog/admin/internal/svc"
+	"lxtian-blog/admin/internal/types"
+	"lxtian-blog/common/model"
+	"lxtian-blog/common/repository/payment_repo"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CouponsLogic struct {
+	logx.Logger
+	ctx           context.Context
+	svcCtx        *svc.ServiceContext
+	couponService payment_repo.LxtPaymentCouponsRepo
+}
+
+// 优惠券列表
+func NewCouponsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CouponsLogic {
+	return &CouponsLogic{
+		Logger:        logx.WithContext(ctx),
+		ctx:           ctx,
+		svcCtx:        svcCtx,
+		couponService: payment_repo.NewLxtPaymentCouponsRepo(svcCtx.DB),
+	}
+}
+
+func (l *CouponsLogic) Coupons(req *types.CouponsReq) (resp *types.CouponsResp, err error) {
+	// 参数验证
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.PageSize <= 0 {
+		req.PageSize = 10
+	}
+	if req.PageSize > 100 {
+		req.PageSize = 100 // 限制最大每页数量
+	}
+
+	// 构建查询条件
+	condition := make(map[string]interface{})
+	if req.Code != "" {
+		condition["code = ?"] = strings.ToUpper(strings.TrimSpace(req.Code))
+	}
+	if req.Status >= 0 {
+		condition["status = ?"] = req.Status
+	}
+
+	coupons, total, err := l.couponService.GetList(l.ctx, condition, req.Page, req.PageSize, "id desc", req.Name, "name")
+	if err != nil {
+		l.Errorf("Failed to get coupons: %v", err)
+		return nil, fmt.Errorf("failed to get coupons: %w", err)
+	}
+
+	list := make([]map[string]interface{}, 0, len(coupons))
+	for _, coupon := range coupons {
+		list = append(list, buildCouponItem(coupon))
+	}
+
+	return &types.CouponsResp{
+		Page:     req.Page,
+		PageSize: req.PageSize,
+		Total:    total,
+		List:     list,
+	}, nil
+}
+
+// 构建优惠券项
+func buildCouponItem(coupon *model.LxtPaymentCoupon) map[string]interface{} {
+	item := map[string]interface{}{
+		"id":                  coupon.ID,
+		"code":                coupon.Code,
+		"name":                coupon.Name,
+		"type":                coupon.Type,
+		"value":               coupon.Value,
+		"max_discount":        coupon.MaxDiscount,
+		"min_amount":          coupon.MinAmount,
+		"buy_type":            coupon.BuyType,
+		"membership_type_id":  coupon.MembershipTypeID,
+		"classify_id":         coupon.ClassifyID,
+		"first_purchase_only": coupon.FirstPurchaseOnly == 1,
+		"total_limit":         coupon.TotalLimit,
+		"per_user_limit":      coupon.PerUserLimit,
+		"used_count":          coupon.UsedCount,
+		"status":              coupon.Status,
+		"remark":              coupon.Remark,
+		"created_at":          coupon.CreatedAt.Format("2006-01-02 15:04:05"),
+		"updated_at":          coupon.UpdatedAt.Format("2006-01-02 15:04:05"),
+	}
+
+	if coupon.StartTime != nil {
+		item["start_time"] = coupon.StartTime.Format("2006-01-02 15:04:05")
+	}
+	if coupon.EndTime != nil {
+		item["end_time"] = coupon.EndTime.Format("2006-01-02 15:04:05")
+	}
+
+	return item
+}
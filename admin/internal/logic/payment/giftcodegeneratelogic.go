@@ -0,0 +1,135 @@
+package payment
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"lxtian-blog/admin/internal/svc"
+	"lxtian-blog/admin/internal/types"
+	"lxtian-blog/common/constant"
+	"lxtian-blog/common/model"
+	"lxtian-blog/common/pkg/utils"
+	"lxtian-blog/common/repository/payment_repo"
+	"lxtian-blog/common/repository/user_repo"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// giftCodeMaxBatchSize 单批次最多生成的礼品码数量
+const giftCodeMaxBatchSize = 1000
+
+type GiftCodeGenerateLogic struct {
+	logx.Logger
+	ctx             context.Context
+	svcCtx          *svc.ServiceContext
+	giftCodeService payment_repo.LxtPaymentGiftCodesRepo
+}
+
+// 礼品码生成
+func NewGiftCodeGenerateLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GiftCodeGenerateLogic {
+	return &GiftCodeGenerateLogic{
+		Logger:          logx.WithContext(ctx),
+		ctx:             ctx,
+		svcCtx:          svcCtx,
+		giftCodeService: payment_repo.NewLxtPaymentGiftCodesRepo(svcCtx.DB),
+	}
+}
+
+// GiftCodeGenerate 为指定会员类型或商品批量生成一次性礼品码，同一批次使用相同批次号
+func (l *GiftCodeGenerateLogic) GiftCodeGenerate(req *types.GiftCodeGenerateReq) (resp *types.GiftCodeGenerateResp, err error) {
+	if req.Count <= 0 || req.Count > giftCodeMaxBatchSize {
+		return nil, fmt.Errorf("生成数量必须在1到%d之间", giftCodeMaxBatchSize)
+	}
+	if err := l.checkTarget(req); err != nil {
+		return nil, err
+	}
+
+	var expireAt *time.Time
+	if req.ExpireAt != "" {
+		expire, err := parseQueryTime(req.ExpireAt)
+		if err != nil {
+			return nil, fmt.Errorf("过期时间格式错误: %s", req.ExpireAt)
+		}
+		if len(req.ExpireAt) == len("2006-01-02") {
+			expire = expire.Add(24*time.Hour - time.Second)
+		}
+		if !expire.After(time.Now()) {
+			return nil, fmt.Errorf("过期时间必须晚于当前时间")
+		}
+		expireAt = &expire
+	}
+
+	createdBy, _, err := currentAdmin(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	batchNo := fmt.Sprintf("GB%d", utils.Snowflake())
+	codes := make([]string, 0, req.Count)
+	records := make([]*model.LxtPaymentGiftCode, 0, req.Count)
+	seen := make(map[string]struct{}, req.Count)
+	for len(codes) < req.Count {
+		code, err := utils.GenerateGiftCode()
+		if err != nil {
+			l.Errorf("Failed to generate gift code: %v", err)
+			return nil, fmt.Errorf("生成礼品码失败")
+		}
+		if _, ok := seen[code]; ok {
+			continue
+		}
+		seen[code] = struct{}{}
+		codes = append(codes, code)
+		records = append(records, &model.LxtPaymentGiftCode{
+			BatchNo:          batchNo,
+			Code:             code,
+			BuyType:          int32(req.BuyType),
+			MembershipTypeID: req.MembershipTypeId,
+			GoodsID:          req.GoodsId,
+			Status:           constant.GiftCodeStatusUnused,
+			ExpireAt:         expireAt,
+			Remark:           strings.TrimSpace(req.Remark),
+			CreatedBy:        createdBy,
+		})
+	}
+
+	if err := l.giftCodeService.CreateBatch(l.ctx, records); err != nil {
+		l.Errorf("Failed to create gift codes: batchNo=%s, err=%v", batchNo, err)
+		return nil, fmt.Errorf("保存礼品码失败: %w", err)
+	}
+
+	l.Infof("Generated gift codes: batchNo=%s, buyType=%d, membershipTypeId=%d, goodsId=%d, count=%d, createdBy=%d",
+		batchNo, req.BuyType, req.MembershipTypeId, req.GoodsId, req.Count, createdBy)
+
+	return &types.GiftCodeGenerateResp{
+		BatchNo: batchNo,
+		Count:   len(codes),
+		Codes:   codes,
+	}, nil
+}
+
+// checkTarget 校验礼品码兑换的会员类型或商品存在，并清除与兑换类型无关的ID
+func (l *GiftCodeGenerateLogic) checkTarget(req *types.GiftCodeGenerateReq) error {
+	switch req.BuyType {
+	case constant.BuyTypeMembership:
+		if req.MembershipTypeId <= 0 {
+			return fmt.Errorf("兑换会员时会员类型ID不能为空")
+		}
+		if _, err := user_repo.NewMembershipTypeRepository(l.svcCtx.DB).GetByID(l.ctx, uint64(req.MembershipTypeId)); err != nil {
+			return fmt.Errorf("会员类型不存在")
+		}
+		req.GoodsId = 0
+	case constant.BuyTypeGoods:
+		if req.GoodsId <= 0 {
+			return fmt.Errorf("兑换商品时商品ID不能为空")
+		}
+		if _, err := payment_repo.NewLxtPaymentGoodsRepo(l.svcCtx.DB).GetById(l.ctx, req.GoodsId); err != nil {
+			return fmt.Errorf("商品不存在")
+		}
+		req.MembershipTypeId = 0
+	default:
+		return fmt.Errorf("礼品码只能兑换会员或商品")
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+package payment
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"strconv"
+
+	"lxtian-blog/admin/internal/svc"
+	"lxtian-blog/admin/internal/types"
+	"lxtian-blog/common/repository/payment_repo"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// giftCodeExportLimit 单次导出的最大礼品码数量
+const giftCodeExportLimit = 10000
+
+type GiftCodesExportLogic struct {
+	logx.Logger
+	ctx             context.Context
+	svcCtx          *svc.ServiceContext
+	giftCodeService payment_repo.LxtPaymentGiftCodesRepo
+}
+
+// 礼品码导出
+func NewGiftCodesExportLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GiftCodesExportLogic {
+	return &GiftCodesExportLogic{
+		Logger:          logx.WithContext(ctx),
+		ctx:             ctx,
+		svcCtx:          svcCtx,
+		giftCodeService: payment_repo.NewLxtPaymentGiftCodesRepo(svcCtx.DB),
+	}
+}
+
+// GiftCodesExport 按批次导出礼品码，返回 CSV 文件内容和文件名
+func (l *GiftCodesExportLogic) GiftCodesExport(req *types.GiftCodesExportReq) ([]byte, string, error) {
+	if req.BatchNo == "" {
+		return nil, "", fmt.Errorf("批次号不能为空")
+	}
+
+	giftCodes, err := l.giftCodeService.FindForExport(l.ctx, giftCodeCondition(req.BatchNo, req.Status), giftCodeExportLimit)
+	if err != nil {
+		l.Errorf("Failed to export gift codes: batchNo=%s, err=%v", req.BatchNo, err)
+		return nil, "", fmt.Errorf("failed to export gift codes: %w", err)
+	}
+	if len(giftCodes) == 0 {
+		return nil, "", fmt.Errorf("没有可导出的礼品码")
+	}
+
+	var buf bytes.Buffer
+	// 写入 UTF-8 BOM，避免 Excel 打开中文乱码
+	buf.WriteString("\xEF\xBB\xBF")
+	writer := csv.NewWriter(&buf)
+	_ = writer.Write([]string{"批次号", "礼品码", "兑换类型", "会员类型ID", "商品ID", "状态", "过期时间", "兑换用户ID", "兑换时间", "备注", "创建时间"})
+	for _, giftCode := range giftCodes {
+		expireAt, redeemedAt := "", ""
+		if giftCode.ExpireAt != nil {
+			expireAt = giftCode.ExpireAt.Format("2006-01-02 15:04:05")
+		}
+		if giftCode.RedeemedAt != nil {
+			redeemedAt = giftCode.RedeemedAt.Format("2006-01-02 15:04:05")
+		}
+		_ = writer.Write([]string{
+			giftCode.BatchNo,
+			giftCode.Code,
+			strconv.Itoa(int(giftCode.BuyType)),
+			strconv.FormatInt(giftCode.MembershipTypeID, 10),
+			strconv.FormatInt(giftCode.GoodsID, 10),
+			giftCode.Status,
+			expireAt,
+			strconv.FormatInt(giftCode.RedeemedBy, 10),
+			redeemedAt,
+			giftCode.Remark,
+			giftCode.CreatedAt.Format("2006-01-02 15:04:05"),
+		})
+	}
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return nil, "", fmt.Errorf("failed to write csv: %w", err)
+	}
+
+	return buf.Bytes(), fmt.Sprintf("gift_codes_%s.csv", req.BatchNo), nil
+}
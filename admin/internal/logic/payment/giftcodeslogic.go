@@ -0,0 +1,107 @@
+package payment
+
+import (
+	"context"
+	"fmt"
+
+	"lxtian-blog/admin/internal/svc"
+	"lxtian-blog/admin/internal/types"
+	"lxtian-blog/common/model"
+	"lxtian-blog/common/pkg/utils"
+	"lxtian-blog/common/repository/payment_repo"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GiftCodesLogic struct {
+	logx.Logger
+	ctx             context.Context
+	svcCtx          *svc.ServiceContext
+	giftCodeService payment_repo.LxtPaymentGiftCodesRepo
+}
+
+// 礼品码列表
+func NewGiftCodesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GiftCodesLogic {
+	return &GiftCodesLogic{
+		Logger:          logx.WithContext(ctx),
+		ctx:             ctx,
+		svcCtx:          svcCtx,
+		giftCodeService: payment_repo.NewLxtPaymentGiftCodesRepo(svcCtx.DB),
+	}
+}
+
+func (l *GiftCodesLogic) GiftCodes(req *types.GiftCodesReq) (resp *types.GiftCodesResp, err error) {
+	// 参数验证
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.PageSize <= 0 {
+		req.PageSize = 10
+	}
+	if req.PageSize > 100 {
+		req.PageSize = 100 // 限制最大每页数量
+	}
+
+	// 构建查询条件
+	condition := giftCodeCondition(req.BatchNo, req.Status)
+	if req.Code != "" {
+		condition["code = ?"] = utils.NormalizeGiftCode(req.Code)
+	}
+
+	giftCodes, total, err := l.giftCodeService.GetList(l.ctx, condition, req.Page, req.PageSize, "id desc", "")
+	if err != nil {
+		l.Errorf("Failed to get gift codes: %v", err)
+		return nil, fmt.Errorf("failed to get gift codes: %w", err)
+	}
+
+	list := make([]map[string]interface{}, 0, len(giftCodes))
+	for _, giftCode := range giftCodes {
+		list = append(list, buildGiftCodeItem(giftCode))
+	}
+
+	return &types.GiftCodesResp{
+		Page:     req.Page,
+		PageSize: req.PageSize,
+		Total:    total,
+		List:     list,
+	}, nil
+}
+
+// giftCodeCondition 构建按批次号和状态过滤的查询条件
+func giftCodeCondition(batchNo, status string) map[string]interface{} {
+	condition := make(map[string]interface{})
+	if batchNo != "" {
+		condition["batch_no = ?"] = batchNo
+	}
+	if status != "" {
+		condition["status = ?"] = status
+	}
+	return condition
+}
+
+// 构建礼品码项
+func buildGiftCodeItem(giftCode *model.LxtPaymentGiftCode) map[string]interface{} {
+	item := map[string]interface{}{
+		"id":                 giftCode.ID,
+		"batch_no":           giftCode.BatchNo,
+		"code":               giftCode.Code,
+		"buy_type":           giftCode.BuyType,
+		"membership_type_id": giftCode.MembershipTypeID,
+		"goods_id":           giftCode.GoodsID,
+		"status":             giftCode.Status,
+		"redeemed_by":        giftCode.RedeemedBy,
+		"payment_id":         giftCode.PaymentID,
+		"remark":             giftCode.Remark,
+		"created_by":         giftCode.CreatedBy,
+		"created_at":         giftCode.CreatedAt.Format("2006-01-02 15:04:05"),
+	}
+
+	if giftCode.ExpireAt != nil {
+		item["expire_at"] = giftCode.ExpireAt.Format("2006-01-02 15:04:05")
+	}
+	if giftCode.RedeemedAt != nil {
+		item["redeemed_at"] = giftCode.RedeemedAt.Format("2006-01-02 15:04:05")
+	}
+
+	return item
+}
@@ -0,0 +1,70 @@
+package payment
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"lxtian-blog/admin/internal/svc"
+	"lxtian-blog/admin/internal/types"
+	"lxtian-blog/common/constant"
+	"lxtian-blog/common/repository/payment_repo"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GoodsFileSaveLogic struct {
+	logx.Logger
+	ctx          context.Context
+	svcCtx       *svc.ServiceContext
+	goodsService payment_repo.LxtPaymentGoodsRepo
+}
+
+// 商品文件设置
+func NewGoodsFileSaveLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GoodsFileSaveLogic {
+	return &GoodsFileSaveLogic{
+		Logger:       logx.WithContext(ctx),
+		ctx:          ctx,
+		svcCtx:       svcCtx,
+		goodsService: payment_repo.NewLxtPaymentGoodsRepo(svcCtx.DB),
+	}
+}
+
+// GoodsFileSave 设置商品的交付文件和下载次数，文件 key 只能是存储内的相对路径
+func (l *GoodsFileSaveLogic) GoodsFileSave(req *types.GoodsFileSaveReq) (resp *types.GoodsFileSaveResp, err error) {
+	if req.FileStorage != constant.GoodsStorageQiniu && req.FileStorage != constant.GoodsStorageLocal {
+		return nil, fmt.Errorf("文件存储方式只能为 %s 或 %s", constant.GoodsStorageQiniu, constant.GoodsStorageLocal)
+	}
+	if req.DownloadLimit < 0 {
+		return nil, fmt.Errorf("下载次数不能小于0")
+	}
+
+	var fileKey *string
+	if key := strings.TrimSpace(req.FileKey); key != "" {
+		if strings.Contains(key, "://") || strings.Contains(key, "\\") || !filepath.IsLocal(key) {
+			return nil, fmt.Errorf("文件key必须是相对路径，且不能包含 ..")
+		}
+		key = filepath.ToSlash(filepath.Clean(key))
+		fileKey = &key
+	}
+
+	if _, err = l.goodsService.GetByID(l.ctx, uint64(req.Id)); err != nil {
+		return nil, fmt.Errorf("商品不存在")
+	}
+	err = l.goodsService.UpdateByCondition(l.ctx, map[string]interface{}{"id = ?": req.Id}, map[string]interface{}{
+		"file_storage":   req.FileStorage,
+		"file_key":       fileKey,
+		"download_limit": req.DownloadLimit,
+	})
+	if err != nil {
+		l.Errorf("Failed to save goods file: id=%d, err=%v", req.Id, err)
+		return nil, err
+	}
+
+	l.Infof("Saved goods file: id=%d, storage=%s, downloadLimit=%d", req.Id, req.FileStorage, req.DownloadLimit)
+
+	return &types.GoodsFileSaveResp{
+		Data: true,
+	}, nil
+}
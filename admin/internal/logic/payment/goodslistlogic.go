@@ -10,6 +10,7 @@ import (
 	"lxtian-blog/common/repository/payment_repo"
 	"strings"
 
+	"github.com/shopspring/decimal"
 	"github.com/zeromicro/go-zero/core/logx"
 )
 
@@ -73,10 +74,10 @@ func (l *GoodsListLogic) GoodsList(req *types.GoodsListReq) (resp *types.GoodsLi
 	var filteredGoods []*model.LxtPaymentGood
 	for _, good := range goodsList {
 		// 价格过滤
-		if req.PriceMin > 0 && good.Price < float64(req.PriceMin) {
+		if req.PriceMin > 0 && good.Price.LessThan(decimal.NewFromFloat32(req.PriceMin)) {
 			continue
 		}
-		if req.PriceMax > 0 && good.Price > float64(req.PriceMax) {
+		if req.PriceMax > 0 && good.Price.GreaterThan(decimal.NewFromFloat32(req.PriceMax)) {
 			continue
 		}
 		filteredGoods = append(filteredGoods, good)
@@ -89,10 +90,10 @@ func (l *GoodsListLogic) GoodsList(req *types.GoodsListReq) (resp *types.GoodsLi
 		if err == nil {
 			var count int64
 			for _, good := range allGoods {
-				if req.PriceMin > 0 && good.Price < float64(req.PriceMin) {
+				if req.PriceMin > 0 && good.Price.LessThan(decimal.NewFromFloat32(req.PriceMin)) {
 					continue
 				}
-				if req.PriceMax > 0 && good.Price > float64(req.PriceMax) {
+				if req.PriceMax > 0 && good.Price.GreaterThan(decimal.NewFromFloat32(req.PriceMax)) {
 					continue
 				}
 				count++
@@ -129,9 +130,12 @@ func (l *GoodsListLogic) buildGoodsItem(good *model.LxtPaymentGood) map[string]i
 		"price":          good.Price,
 		"original_price": good.OriginalPrice,
 		"rating":         good.Rating,
+		"review_count":   good.ReviewCount,
 		"sales":          good.Sales,
 		"downloads":      good.Downloads,
 		"size":           good.Size,
+		"file_storage":   good.FileStorage,
+		"download_limit": good.DownloadLimit,
 		"status":         good.Status,
 		"pic_url":        good.PicURL,
 		"created_at":     good.CreatedAt.Format("2006-01-02 15:04:05"),
@@ -144,6 +148,9 @@ func (l *GoodsListLogic) buildGoodsItem(good *model.LxtPaymentGood) map[string]i
 	if good.ProductCode != nil {
 		item["product_code"] = *good.ProductCode
 	}
+	if good.FileKey != nil {
+		item["file_key"] = *good.FileKey
+	}
 	if good.PicURL != nil {
 		item["pic_url"] = *good.PicURL
 	}
@@ -0,0 +1,72 @@
+package payment
+
+import (
+	"context"
+	"fmt"
+
+	"lxtian-blog/admin/internal/svc"
+	"lxtian-blog/admin/internal/types"
+	"lxtian-blog/common/repository/payment_repo"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GoodsReviewsLogic struct {
+	logx.Logger
+	ctx           context.Context
+	svcCtx        *svc.ServiceContext
+	reviewService payment_repo.LxtPaymentGoodsReviewsRepo
+}
+
+// 商品评价列表
+func NewGoodsReviewsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GoodsReviewsLogic {
+	return &GoodsReviewsLogic{
+		Logger:        logx.WithContext(ctx),
+		ctx:           ctx,
+		svcCtx:        svcCtx,
+		reviewService: payment_repo.NewLxtPaymentGoodsReviewsRepo(svcCtx.DB),
+	}
+}
+
+func (l *GoodsReviewsLogic) GoodsReviews(req *types.GoodsReviewsReq) (resp *types.GoodsReviewsResp, err error) {
+	// 参数验证
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.PageSize <= 0 {
+		req.PageSize = 10
+	}
+	if req.PageSize > 100 {
+		req.PageSize = 100 // 限制最大每页数量
+	}
+
+	reviews, total, err := l.reviewService.FindByGoods(l.ctx, req.GoodsId, int32(req.Status), req.Page, req.PageSize)
+	if err != nil {
+		l.Errorf("Failed to get goods reviews: %v", err)
+		return nil, fmt.Errorf("failed to get goods reviews: %w", err)
+	}
+
+	list := make([]map[string]interface{}, 0, len(reviews))
+	for _, review := range reviews {
+		list = append(list, map[string]interface{}{
+			"id":         review.ID,
+			"goods_id":   review.GoodsID,
+			"user_id":    review.UserID,
+			"nickname":   review.Nickname,
+			"head_img":   review.HeadImg,
+			"payment_id": review.PaymentID,
+			"rating":     review.Rating,
+			"content":    review.Content,
+			"status":     review.Status,
+			"created_at": review.CreatedAt.Format("2006-01-02 15:04:05"),
+			"updated_at": review.UpdatedAt.Format("2006-01-02 15:04:05"),
+		})
+	}
+
+	return &types.GoodsReviewsResp{
+		Page:     req.Page,
+		PageSize: req.PageSize,
+		Total:    total,
+		List:     list,
+	}, nil
+}
@@ -0,0 +1,69 @@
+package payment
+
+import (
+	"context"
+	"fmt"
+
+	"lxtian-blog/admin/internal/svc"
+	"lxtian-blog/admin/internal/types"
+	"lxtian-blog/common/constant"
+	"lxtian-blog/common/repository/payment_repo"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GoodsReviewStatusLogic struct {
+	logx.Logger
+	ctx           context.Context
+	svcCtx        *svc.ServiceContext
+	reviewService payment_repo.LxtPaymentGoodsReviewsRepo
+	goodsService  payment_repo.LxtPaymentGoodsRepo
+}
+
+// 商品评价隐藏/展示
+func NewGoodsReviewStatusLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GoodsReviewStatusLogic {
+	return &GoodsReviewStatusLogic{
+		Logger:        logx.WithContext(ctx),
+		ctx:           ctx,
+		svcCtx:        svcCtx,
+		reviewService: payment_repo.NewLxtPaymentGoodsReviewsRepo(svcCtx.DB),
+		goodsService:  payment_repo.NewLxtPaymentGoodsRepo(svcCtx.DB),
+	}
+}
+
+// GoodsReviewStatus 隐藏或重新展示评价，商品评分只统计展示中的评价，状态变化时同步增减
+func (l *GoodsReviewStatusLogic) GoodsReviewStatus(req *types.GoodsReviewStatusReq) (resp *types.GoodsReviewStatusResp, err error) {
+	if req.Status != constant.GoodsReviewStatusHidden && req.Status != constant.GoodsReviewStatusVisible {
+		return nil, fmt.Errorf("状态只能为0或1")
+	}
+
+	var goodsId int64
+	err = l.reviewService.WithTransaction(l.ctx, func(txCtx context.Context) error {
+		review, err := l.reviewService.LockById(txCtx, req.Id)
+		if err != nil {
+			return fmt.Errorf("评价不存在")
+		}
+		goodsId = review.GoodsID
+		if review.Status == int32(req.Status) {
+			return nil
+		}
+
+		if err := l.reviewService.UpdateStatus(txCtx, review.ID, int32(req.Status)); err != nil {
+			return err
+		}
+		if req.Status == constant.GoodsReviewStatusVisible {
+			return l.goodsService.AdjustRating(txCtx, review.GoodsID, int64(review.Rating), 1)
+		}
+		return l.goodsService.AdjustRating(txCtx, review.GoodsID, -int64(review.Rating), -1)
+	})
+	if err != nil {
+		l.Errorf("Failed to update goods review status: id=%d, status=%d, err=%v", req.Id, req.Status, err)
+		return nil, err
+	}
+
+	l.Infof("Updated goods review status: id=%d, goodsId=%d, status=%d", req.Id, goodsId, req.Status)
+
+	return &types.GoodsReviewStatusResp{
+		Data: true,
+	}, nil
+}
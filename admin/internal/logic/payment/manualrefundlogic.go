@@ -2,30 +2,114 @@ package payment
 
 import (
 	"context"
+	"fmt"
 
 	"lxtian-blog/admin/internal/svc"
 	"lxtian-blog/admin/internal/types"
+	"lxtian-blog/common/constant"
+	"lxtian-blog/common/model"
+	"lxtian-blog/common/pkg/utils"
+	"lxtian-blog/common/repository/payment_repo"
 
 	"github.com/zeromicro/go-zero/core/logx"
 )
 
 type ManualRefundLogic struct {
 	logx.Logger
-	ctx    context.Context
-	svcCtx *svc.ServiceContext
+	ctx            context.Context
+	svcCtx         *svc.ServiceContext
+	refundService  payment_repo.LxtPaymentRefundsRepo
+	requestService payment_repo.LxtPaymentRefundRequestsRepo
 }
 
 // 手动退款
 func NewManualRefundLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ManualRefundLogic {
 	return &ManualRefundLogic{
-		Logger: logx.WithContext(ctx),
-		ctx:    ctx,
-		svcCtx: svcCtx,
+		Logger:         logx.WithContext(ctx),
+		ctx:            ctx,
+		svcCtx:         svcCtx,
+		refundService:  payment_repo.NewLxtPaymentRefundsRepo(svcCtx.DB),
+		requestService: payment_repo.NewLxtPaymentRefundRequestsRepo(svcCtx.DB),
 	}
 }
 
+// ManualRefund 提交退款申请，需其他管理员审批通过后才会向支付宝发起退款
 func (l *ManualRefundLogic) ManualRefund(req *types.ManualRefundReq) (resp *types.ManualRefundResp, err error) {
-	// todo: add your logic here and delete this line
+	if req.PaymentId == "" {
+		return nil, fmt.Errorf("支付ID不能为空")
+	}
+	if req.RefundReason == "" {
+		return nil, fmt.Errorf("退款原因不能为空")
+	}
+	refundAmount := utils.AmountFromFloat(req.RefundAmount)
+	if !refundAmount.IsPositive() {
+		return nil, fmt.Errorf("退款金额必须大于0")
+	}
+
+	operatorId, operatorName, err := currentAdmin(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	refundRequest := &model.LxtPaymentRefundRequest{
+		RequestID:     utils.GenerateOrderSN("RR"),
+		PaymentID:     req.PaymentId,
+		RefundAmount:  refundAmount,
+		RefundReason:  req.RefundReason,
+		Status:        constant.RefundRequestStatusPending,
+		RequesterID:   operatorId,
+		RequesterName: operatorName,
+	}
+
+	// 锁定订单后校验可退金额：支付金额 - 已退款/退款中金额 - 待审批申请金额
+	err = l.refundService.WithTransaction(l.ctx, func(txCtx context.Context) error {
+		order, err := l.refundService.LockPaymentOrderByPaymentId(txCtx, req.PaymentId)
+		if err != nil {
+			return fmt.Errorf("支付订单不存在")
+		}
+		if order.Status != constant.PaymentStatusPaid && order.Status != constant.PaymentStatusPartialRefunded {
+			return fmt.Errorf("订单状态不允许退款")
+		}
 
-	return
+		refunded, err := l.refundService.SumRefundAmountByPaymentId(txCtx, order.PaymentID,
+			[]string{constant.RefundStatusSuccess, constant.RefundStatusPending})
+		if err != nil {
+			return fmt.Errorf("failed to sum refunded amount: %w", err)
+		}
+		pending, err := l.requestService.SumPendingAmountByPaymentId(txCtx, order.PaymentID)
+		if err != nil {
+			return fmt.Errorf("failed to sum pending refund requests: %w", err)
+		}
+		refundable := order.Amount.Sub(refunded).Sub(pending).Round(2)
+		if refundAmount.GreaterThan(refundable) {
+			return fmt.Errorf("退款金额超过可退金额，可退金额为%s元", refundable.StringFixed(2))
+		}
+
+		refundRequest.OrderSn = order.OrderSn
+		refundRequest.UserID = order.UserID
+		return l.requestService.Create(txCtx, refundRequest)
+	})
+	if err != nil {
+		l.Errorf("Failed to create refund request: paymentId=%s, err=%v", req.PaymentId, err)
+		return nil, err
+	}
+
+	l.Infof("Created refund request: requestId=%s, paymentId=%s, amount=%s, requester=%s",
+		refundRequest.RequestID, refundRequest.PaymentID, refundAmount.StringFixed(2), operatorName)
+
+	return &types.ManualRefundResp{
+		Data:      true,
+		Message:   "退款申请已提交，等待审批",
+		RequestId: refundRequest.RequestID,
+	}, nil
+}
+
+// currentAdmin 获取当前登录的管理员
+func currentAdmin(ctx context.Context) (int64, string, error) {
+	userId, ok := ctx.Value("user_id").(uint)
+	if !ok {
+		return 0, "", fmt.Errorf("获取当前用户失败")
+	}
+	username, _ := ctx.Value("username").(string)
+	return int64(userId), username, nil
 }
@@ -55,9 +55,9 @@ func (l *MembershipListLogic) MembershipList() (resp *types.MembershipListResp,
 		respList = append(respList, &types.MembershipType{
 			Id:            mt.ID,
 			Name:          mt.Name,
-			Price:         mt.Price,
-			OriginalPrice: mt.OriginalPrice,
-			Discount:      mt.Discount,
+			Price:         mt.Price.InexactFloat64(),
+			OriginalPrice: mt.OriginalPrice.InexactFloat64(),
+			Discount:      mt.Discount.InexactFloat64(),
 			Period:        mt.Period,
 			Popular:       mt.Popular == 1,
 			Permissions:   permissions,
@@ -2,30 +2,113 @@ package payment
 
 import (
 	"context"
+	"fmt"
 
 	"lxtian-blog/admin/internal/svc"
 	"lxtian-blog/admin/internal/types"
+	"lxtian-blog/common/model"
+	"lxtian-blog/common/repository/payment_repo"
 
 	"github.com/zeromicro/go-zero/core/logx"
 )
 
 type PaymentNotifiesLogic struct {
 	logx.Logger
-	ctx    context.Context
-	svcCtx *svc.ServiceContext
+	ctx           context.Context
+	svcCtx        *svc.ServiceContext
+	notifyService payment_repo.LxtPaymentNotifiesRepo
 }
 
 // 支付通知记录
 func NewPaymentNotifiesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *PaymentNotifiesLogic {
 	return &PaymentNotifiesLogic{
-		Logger: logx.WithContext(ctx),
-		ctx:    ctx,
-		svcCtx: svcCtx,
+		Logger:        logx.WithContext(ctx),
+		ctx:           ctx,
+		svcCtx:        svcCtx,
+		notifyService: payment_repo.NewLxtPaymentNotifiesRepo(svcCtx.DB),
 	}
 }
 
 func (l *PaymentNotifiesLogic) PaymentNotifies(req *types.PaymentNotifiesReq) (resp *types.PaymentNotifiesResp, err error) {
-	// todo: add your logic here and delete this line
+	// 参数验证
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.PageSize <= 0 {
+		req.PageSize = 10
+	}
+	if req.PageSize > 100 {
+		req.PageSize = 100 // 限制最大每页数量
+	}
+
+	// 构建查询条件
+	condition := make(map[string]interface{})
+	if req.NotifyType != "" {
+		condition["notify_type = ?"] = req.NotifyType
+	}
+	if req.VerifyStatus != "" {
+		condition["verify_status = ?"] = req.VerifyStatus
+	}
+	if req.ProcessStatus != "" {
+		condition["process_status = ?"] = req.ProcessStatus
+	}
+
+	start, end, err := parseTimeRange(req.StartTime, req.EndTime)
+	if err != nil {
+		return nil, err
+	}
+	if !start.IsZero() {
+		condition["created_at >= ?"] = start
+	}
+	if !end.IsZero() {
+		condition["created_at <= ?"] = end
+	}
+
+	notifies, total, err := l.notifyService.GetList(l.ctx, condition, req.Page, req.PageSize, "id desc", "")
+	if err != nil {
+		l.Errorf("Failed to get payment notifies: %v", err)
+		return nil, fmt.Errorf("failed to get payment notifies: %w", err)
+	}
+
+	list := make([]map[string]interface{}, 0, len(notifies))
+	for _, notify := range notifies {
+		list = append(list, buildPaymentNotifyItem(notify))
+	}
+
+	return &types.PaymentNotifiesResp{
+		Page:     req.Page,
+		PageSize: req.PageSize,
+		Total:    total,
+		List:     list,
+	}, nil
+}
+
+// 构建通知记录项
+func buildPaymentNotifyItem(notify *model.LxtPaymentNotify) map[string]interface{} {
+	item := map[string]interface{}{
+		"id":             notify.ID,
+		"notify_id":      notify.NotifyID,
+		"payment_id":     notify.PaymentID,
+		"notify_type":    notify.NotifyType,
+		"notify_data":    notify.NotifyData,
+		"verify_status":  notify.VerifyStatus,
+		"process_status": notify.ProcessStatus,
+		"created_at":     notify.CreatedAt.Format("2006-01-02 15:04:05"),
+		"updated_at":     notify.UpdatedAt.Format("2006-01-02 15:04:05"),
+	}
+
+	if notify.SignType != nil {
+		item["sign_type"] = *notify.SignType
+	}
+	if notify.ClientIP != nil {
+		item["client_ip"] = *notify.ClientIP
+	}
+	if notify.ErrorMessage != nil {
+		item["error_message"] = *notify.ErrorMessage
+	}
+	if notify.ProcessedAt != nil {
+		item["processed_at"] = notify.ProcessedAt.Format("2006-01-02 15:04:05")
+	}
 
-	return
+	return item
 }
@@ -2,30 +2,142 @@ package payment
 
 import (
 	"context"
+	"fmt"
+	"sort"
+	"time"
 
 	"lxtian-blog/admin/internal/svc"
 	"lxtian-blog/admin/internal/types"
+	"lxtian-blog/common/model"
+	"lxtian-blog/common/repository/payment_repo"
 
 	"github.com/zeromicro/go-zero/core/logx"
 )
 
 type PaymentOrderLogic struct {
 	logx.Logger
-	ctx    context.Context
-	svcCtx *svc.ServiceContext
+	ctx            context.Context
+	svcCtx         *svc.ServiceContext
+	paymentService payment_repo.PaymentOrderRepository
+	notifyService  payment_repo.LxtPaymentNotifiesRepo
+	refundService  payment_repo.LxtPaymentRefundsRepo
 }
 
 // 支付订单详情
 func NewPaymentOrderLogic(ctx context.Context, svcCtx *svc.ServiceContext) *PaymentOrderLogic {
 	return &PaymentOrderLogic{
-		Logger: logx.WithContext(ctx),
-		ctx:    ctx,
-		svcCtx: svcCtx,
+		Logger:         logx.WithContext(ctx),
+		ctx:            ctx,
+		svcCtx:         svcCtx,
+		paymentService: payment_repo.NewPaymentOrderRepository(svcCtx.DB),
+		notifyService:  payment_repo.NewLxtPaymentNotifiesRepo(svcCtx.DB),
+		refundService:  payment_repo.NewLxtPaymentRefundsRepo(svcCtx.DB),
 	}
 }
 
+// 时间线事件
+type timelineEvent struct {
+	at    time.Time
+	event string
+	item  map[string]interface{}
+}
+
 func (l *PaymentOrderLogic) PaymentOrder(req *types.PaymentOrderReq) (resp *types.PaymentOrderResp, err error) {
-	// todo: add your logic here and delete this line
+	if req.PaymentId == "" {
+		return nil, fmt.Errorf("支付ID不能为空")
+	}
+
+	order, err := l.paymentService.GetByPaymentId(l.ctx, req.PaymentId)
+	if err != nil {
+		l.Errorf("Failed to get payment order: paymentId=%s, err=%v", req.PaymentId, err)
+		return nil, fmt.Errorf("支付订单不存在")
+	}
+
+	condition := map[string]interface{}{"payment_id = ?": order.PaymentID}
+	notifies, _, err := l.notifyService.GetList(l.ctx, condition, 0, 0, "id asc", "")
+	if err != nil {
+		l.Errorf("Failed to get payment notifies: paymentId=%s, err=%v", order.PaymentID, err)
+		return nil, fmt.Errorf("failed to get payment notifies: %w", err)
+	}
+	refunds, _, err := l.refundService.GetList(l.ctx, condition, 0, 0, "id asc", "")
+	if err != nil {
+		l.Errorf("Failed to get payment refunds: paymentId=%s, err=%v", order.PaymentID, err)
+		return nil, fmt.Errorf("failed to get payment refunds: %w", err)
+	}
+
+	notifyList := make([]map[string]interface{}, 0, len(notifies))
+	for _, notify := range notifies {
+		notifyList = append(notifyList, buildPaymentNotifyItem(notify))
+	}
+	refundList := make([]map[string]interface{}, 0, len(refunds))
+	for _, refund := range refunds {
+		refundList = append(refundList, buildPaymentRefundItem(refund))
+	}
 
-	return
+	return &types.PaymentOrderResp{
+		Data: map[string]interface{}{
+			"order":    buildPaymentOrderItem(order),
+			"notifies": notifyList,
+			"refunds":  refundList,
+			"timeline": l.buildTimeline(order, notifies, refunds),
+		},
+	}, nil
+}
+
+// buildTimeline 按时间顺序汇总订单创建、通知、支付、退款、关闭等事件
+func (l *PaymentOrderLogic) buildTimeline(order *model.LxtPaymentOrder, notifies []*model.LxtPaymentNotify, refunds []*model.LxtPaymentRefund) []map[string]interface{} {
+	events := []timelineEvent{{
+		at:    order.CreatedAt,
+		event: "ORDER_CREATED",
+		item:  map[string]interface{}{"amount": order.Amount, "status": order.Status},
+	}}
+
+	for _, notify := range notifies {
+		events = append(events, timelineEvent{
+			at:    notify.CreatedAt,
+			event: "NOTIFY_" + notify.NotifyType,
+			item: map[string]interface{}{
+				"notify_id":      notify.NotifyID,
+				"verify_status":  notify.VerifyStatus,
+				"process_status": notify.ProcessStatus,
+			},
+		})
+	}
+	if order.PayTime != nil {
+		events = append(events, timelineEvent{
+			at:    *order.PayTime,
+			event: "ORDER_PAID",
+			item:  map[string]interface{}{"trade_no": order.TradeNo, "receipt_amount": order.ReceiptAmount},
+		})
+	}
+	for _, refund := range refunds {
+		at := refund.CreatedAt
+		if refund.GmtRefund != nil {
+			at = *refund.GmtRefund
+		}
+		events = append(events, timelineEvent{
+			at:    at,
+			event: "REFUND_" + refund.Status,
+			item:  map[string]interface{}{"refund_id": refund.RefundID, "refund_amount": refund.RefundAmount},
+		})
+	}
+	if order.CloseTime != nil {
+		events = append(events, timelineEvent{
+			at:    *order.CloseTime,
+			event: "ORDER_CLOSED",
+			item:  map[string]interface{}{"status": order.Status},
+		})
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].at.Before(events[j].at)
+	})
+
+	timeline := make([]map[string]interface{}, 0, len(events))
+	for _, e := range events {
+		e.item["event"] = e.event
+		e.item["time"] = e.at.Format("2006-01-02 15:04:05")
+		timeline = append(timeline, e.item)
+	}
+	return timeline
 }
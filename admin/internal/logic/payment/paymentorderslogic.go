@@ -2,30 +2,164 @@ package payment
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"lxtian-blog/admin/internal/svc"
 	"lxtian-blog/admin/internal/types"
+	"lxtian-blog/common/model"
+	"lxtian-blog/common/repository/payment_repo"
 
 	"github.com/zeromicro/go-zero/core/logx"
 )
 
 type PaymentOrdersLogic struct {
 	logx.Logger
-	ctx    context.Context
-	svcCtx *svc.ServiceContext
+	ctx            context.Context
+	svcCtx         *svc.ServiceContext
+	paymentService payment_repo.PaymentOrderRepository
 }
 
 // 支付订单管理
 func NewPaymentOrdersLogic(ctx context.Context, svcCtx *svc.ServiceContext) *PaymentOrdersLogic {
 	return &PaymentOrdersLogic{
-		Logger: logx.WithContext(ctx),
-		ctx:    ctx,
-		svcCtx: svcCtx,
+		Logger:         logx.WithContext(ctx),
+		ctx:            ctx,
+		svcCtx:         svcCtx,
+		paymentService: payment_repo.NewPaymentOrderRepository(svcCtx.DB),
 	}
 }
 
 func (l *PaymentOrdersLogic) PaymentOrders(req *types.PaymentOrdersReq) (resp *types.PaymentOrdersResp, err error) {
-	// todo: add your logic here and delete this line
+	// 参数验证
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.PageSize <= 0 {
+		req.PageSize = 10
+	}
+	if req.PageSize > 100 {
+		req.PageSize = 100 // 限制最大每页数量
+	}
+
+	// 构建查询条件
+	condition := make(map[string]interface{})
+	if req.UserId > 0 {
+		condition["user_id = ?"] = req.UserId
+	}
+	if req.OrderId != "" {
+		condition["order_sn = ?"] = req.OrderId
+	}
+	if req.OutTradeNo != "" {
+		condition["out_trade_no = ?"] = req.OutTradeNo
+	}
+	if req.BuyType > 0 {
+		condition["buy_type = ?"] = req.BuyType
+	}
+	if req.PaymentStatus != "" {
+		condition["status = ?"] = req.PaymentStatus
+	}
+
+	// 时间范围过滤
+	start, end, err := parseTimeRange(req.StartTime, req.EndTime)
+	if err != nil {
+		return nil, err
+	}
+	if !start.IsZero() {
+		condition["created_at >= ?"] = start
+	}
+	if !end.IsZero() {
+		condition["created_at <= ?"] = end
+	}
+
+	orders, total, err := l.paymentService.GetList(l.ctx, condition, req.Page, req.PageSize, "id desc", "")
+	if err != nil {
+		l.Errorf("Failed to get payment orders: %v", err)
+		return nil, fmt.Errorf("failed to get payment orders: %w", err)
+	}
+
+	list := make([]map[string]interface{}, 0, len(orders))
+	for _, order := range orders {
+		list = append(list, buildPaymentOrderItem(order))
+	}
 
-	return
+	return &types.PaymentOrdersResp{
+		Page:     req.Page,
+		PageSize: req.PageSize,
+		Total:    total,
+		List:     list,
+	}, nil
+}
+
+// 构建支付订单项
+func buildPaymentOrderItem(order *model.LxtPaymentOrder) map[string]interface{} {
+	item := map[string]interface{}{
+		"id":              order.ID,
+		"payment_id":      order.PaymentID,
+		"order_sn":        order.OrderSn,
+		"out_trade_no":    order.OutTradeNo,
+		"user_id":         order.UserID,
+		"goods_id":        order.GoodsID,
+		"vip_id":          order.VipID,
+		"quantity":        order.Quantity,
+		"buy_type":        order.BuyType,
+		"pay_type":        order.PayType,
+		"amount":          order.Amount,
+		"unit_price":      order.UnitPrice,
+		"discount_amount": order.DiscountAmount,
+		"receipt_amount":  order.ReceiptAmount,
+		"subject":         order.Subject,
+		"status":          order.Status,
+		"trade_no":        order.TradeNo,
+		"trade_status":    order.TradeStatus,
+		"buyer_logon_id":  order.BuyerLogonID,
+		"timeout":         order.Timeout,
+		"client_ip":       order.ClientIP,
+		"remark":          order.Remark,
+		"created_at":      order.CreatedAt.Format("2006-01-02 15:04:05"),
+		"updated_at":      order.UpdatedAt.Format("2006-01-02 15:04:05"),
+	}
+
+	if order.Body != nil {
+		item["body"] = *order.Body
+	}
+	if order.PriceSnapshot != nil {
+		item["price_snapshot"] = *order.PriceSnapshot
+	}
+	if order.PayTime != nil {
+		item["pay_time"] = order.PayTime.Format("2006-01-02 15:04:05")
+	}
+	if order.CloseTime != nil {
+		item["close_time"] = order.CloseTime.Format("2006-01-02 15:04:05")
+	}
+
+	return item
+}
+
+// parseTimeRange 解析查询时间范围，支持 2006-01-02 和 2006-01-02 15:04:05 两种格式
+// 仅传日期时结束时间取当天最后一秒
+func parseTimeRange(startTime, endTime string) (start, end time.Time, err error) {
+	if startTime != "" {
+		start, err = parseQueryTime(startTime)
+		if err != nil {
+			return start, end, fmt.Errorf("开始时间格式错误: %s", startTime)
+		}
+	}
+	if endTime != "" {
+		end, err = parseQueryTime(endTime)
+		if err != nil {
+			return start, end, fmt.Errorf("结束时间格式错误: %s", endTime)
+		}
+		if len(endTime) == len("2006-01-02") {
+			end = end.Add(24*time.Hour - time.Second)
+		}
+	}
+	return start, end, nil
+}
+
+func parseQueryTime(s string) (time.Time, error) {
+	if len(s) == len("2006-01-02") {
+		return time.ParseInLocation("2006-01-02", s, time.Local)
+	}
+	return time.ParseInLocation("2006-01-02 15:04:05", s, time.Local)
 }
@@ -2,30 +2,115 @@ package payment
 
 import (
 	"context"
+	"fmt"
 
 	"lxtian-blog/admin/internal/svc"
 	"lxtian-blog/admin/internal/types"
+	"lxtian-blog/common/model"
+	"lxtian-blog/common/repository/payment_repo"
 
 	"github.com/zeromicro/go-zero/core/logx"
 )
 
 type PaymentRefundsLogic struct {
 	logx.Logger
-	ctx    context.Context
-	svcCtx *svc.ServiceContext
+	ctx           context.Context
+	svcCtx        *svc.ServiceContext
+	refundService payment_repo.LxtPaymentRefundsRepo
 }
 
 // 退款记录管理
 func NewPaymentRefundsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *PaymentRefundsLogic {
 	return &PaymentRefundsLogic{
-		Logger: logx.WithContext(ctx),
-		ctx:    ctx,
-		svcCtx: svcCtx,
+		Logger:        logx.WithContext(ctx),
+		ctx:           ctx,
+		svcCtx:        svcCtx,
+		refundService: payment_repo.NewLxtPaymentRefundsRepo(svcCtx.DB),
 	}
 }
 
 func (l *PaymentRefundsLogic) PaymentRefunds(req *types.PaymentRefundsReq) (resp *types.PaymentRefundsResp, err error) {
-	// todo: add your logic here and delete this line
+	// 参数验证
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.PageSize <= 0 {
+		req.PageSize = 10
+	}
+	if req.PageSize > 100 {
+		req.PageSize = 100 // 限制最大每页数量
+	}
+
+	// 构建查询条件
+	condition := make(map[string]interface{})
+	if req.PaymentId != "" {
+		condition["payment_id = ?"] = req.PaymentId
+	}
+	if req.OrderId != "" {
+		condition["order_sn = ?"] = req.OrderId
+	}
+	if req.RefundStatus != "" {
+		condition["status = ?"] = req.RefundStatus
+	}
+
+	start, end, err := parseTimeRange(req.StartTime, req.EndTime)
+	if err != nil {
+		return nil, err
+	}
+	if !start.IsZero() {
+		condition["created_at >= ?"] = start
+	}
+	if !end.IsZero() {
+		condition["created_at <= ?"] = end
+	}
+
+	refunds, total, err := l.refundService.GetList(l.ctx, condition, req.Page, req.PageSize, "id desc", "")
+	if err != nil {
+		l.Errorf("Failed to get payment refunds: %v", err)
+		return nil, fmt.Errorf("failed to get payment refunds: %w", err)
+	}
+
+	list := make([]map[string]interface{}, 0, len(refunds))
+	for _, refund := range refunds {
+		list = append(list, buildPaymentRefundItem(refund))
+	}
+
+	return &types.PaymentRefundsResp{
+		Page:     req.Page,
+		PageSize: req.PageSize,
+		Total:    total,
+		List:     list,
+	}, nil
+}
+
+// 构建退款记录项
+func buildPaymentRefundItem(refund *model.LxtPaymentRefund) map[string]interface{} {
+	item := map[string]interface{}{
+		"id":             refund.ID,
+		"refund_id":      refund.RefundID,
+		"payment_id":     refund.PaymentID,
+		"order_sn":       refund.OrderSn,
+		"out_trade_no":   refund.OutTradeNo,
+		"out_request_no": refund.OutRequestNo,
+		"user_id":        refund.UserID,
+		"refund_amount":  refund.RefundAmount,
+		"status":         refund.Status,
+		"created_at":     refund.CreatedAt.Format("2006-01-02 15:04:05"),
+		"updated_at":     refund.UpdatedAt.Format("2006-01-02 15:04:05"),
+	}
+
+	if refund.RefundFee != nil {
+		item["refund_fee"] = *refund.RefundFee
+	}
+	if refund.RefundReason != nil {
+		item["refund_reason"] = *refund.RefundReason
+	}
+	if refund.RefundStatus != nil {
+		item["refund_status"] = *refund.RefundStatus
+	}
+	if refund.GmtRefund != nil {
+		item["gmt_refund"] = refund.GmtRefund.Format("2006-01-02 15:04:05")
+	}
 
-	return
+	return item
 }
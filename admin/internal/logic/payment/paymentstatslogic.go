@@ -2,30 +2,86 @@ package payment
 
 import (
 	"context"
+	"fmt"
 
 	"lxtian-blog/admin/internal/svc"
 	"lxtian-blog/admin/internal/types"
+	"lxtian-blog/common/repository/payment_repo"
 
 	"github.com/zeromicro/go-zero/core/logx"
 )
 
 type PaymentStatsLogic struct {
 	logx.Logger
-	ctx    context.Context
-	svcCtx *svc.ServiceContext
+	ctx            context.Context
+	svcCtx         *svc.ServiceContext
+	paymentService payment_repo.PaymentOrderRepository
 }
 
 // 支付统计
 func NewPaymentStatsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *PaymentStatsLogic {
 	return &PaymentStatsLogic{
-		Logger: logx.WithContext(ctx),
-		ctx:    ctx,
-		svcCtx: svcCtx,
+		Logger:         logx.WithContext(ctx),
+		ctx:            ctx,
+		svcCtx:         svcCtx,
+		paymentService: payment_repo.NewPaymentOrderRepository(svcCtx.DB),
 	}
 }
 
 func (l *PaymentStatsLogic) PaymentStats(req *types.PaymentStatsReq) (resp *types.PaymentStatsResp, err error) {
-	// todo: add your logic here and delete this line
+	start, end, err := parseTimeRange(req.StartTime, req.EndTime)
+	if err != nil {
+		return nil, err
+	}
+	if start.IsZero() || end.IsZero() {
+		return nil, fmt.Errorf("开始时间和结束时间不能为空")
+	}
+	if end.Before(start) {
+		return nil, fmt.Errorf("结束时间不能早于开始时间")
+	}
+
+	// 分组方式：默认按天
+	periodFormat := "%Y-%m-%d"
+	if req.GroupBy == "hour" {
+		periodFormat = "%Y-%m-%d %H:00"
+	}
+
+	periodStats, err := l.paymentService.GetStatsByPeriod(l.ctx, start, end, periodFormat)
+	if err != nil {
+		l.Errorf("Failed to get payment stats by period: %v", err)
+		return nil, fmt.Errorf("failed to get payment stats: %w", err)
+	}
+	buyTypeStats, err := l.paymentService.GetStatsByBuyType(l.ctx, start, end)
+	if err != nil {
+		l.Errorf("Failed to get payment stats by buy type: %v", err)
+		return nil, fmt.Errorf("failed to get payment stats: %w", err)
+	}
+
+	data := make([]map[string]interface{}, 0, len(periodStats))
+	for _, stat := range periodStats {
+		data = append(data, map[string]interface{}{
+			"period":        stat.Period,
+			"order_count":   stat.OrderCount,
+			"paid_count":    stat.PaidCount,
+			"gross_amount":  stat.GrossAmount,
+			"refund_amount": stat.RefundAmount,
+			"revenue":       stat.Revenue,
+		})
+	}
+	byBuyType := make([]map[string]interface{}, 0, len(buyTypeStats))
+	for _, stat := range buyTypeStats {
+		byBuyType = append(byBuyType, map[string]interface{}{
+			"buy_type":      stat.BuyType,
+			"order_count":   stat.OrderCount,
+			"paid_count":    stat.PaidCount,
+			"gross_amount":  stat.GrossAmount,
+			"refund_amount": stat.RefundAmount,
+			"revenue":       stat.Revenue,
+		})
+	}
 
-	return
+	return &types.PaymentStatsResp{
+		Data:      data,
+		ByBuyType: byBuyType,
+	}, nil
 }
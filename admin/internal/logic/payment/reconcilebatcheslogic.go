@@ -0,0 +1,93 @@
+package payment
+
+import (
+	"context"
+	"fmt"
+
+	"lxtian-blog/admin/internal/svc"
+	"lxtian-blog/admin/internal/types"
+	"lxtian-blog/common/model"
+	"lxtian-blog/common/repository/payment_repo"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ReconcileBatchesLogic struct {
+	logx.Logger
+	ctx          context.Context
+	svcCtx       *svc.ServiceContext
+	batchService payment_repo.LxtPaymentReconciliationsRepo
+}
+
+// 对账批次列表
+func NewReconcileBatchesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ReconcileBatchesLogic {
+	return &ReconcileBatchesLogic{
+		Logger:       logx.WithContext(ctx),
+		ctx:          ctx,
+		svcCtx:       svcCtx,
+		batchService: payment_repo.NewLxtPaymentReconciliationsRepo(svcCtx.DB),
+	}
+}
+
+func (l *ReconcileBatchesLogic) ReconcileBatches(req *types.ReconcileBatchesReq) (resp *types.ReconcileBatchesResp, err error) {
+	// 参数验证
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.PageSize <= 0 {
+		req.PageSize = 10
+	}
+	if req.PageSize > 100 {
+		req.PageSize = 100 // 限制最大每页数量
+	}
+
+	// 构建查询条件
+	condition := make(map[string]interface{})
+	if req.BillDate != "" {
+		condition["bill_date = ?"] = req.BillDate
+	}
+	if req.Status != "" {
+		condition["status = ?"] = req.Status
+	}
+
+	batches, total, err := l.batchService.GetList(l.ctx, condition, req.Page, req.PageSize, "id desc", "")
+	if err != nil {
+		l.Errorf("Failed to get reconcile batches: %v", err)
+		return nil, fmt.Errorf("failed to get reconcile batches: %w", err)
+	}
+
+	list := make([]map[string]interface{}, 0, len(batches))
+	for _, batch := range batches {
+		list = append(list, buildReconcileBatchItem(batch))
+	}
+
+	return &types.ReconcileBatchesResp{
+		Page:     req.Page,
+		PageSize: req.PageSize,
+		Total:    total,
+		List:     list,
+	}, nil
+}
+
+// 构建对账批次项
+func buildReconcileBatchItem(batch *model.LxtPaymentReconciliation) map[string]interface{} {
+	item := map[string]interface{}{
+		"id":                batch.ID,
+		"batch_id":          batch.BatchID,
+		"bill_date":         batch.BillDate,
+		"source":            batch.Source,
+		"status":            batch.Status,
+		"total_count":       batch.TotalCount,
+		"matched_count":     batch.MatchedCount,
+		"discrepancy_count": batch.DiscrepancyCount,
+		"bill_amount":       batch.BillAmount,
+		"created_at":        batch.CreatedAt.Format("2006-01-02 15:04:05"),
+		"updated_at":        batch.UpdatedAt.Format("2006-01-02 15:04:05"),
+	}
+
+	if batch.ErrorMessage != nil {
+		item["error_message"] = *batch.ErrorMessage
+	}
+
+	return item
+}
@@ -0,0 +1,121 @@
+package payment
+
+import (
+	"context"
+	"fmt"
+
+	"lxtian-blog/admin/internal/svc"
+	"lxtian-blog/admin/internal/types"
+	"lxtian-blog/common/model"
+	"lxtian-blog/common/repository/payment_repo"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ReconcileDiscrepanciesLogic struct {
+	logx.Logger
+	ctx                context.Context
+	svcCtx             *svc.ServiceContext
+	discrepancyService payment_repo.LxtPaymentReconcileDiscrepanciesRepo
+}
+
+// 对账差异列表
+func NewReconcileDiscrepanciesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ReconcileDiscrepanciesLogic {
+	return &ReconcileDiscrepanciesLogic{
+		Logger:             logx.WithContext(ctx),
+		ctx:                ctx,
+		svcCtx:             svcCtx,
+		discrepancyService: payment_repo.NewLxtPaymentReconcileDiscrepanciesRepo(svcCtx.DB),
+	}
+}
+
+func (l *ReconcileDiscrepanciesLogic) ReconcileDiscrepancies(req *types.ReconcileDiscrepanciesReq) (resp *types.ReconcileDiscrepanciesResp, err error) {
+	// 参数验证
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.PageSize <= 0 {
+		req.PageSize = 10
+	}
+	if req.PageSize > 100 {
+		req.PageSize = 100 // 限制最大每页数量
+	}
+
+	// 构建查询条件
+	condition := make(map[string]interface{})
+	if req.BillDate != "" {
+		condition["bill_date = ?"] = req.BillDate
+	}
+	if req.BatchId != "" {
+		condition["batch_id = ?"] = req.BatchId
+	}
+	if req.Type != "" {
+		condition["type = ?"] = req.Type
+	}
+	if req.ResolveStatus != "" {
+		condition["resolve_status = ?"] = req.ResolveStatus
+	}
+	if req.OutTradeNo != "" {
+		condition["out_trade_no = ?"] = req.OutTradeNo
+	}
+
+	discrepancies, total, err := l.discrepancyService.GetList(l.ctx, condition, req.Page, req.PageSize, "id desc", "")
+	if err != nil {
+		l.Errorf("Failed to get reconcile discrepancies: %v", err)
+		return nil, fmt.Errorf("failed to get reconcile discrepancies: %w", err)
+	}
+
+	list := make([]map[string]interface{}, 0, len(discrepancies))
+	for _, discrepancy := range discrepancies {
+		list = append(list, buildReconcileDiscrepancyItem(discrepancy))
+	}
+
+	return &types.ReconcileDiscrepanciesResp{
+		Page:     req.Page,
+		PageSize: req.PageSize,
+		Total:    total,
+		List:     list,
+	}, nil
+}
+
+// 构建对账差异项
+func buildReconcileDiscrepancyItem(discrepancy *model.LxtPaymentReconcileDiscrepancy) map[string]interface{} {
+	item := map[string]interface{}{
+		"id":             discrepancy.ID,
+		"batch_id":       discrepancy.BatchID,
+		"bill_date":      discrepancy.BillDate,
+		"type":           discrepancy.Type,
+		"biz_type":       discrepancy.BizType,
+		"out_trade_no":   discrepancy.OutTradeNo,
+		"trade_no":       discrepancy.TradeNo,
+		"out_request_no": discrepancy.OutRequestNo,
+		"detail":         discrepancy.Detail,
+		"resolve_status": discrepancy.ResolveStatus,
+		"created_at":     discrepancy.CreatedAt.Format("2006-01-02 15:04:05"),
+		"updated_at":     discrepancy.UpdatedAt.Format("2006-01-02 15:04:05"),
+	}
+
+	if discrepancy.LocalAmount != nil {
+		item["local_amount"] = *discrepancy.LocalAmount
+	}
+	if discrepancy.BillAmount != nil {
+		item["bill_amount"] = *discrepancy.BillAmount
+	}
+	if discrepancy.LocalStatus != nil {
+		item["local_status"] = *discrepancy.LocalStatus
+	}
+	if discrepancy.ResolverID != nil {
+		item["resolver_id"] = *discrepancy.ResolverID
+	}
+	if discrepancy.ResolverName != nil {
+		item["resolver_name"] = *discrepancy.ResolverName
+	}
+	if discrepancy.ResolveRemark != nil {
+		item["resolve_remark"] = *discrepancy.ResolveRemark
+	}
+	if discrepancy.ResolvedAt != nil {
+		item["resolved_at"] = discrepancy.ResolvedAt.Format("2006-01-02 15:04:05")
+	}
+
+	return item
+}
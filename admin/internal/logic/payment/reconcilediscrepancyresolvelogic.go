@@ -0,0 +1,68 @@
+package payment
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"lxtian-blog/admin/internal/svc"
+	"lxtian-blog/admin/internal/types"
+	"lxtian-blog/common/constant"
+	"lxtian-blog/common/repository/payment_repo"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ReconcileDiscrepancyResolveLogic struct {
+	logx.Logger
+	ctx                context.Context
+	svcCtx             *svc.ServiceContext
+	discrepancyService payment_repo.LxtPaymentReconcileDiscrepanciesRepo
+}
+
+// 对账差异处理
+func NewReconcileDiscrepancyResolveLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ReconcileDiscrepancyResolveLogic {
+	return &ReconcileDiscrepancyResolveLogic{
+		Logger:             logx.WithContext(ctx),
+		ctx:                ctx,
+		svcCtx:             svcCtx,
+		discrepancyService: payment_repo.NewLxtPaymentReconcileDiscrepanciesRepo(svcCtx.DB),
+	}
+}
+
+// ReconcileDiscrepancyResolve 将待处理的差异标记为已处理或已忽略，记录处理人和备注
+func (l *ReconcileDiscrepancyResolveLogic) ReconcileDiscrepancyResolve(req *types.ReconcileDiscrepancyResolveReq) (resp *types.ReconcileDiscrepancyResolveResp, err error) {
+	if req.Id <= 0 {
+		return nil, fmt.Errorf("差异ID不能为空")
+	}
+	if req.ResolveStatus != constant.DiscrepancyResolveResolved && req.ResolveStatus != constant.DiscrepancyResolveIgnored {
+		return nil, fmt.Errorf("处理结果只能为 %s 或 %s", constant.DiscrepancyResolveResolved, constant.DiscrepancyResolveIgnored)
+	}
+
+	resolverId, resolverName, err := currentAdmin(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ok, err := l.discrepancyService.Resolve(l.ctx, req.Id, map[string]interface{}{
+		"resolve_status": req.ResolveStatus,
+		"resolver_id":    resolverId,
+		"resolver_name":  resolverName,
+		"resolve_remark": req.Remark,
+		"resolved_at":    time.Now(),
+	})
+	if err != nil {
+		l.Errorf("Failed to resolve reconcile discrepancy: id=%d, err=%v", req.Id, err)
+		return nil, fmt.Errorf("failed to resolve reconcile discrepancy: %w", err)
+	}
+	if !ok {
+		return nil, fmt.Errorf("差异不存在或已处理")
+	}
+
+	l.Infof("Resolved reconcile discrepancy: id=%d, status=%s, resolver=%s", req.Id, req.ResolveStatus, resolverName)
+
+	return &types.ReconcileDiscrepancyResolveResp{
+		Data:    true,
+		Message: "处理成功",
+	}, nil
+}
@@ -0,0 +1,51 @@
+package payment
+
+import (
+	"context"
+	"fmt"
+
+	"lxtian-blog/admin/internal/svc"
+	"lxtian-blog/admin/internal/types"
+	"lxtian-blog/rpc/payment/paymentclient"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ReconcileRunLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 手动对账
+func NewReconcileRunLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ReconcileRunLogic {
+	return &ReconcileRunLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// ReconcileRun 对指定日期的账单重新对账，可指定服务器上的账单文件代替从支付宝下载
+func (l *ReconcileRunLogic) ReconcileRun(req *types.ReconcileRunReq) (resp *types.ReconcileRunResp, err error) {
+	if req.BillDate == "" {
+		return nil, fmt.Errorf("账单日期不能为空")
+	}
+
+	res, err := l.svcCtx.PaymentRpc.ReconcileBill(l.ctx, &paymentclient.ReconcileBillReq{
+		BillDate: req.BillDate,
+		FilePath: req.FilePath,
+	})
+	if err != nil {
+		l.Errorf("Failed to reconcile bill: billDate=%s, err=%v", req.BillDate, err)
+		return nil, fmt.Errorf("对账失败: %w", err)
+	}
+
+	return &types.ReconcileRunResp{
+		BatchId:          res.BatchId,
+		TotalCount:       res.TotalCount,
+		MatchedCount:     res.MatchedCount,
+		DiscrepancyCount: res.DiscrepancyCount,
+		Message:          res.Message,
+	}, nil
+}
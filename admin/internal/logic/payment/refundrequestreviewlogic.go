@@ -0,0 +1,117 @@
+package payment
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"lxtian-blog/admin/internal/svc"
+	"lxtian-blog/admin/internal/types"
+	"lxtian-blog/common/constant"
+	"lxtian-blog/common/pkg/utils"
+	"lxtian-blog/common/repository/payment_repo"
+	"lxtian-blog/rpc/payment/paymentclient"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type RefundRequestReviewLogic struct {
+	logx.Logger
+	ctx            context.Context
+	svcCtx         *svc.ServiceContext
+	requestService payment_repo.LxtPaymentRefundRequestsRepo
+}
+
+// 退款申请审批
+func NewRefundRequestReviewLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RefundRequestReviewLogic {
+	return &RefundRequestReviewLogic{
+		Logger:         logx.WithContext(ctx),
+		ctx:            ctx,
+		svcCtx:         svcCtx,
+		requestService: payment_repo.NewLxtPaymentRefundRequestsRepo(svcCtx.DB),
+	}
+}
+
+// RefundRequestReview 审批退款申请，审批人不能是申请人；通过后以申请ID作为退款单号调用支付服务退款
+func (l *RefundRequestReviewLogic) RefundRequestReview(req *types.RefundRequestReviewReq) (resp *types.RefundRequestReviewResp, err error) {
+	if req.RequestId == "" {
+		return nil, fmt.Errorf("退款申请ID不能为空")
+	}
+
+	reviewerId, reviewerName, err := currentAdmin(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	refundRequest, err := l.requestService.GetByRequestId(l.ctx, req.RequestId)
+	if err != nil {
+		return nil, fmt.Errorf("退款申请不存在")
+	}
+	if refundRequest.Status != constant.RefundRequestStatusPending {
+		return nil, fmt.Errorf("退款申请已处理")
+	}
+	if refundRequest.RequesterID == reviewerId {
+		return nil, fmt.Errorf("不能审批自己提交的退款申请")
+	}
+
+	status := constant.RefundRequestStatusRejected
+	if req.Approve {
+		status = constant.RefundRequestStatusApproved
+	}
+	ok, err := l.requestService.UpdateIfStatus(l.ctx, req.RequestId, constant.RefundRequestStatusPending, map[string]interface{}{
+		"status":        status,
+		"reviewer_id":   reviewerId,
+		"reviewer_name": reviewerName,
+		"review_remark": req.Remark,
+		"reviewed_at":   time.Now(),
+	})
+	if err != nil {
+		l.Errorf("Failed to review refund request: requestId=%s, err=%v", req.RequestId, err)
+		return nil, fmt.Errorf("failed to review refund request: %w", err)
+	}
+	if !ok {
+		return nil, fmt.Errorf("退款申请已处理")
+	}
+
+	if !req.Approve {
+		l.Infof("Rejected refund request: requestId=%s, reviewer=%s", req.RequestId, reviewerName)
+		return &types.RefundRequestReviewResp{
+			Data:    true,
+			Message: "已驳回退款申请",
+		}, nil
+	}
+
+	// 审批通过，调用支付服务发起退款；申请ID作为退款单号，重复提交时支付服务幂等返回
+	res, err := l.svcCtx.PaymentRpc.RefundPayment(l.ctx, &paymentclient.RefundPaymentReq{
+		PaymentId:    refundRequest.PaymentID,
+		RefundAmount: utils.FormatAmount(refundRequest.RefundAmount),
+		RefundReason: refundRequest.RefundReason,
+		OutRequestNo: refundRequest.RequestID,
+	})
+	if err != nil {
+		l.Errorf("Failed to refund payment: requestId=%s, paymentId=%s, err=%v", req.RequestId, refundRequest.PaymentID, err)
+		if _, uerr := l.requestService.UpdateIfStatus(l.ctx, req.RequestId, constant.RefundRequestStatusApproved, map[string]interface{}{
+			"status":        constant.RefundRequestStatusFailed,
+			"error_message": err.Error(),
+		}); uerr != nil {
+			l.Errorf("Failed to mark refund request failed: requestId=%s, err=%v", req.RequestId, uerr)
+		}
+		return &types.RefundRequestReviewResp{
+			Data:    false,
+			Message: "发起退款失败: " + err.Error(),
+		}, nil
+	}
+
+	if _, err := l.requestService.UpdateIfStatus(l.ctx, req.RequestId, constant.RefundRequestStatusApproved, map[string]interface{}{
+		"refund_id": res.RefundId,
+	}); err != nil {
+		l.Errorf("Failed to save refund id: requestId=%s, refundId=%s, err=%v", req.RequestId, res.RefundId, err)
+	}
+
+	l.Infof("Approved refund request: requestId=%s, refundId=%s, reviewer=%s", req.RequestId, res.RefundId, reviewerName)
+
+	return &types.RefundRequestReviewResp{
+		Data:    true,
+		Message: res.Message,
+	}, nil
+}
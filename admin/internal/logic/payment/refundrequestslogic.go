@@ -0,0 +1,120 @@
+package payment
+
+import (
+	"context"
+	"fmt"
+
+	"lxtian-blog/admin/internal/svc"
+	"lxtian-blog/admin/internal/types"
+	"lxtian-blog/common/model"
+	"lxtian-blog/common/repository/payment_repo"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type RefundRequestsLogic struct {
+	logx.Logger
+	ctx            context.Context
+	svcCtx         *svc.ServiceContext
+	requestService payment_repo.LxtPaymentRefundRequestsRepo
+}
+
+// 退款申请列表
+func NewRefundRequestsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RefundRequestsLogic {
+	return &RefundRequestsLogic{
+		Logger:         logx.WithContext(ctx),
+		ctx:            ctx,
+		svcCtx:         svcCtx,
+		requestService: payment_repo.NewLxtPaymentRefundRequestsRepo(svcCtx.DB),
+	}
+}
+
+func (l *RefundRequestsLogic) RefundRequests(req *types.RefundRequestsReq) (resp *types.RefundRequestsResp, err error) {
+	// 参数验证
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.PageSize <= 0 {
+		req.PageSize = 10
+	}
+	if req.PageSize > 100 {
+		req.PageSize = 100 // 限制最大每页数量
+	}
+
+	// 构建查询条件
+	condition := make(map[string]interface{})
+	if req.PaymentId != "" {
+		condition["payment_id = ?"] = req.PaymentId
+	}
+	if req.Status != "" {
+		condition["status = ?"] = req.Status
+	}
+
+	start, end, err := parseTimeRange(req.StartTime, req.EndTime)
+	if err != nil {
+		return nil, err
+	}
+	if !start.IsZero() {
+		condition["created_at >= ?"] = start
+	}
+	if !end.IsZero() {
+		condition["created_at <= ?"] = end
+	}
+
+	requests, total, err := l.requestService.GetList(l.ctx, condition, req.Page, req.PageSize, "id desc", "")
+	if err != nil {
+		l.Errorf("Failed to get refund requests: %v", err)
+		return nil, fmt.Errorf("failed to get refund requests: %w", err)
+	}
+
+	list := make([]map[string]interface{}, 0, len(requests))
+	for _, request := range requests {
+		list = append(list, buildRefundRequestItem(request))
+	}
+
+	return &types.RefundRequestsResp{
+		Page:     req.Page,
+		PageSize: req.PageSize,
+		Total:    total,
+		List:     list,
+	}, nil
+}
+
+// 构建退款申请项
+func buildRefundRequestItem(request *model.LxtPaymentRefundRequest) map[string]interface{} {
+	item := map[string]interface{}{
+		"id":             request.ID,
+		"request_id":     request.RequestID,
+		"payment_id":     request.PaymentID,
+		"order_sn":       request.OrderSn,
+		"user_id":        request.UserID,
+		"refund_amount":  request.RefundAmount,
+		"refund_reason":  request.RefundReason,
+		"status":         request.Status,
+		"requester_id":   request.RequesterID,
+		"requester_name": request.RequesterName,
+		"created_at":     request.CreatedAt.Format("2006-01-02 15:04:05"),
+		"updated_at":     request.UpdatedAt.Format("2006-01-02 15:04:05"),
+	}
+
+	if request.ReviewerID != nil {
+		item["reviewer_id"] = *request.ReviewerID
+	}
+	if request.ReviewerName != nil {
+		item["reviewer_name"] = *request.ReviewerName
+	}
+	if request.ReviewRemark != nil {
+		item["review_remark"] = *request.ReviewRemark
+	}
+	if request.ReviewedAt != nil {
+		item["reviewed_at"] = request.ReviewedAt.Format("2006-01-02 15:04:05")
+	}
+	if request.RefundID != nil {
+		item["refund_id"] = *request.RefundID
+	}
+	if request.ErrorMessage != nil {
+		item["error_message"] = *request.ErrorMessage
+	}
+
+	return item
+}
@@ -2,9 +2,11 @@ package payment
 
 import (
 	"context"
+	"fmt"
 
 	"lxtian-blog/admin/internal/svc"
 	"lxtian-blog/admin/internal/types"
+	"lxtian-blog/rpc/payment/paymentclient"
 
 	"github.com/zeromicro/go-zero/core/logx"
 )
@@ -25,7 +27,24 @@ func NewResendNotifyLogic(ctx context.Context, svcCtx *svc.ServiceContext) *Rese
 }
 
 func (l *ResendNotifyLogic) ResendNotify(req *types.ResendNotifyReq) (resp *types.ResendNotifyResp, err error) {
-	// todo: add your logic here and delete this line
+	if req.NotifyId == "" && req.PaymentId == "" {
+		return nil, fmt.Errorf("通知ID和支付ID至少提供一个")
+	}
+
+	// 调用支付服务重放已保存的通知（重新验签并处理，已支付订单幂等）
+	res, err := l.svcCtx.PaymentRpc.ReplayNotify(l.ctx, &paymentclient.ReplayNotifyReq{
+		NotifyId:  req.NotifyId,
+		PaymentId: req.PaymentId,
+	})
+	if err != nil {
+		l.Errorf("Failed to replay payment notify: notifyId=%s, paymentId=%s, err=%v", req.NotifyId, req.PaymentId, err)
+		return nil, err
+	}
+
+	l.Infof("Replayed payment notify: notifyId=%s, success=%v, message=%s", res.NotifyId, res.Success, res.Message)
 
-	return
+	return &types.ResendNotifyResp{
+		Data:    res.Success,
+		Message: res.Message,
+	}, nil
 }
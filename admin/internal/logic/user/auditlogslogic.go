@@ -0,0 +1,96 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"lxtian-blog/admin/internal/svc"
+	"lxtian-blog/admin/internal/types"
+	"lxtian-blog/common/repository/admin_repo"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type AuditLogsLogic struct {
+	logx.Logger
+	ctx          context.Context
+	svcCtx       *svc.ServiceContext
+	auditService admin_repo.LxtAdminAuditLogsRepo
+}
+
+// 审计日志
+func NewAuditLogsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AuditLogsLogic {
+	return &AuditLogsLogic{
+		Logger:       logx.WithContext(ctx),
+		ctx:          ctx,
+		svcCtx:       svcCtx,
+		auditService: admin_repo.NewLxtAdminAuditLogsRepo(svcCtx.DB),
+	}
+}
+
+func (l *AuditLogsLogic) AuditLogs(req *types.AuditLogsReq) (resp *types.AuditLogsResp, err error) {
+	// 参数验证
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.PageSize <= 0 {
+		req.PageSize = 10
+	}
+	if req.PageSize > 100 {
+		req.PageSize = 100 // 限制最大每页数量
+	}
+
+	filter := admin_repo.AuditLogFilter{
+		UserId:   req.UserId,
+		Username: req.Username,
+		Entity:   req.Entity,
+		EntityId: req.EntityId,
+	}
+	if req.StartTime != "" {
+		if filter.StartTime, err = time.ParseInLocation(time.DateTime, req.StartTime, time.Local); err != nil {
+			return nil, fmt.Errorf("开始时间格式错误，应为 2006-01-02 15:04:05")
+		}
+	}
+	if req.EndTime != "" {
+		if filter.EndTime, err = time.ParseInLocation(time.DateTime, req.EndTime, time.Local); err != nil {
+			return nil, fmt.Errorf("结束时间格式错误，应为 2006-01-02 15:04:05")
+		}
+	}
+
+	logs, total, err := l.auditService.Search(l.ctx, filter, req.Page, req.PageSize)
+	if err != nil {
+		l.Errorf("Failed to search audit logs: %v", err)
+		return nil, fmt.Errorf("failed to search audit logs: %w", err)
+	}
+
+	list := make([]map[string]interface{}, 0, len(logs))
+	for _, log := range logs {
+		list = append(list, map[string]interface{}{
+			"id":          log.ID,
+			"user_id":     log.UserID,
+			"username":    log.Username,
+			"method":      log.Method,
+			"route":       log.Route,
+			"path":        log.Path,
+			"entity":      log.Entity,
+			"entity_id":   log.EntityID,
+			"before_data": log.BeforeData,
+			"after_data":  log.AfterData,
+			"request":     log.Request,
+			"client_ip":   log.ClientIP,
+			"status_code": log.StatusCode,
+			"result":      log.Result,
+			"error_msg":   log.ErrorMsg,
+			"duration":    log.Duration,
+			"created_at":  log.CreatedAt.Format("2006-01-02 15:04:05"),
+		})
+	}
+
+	return &types.AuditLogsResp{
+		Page:     req.Page,
+		PageSize: req.PageSize,
+		Total:    total,
+		List:     list,
+	}, nil
+}
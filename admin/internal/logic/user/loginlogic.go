@@ -2,14 +2,14 @@ package user
 
 import (
 	"context"
-	"encoding/base64"
 	"errors"
 	"gorm.io/gorm"
 	"lxtian-blog/admin/internal/svc"
 	"lxtian-blog/admin/internal/types"
 	"lxtian-blog/common/pkg/jwts"
 	"lxtian-blog/common/pkg/model/mysql"
-	"lxtian-blog/common/pkg/utils"
+	"lxtian-blog/common/pkg/password"
+	"lxtian-blog/common/repository/user_repo"
 	"strings"
 
 	"github.com/zeromicro/go-zero/core/logx"
@@ -34,12 +34,13 @@ func (l *LoginLogic) Login(req *types.LoginReq) (resp *types.LoginResp, err erro
 	//var txyUser mysql.TxyUser
 	var result struct {
 		mysql.TxyUser
-		Key         string `json:"key"`
-		Permissions string `json:"permissions"`
+		Key                   string `json:"key"`
+		Permissions           string `json:"permissions"`
+		PasswordResetRequired int64  `json:"password_reset_required"`
 	}
 	err = l.svcCtx.DB.
 		Model(&mysql.TxyUser{}).
-		Select("txy_user.id,nickname,username,password,is_admin,head_img,type,r.key,GROUP_CONCAT(rp.perm_id) AS permissions").
+		Select("txy_user.id,nickname,username,password,password_reset_required,is_admin,head_img,type,r.key,GROUP_CONCAT(rp.perm_id) AS permissions").
 		Joins("left join txy_user_roles as ur on ur.user_id = txy_user.id").
 		Joins("left join txy_roles as r on r.id = ur.role_id").
 		Joins("left join txy_role_permissions  as rp on rp.role_id = r.id").
@@ -55,31 +56,38 @@ func (l *LoginLogic) Login(req *types.LoginReq) (resp *types.LoginResp, err erro
 
 	permList := strings.Split(result.Permissions, ",")
 
-	// 数据库密码解密
-	decodedBytes, err := base64.StdEncoding.DecodeString(result.Password)
-	if err != nil {
-		return nil, err
+	// 旧版密码长期未登录升级，已被清空，需要重置后才能登录
+	if result.PasswordResetRequired == 1 {
+		return nil, errors.New("密码已失效，请联系管理员重置密码")
 	}
-	decryptedText, err := utils.Decrypt(decodedBytes)
+	// 校验密码
+	ok, needsRehash, err := password.Verify(req.Password, result.Password)
 	if err != nil {
-		return nil, err
+		l.Errorf("Failed to verify password: userId=%d, err=%v", result.Id, err)
+		return nil, errors.New("密码错误！")
 	}
-	if req.Password != decryptedText {
+	if !ok {
 		return nil, errors.New("密码错误！")
 	}
+	// 旧版 AES 密码或过时参数的哈希，登录成功后升级为新哈希
+	if needsRehash {
+		l.upgradePassword(result.Id, result.Password, req.Password)
+	}
 
-	// 获取token
-	auth := l.svcCtx.Config.Auth
-	token, err := jwts.GenToken(jwts.JwtPayLoad{
+	// 签发短期访问令牌和可轮换的刷新令牌
+	tokens, err := l.svcCtx.Sessions.IssueTokens(l.ctx, jwts.JwtPayLoad{
 		UserID:   uint(result.Id),
 		Username: result.Username,
-	}, auth.AccessSecret, auth.AccessExpire)
+	})
 	if err != nil {
 		return nil, err
 	}
 
 	resp = new(types.LoginResp)
-	resp.Token = token
+	resp.Token = tokens.AccessToken
+	resp.ExpiresIn = uint64(tokens.ExpiresIn)
+	resp.RefreshToken = tokens.RefreshToken
+	resp.RefreshExpiresIn = uint64(tokens.RefreshExpiresIn)
 	resp.User = types.User{
 		Id:          int(result.Id),
 		Username:    result.Username,
@@ -88,3 +96,20 @@ func (l *LoginLogic) Login(req *types.LoginReq) (resp *types.LoginResp, err erro
 	}
 	return
 }
+
+// upgradePassword 将用户密码升级为新哈希，失败只记录日志，不影响本次登录
+func (l *LoginLogic) upgradePassword(userId uint64, oldPassword, plain string) {
+	hash, err := password.Hash(plain)
+	if err != nil {
+		l.Errorf("Failed to hash password: userId=%d, err=%v", userId, err)
+		return
+	}
+	upgraded, err := user_repo.NewTxyUserRepository(l.svcCtx.DB).UpgradePassword(l.ctx, userId, oldPassword, hash)
+	if err != nil {
+		l.Errorf("Failed to upgrade password: userId=%d, err=%v", userId, err)
+		return
+	}
+	if upgraded {
+		l.Infof("Upgraded password hash: userId=%d", userId)
+	}
+}
@@ -0,0 +1,45 @@
+package user
+
+import (
+	"context"
+	"errors"
+
+	"lxtian-blog/admin/internal/svc"
+	"lxtian-blog/admin/internal/types"
+	"lxtian-blog/common/pkg/jwts"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type LogoutLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 退出登录
+func NewLogoutLogic(ctx context.Context, svcCtx *svc.ServiceContext) *LogoutLogic {
+	return &LogoutLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// Logout 吊销当前后台登录令牌
+func (l *LogoutLogic) Logout() (resp *types.LogoutResp, err error) {
+	claims, ok := l.ctx.Value("jwt_claims").(*jwts.CustomClaims)
+	if !ok {
+		return nil, errors.New("jwt_claims not found in context")
+	}
+
+	if err = l.svcCtx.Sessions.Logout(l.ctx, claims); err != nil {
+		l.Errorf("后台退出登录失败: user_id=%d, err=%v", claims.UserID, err)
+		return nil, err
+	}
+	l.Infof("后台退出登录成功: user_id=%d", claims.UserID)
+
+	resp = new(types.LogoutResp)
+	resp.Data = true
+	return
+}
@@ -3,9 +3,11 @@ package user
 import (
 	"context"
 	"errors"
+	"lxtian-blog/admin/internal/rbac"
 	"lxtian-blog/admin/internal/svc"
 	"lxtian-blog/admin/internal/types"
 	"lxtian-blog/common/pkg/model/mysql"
+	"lxtian-blog/common/restful/response"
 
 	"github.com/zeromicro/go-zero/core/logx"
 )
@@ -29,41 +31,78 @@ func (l *MenusLogic) Menus(req *types.MenusReq) (resp *types.MenusResp, err erro
 	if req.Perm != "menu" && req.Perm != "" {
 		return nil, errors.New("invalid permission")
 	}
+	userId, ok := l.ctx.Value("user_id").(uint)
+	if !ok || userId == 0 {
+		return nil, response.ErrTokenInvalid
+	}
+	access, err := l.svcCtx.Permissions.Load(l.ctx, userId)
+	if err != nil {
+		l.Errorf("加载用户权限失败: user_id=%d, err=%v", userId, err)
+		return nil, err
+	}
+
+	var results []MenuWithPerm
+	err = l.svcCtx.DB.Table("txy_menu").
+		Select("txy_menu.id, txy_menu.title, txy_menu.pid, txy_menu.`index`, txy_menu.icon, txy_menu.permiss, txy_menu.sort, txy_permissions.id as perm_id").
+		Joins("LEFT JOIN txy_permissions ON txy_permissions.menu_id = txy_menu.id AND txy_permissions.type = ?", "menu").
+		Group("txy_menu.id").
+		Order("txy_menu.sort ASC").
+		Scan(&results).Error
+	if err != nil {
+		return nil, err
+	}
+
 	resp = new(types.MenusResp)
-	db := l.svcCtx.DB.Table("txy_menu").Order("txy_menu.sort ASC")
-	if req.Perm != "" {
-		var results []MenuWithPerm
-		err = db.Select("txy_menu.id, txy_menu.title, txy_menu.pid, txy_menu.`index`, txy_menu.icon, txy_menu.permiss, txy_menu.sort, txy_permissions.id as perm_id").
-			Joins("LEFT JOIN txy_permissions ON txy_permissions.menu_id = txy_menu.id AND txy_permissions.type = ?", "menu").
-			Group("txy_menu.id").
-			Scan(&results).Error
-		if err != nil {
-			return nil, err
+	for _, item := range visibleMenus(results, access) {
+		data := map[string]interface{}{
+			"id":      item.Id,
+			"title":   item.Title,
+			"pid":     item.Pid,
+			"index":   item.Index,
+			"icon":    item.Icon,
+			"permiss": item.Permiss,
+			"sort":    item.Sort,
+		}
+		if req.Perm != "" {
+			data["perm_id"] = item.PermId
 		}
+		resp.Data = append(resp.Data, data)
+	}
+	return
+}
 
-		for _, item := range results {
-			resp.Data = append(resp.Data, map[string]interface{}{
-				"id":      item.Id,
-				"title":   item.Title,
-				"pid":     item.Pid,
-				"index":   item.Index,
-				"icon":    item.Icon,
-				"permiss": item.Permiss,
-				"sort":    item.Sort,
-				"perm_id": item.PermId,
-			})
+// visibleMenus 过滤出用户可见的菜单：菜单关联了菜单权限时需拥有该权限，且上级菜单可见
+func visibleMenus(menus []MenuWithPerm, access *rbac.Access) []MenuWithPerm {
+	if access.IsAdmin {
+		return menus
+	}
+	byId := make(map[uint64]*MenuWithPerm, len(menus))
+	for i := range menus {
+		byId[menus[i].Id] = &menus[i]
+	}
+	visible := make(map[uint64]bool, len(menus))
+	var check func(menu *MenuWithPerm, depth int) bool
+	check = func(menu *MenuWithPerm, depth int) bool {
+		if v, ok := visible[menu.Id]; ok {
+			return v
 		}
-	} else {
-		var results []map[string]interface{}
-		err = db.Select("id, title, pid, `index`, icon, permiss, sort").
-			Order("sort ASC").
-			Find(&results).Error
-		if err != nil {
-			return nil, err
+		v := menu.PermId == 0 || access.HasPermId(uint64(menu.PermId))
+		// depth 限制防止菜单数据中存在循环引用
+		if v && menu.Pid != 0 {
+			parent, ok := byId[uint64(menu.Pid)]
+			v = ok && depth < len(menus) && check(parent, depth+1)
 		}
-		resp.Data = results
+		visible[menu.Id] = v
+		return v
 	}
-	return
+
+	list := make([]MenuWithPerm, 0, len(menus))
+	for i := range menus {
+		if check(&menus[i], 0) {
+			list = append(list, menus[i])
+		}
+	}
+	return list
 }
 
 type MenuWithPerm struct {
@@ -41,7 +41,7 @@ func (l *PremSaveLogic) PremSave(req *types.PremSaveReq) (resp *types.PremSaveRe
 	}()
 
 	// 1. 删除旧权限
-	if err = tx.Delete(&mysql.TxyRolePermissions{}, req.RoleId).Error; err != nil {
+	if err = tx.Where("role_id = ?", req.RoleId).Delete(&mysql.TxyRolePermissions{}).Error; err != nil {
 		tx.Rollback()
 		return nil, err
 	}
@@ -54,9 +54,11 @@ func (l *PremSaveLogic) PremSave(req *types.PremSaveReq) (resp *types.PremSaveRe
 			PermId: uint64(permId),
 		})
 	}
-	if err := tx.Create(&rolePermissions).Error; err != nil {
-		tx.Rollback()
-		return nil, err
+	if len(rolePermissions) > 0 {
+		if err := tx.Create(&rolePermissions).Error; err != nil {
+			tx.Rollback()
+			return nil, err
+		}
 	}
 
 	// 3. 提交事务
@@ -64,6 +66,11 @@ func (l *PremSaveLogic) PremSave(req *types.PremSaveReq) (resp *types.PremSaveRe
 		return nil, err
 	}
 
+	// 4. 角色权限已变更，清除用户权限缓存
+	if err := l.svcCtx.Permissions.Invalidate(l.ctx); err != nil {
+		l.Errorf("清除权限缓存失败: role_id=%d, err=%v", req.RoleId, err)
+	}
+
 	resp = new(types.PremSaveResp)
 	resp.Data = true
 	return
@@ -0,0 +1,51 @@
+package user
+
+import (
+	"context"
+	"errors"
+
+	"lxtian-blog/admin/internal/svc"
+	"lxtian-blog/admin/internal/types"
+	"lxtian-blog/common/pkg/jwts"
+	"lxtian-blog/common/restful/response"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type TokenRefreshLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 刷新令牌
+func NewTokenRefreshLogic(ctx context.Context, svcCtx *svc.ServiceContext) *TokenRefreshLogic {
+	return &TokenRefreshLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// TokenRefresh 使用刷新令牌换取新的后台访问令牌和刷新令牌
+func (l *TokenRefreshLogic) TokenRefresh(req *types.TokenRefreshReq) (resp *types.TokenRefreshResp, err error) {
+	tokens, err := l.svcCtx.Sessions.Refresh(l.ctx, req.RefreshToken)
+	if err != nil {
+		if errors.Is(err, jwts.ErrRefreshTokenReused) {
+			l.Errorf("Admin refresh token reused, session revoked")
+			return nil, response.ErrTokenInvalid
+		}
+		if errors.Is(err, jwts.ErrRefreshTokenInvalid) {
+			return nil, response.ErrTokenInvalid
+		}
+		l.Errorf("Failed to refresh admin token: %v", err)
+		return nil, err
+	}
+
+	return &types.TokenRefreshResp{
+		AccessToken:      tokens.AccessToken,
+		ExpiresIn:        uint64(tokens.ExpiresIn),
+		RefreshToken:     tokens.RefreshToken,
+		RefreshExpiresIn: uint64(tokens.RefreshExpiresIn),
+	}, nil
+}
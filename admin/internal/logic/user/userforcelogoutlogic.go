@@ -0,0 +1,45 @@
+package user
+
+import (
+	"context"
+	"errors"
+
+	"lxtian-blog/admin/internal/svc"
+	"lxtian-blog/admin/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UserForceLogoutLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 强制用户下线
+func NewUserForceLogoutLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UserForceLogoutLogic {
+	return &UserForceLogoutLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// UserForceLogout 吊销用户此前签发的全部访问令牌和刷新令牌，前台和后台登录同时失效
+func (l *UserForceLogoutLogic) UserForceLogout(req *types.UserForceLogoutReq) (resp *types.UserForceLogoutResp, err error) {
+	if req.UserId <= 0 {
+		return nil, errors.New("用户ID必须大于0")
+	}
+
+	if err = l.svcCtx.Sessions.RevokeUser(l.ctx, uint(req.UserId)); err != nil {
+		l.Errorf("强制用户下线失败: user_id=%d, err=%v", req.UserId, err)
+		return nil, err
+	}
+
+	operatorId, _ := l.ctx.Value("user_id").(uint)
+	l.Infof("强制用户下线成功: user_id=%d, operator=%d", req.UserId, operatorId)
+
+	resp = new(types.UserForceLogoutResp)
+	resp.Data = true
+	return
+}
@@ -2,13 +2,13 @@ package user
 
 import (
 	"context"
-	"encoding/base64"
 	"errors"
 	"fmt"
 	"lxtian-blog/admin/internal/svc"
 	"lxtian-blog/admin/internal/types"
 	"lxtian-blog/common/pkg/model/mysql"
-	"lxtian-blog/common/pkg/utils"
+	"lxtian-blog/common/pkg/password"
+	"lxtian-blog/common/restful/response"
 	"time"
 
 	"github.com/zeromicro/go-zero/core/logx"
@@ -47,6 +47,43 @@ func (l *UserSaveLogic) UserSave(req *types.UserSaveReq) (resp *types.UserSaveRe
 		return nil, err
 	}
 
+	// 非超级管理员不能修改超级管理员账号，也不能修改其他用户的密码，避免改密后登录更高权限的账号
+	callerId, ok := l.ctx.Value("user_id").(uint)
+	if !ok {
+		return nil, errors.New("user_id not found in context")
+	}
+	access, err := l.svcCtx.Permissions.Load(l.ctx, callerId)
+	if err != nil {
+		l.Errorf("加载操作人权限失败: user_id=%d, err=%v", callerId, err)
+		return nil, err
+	}
+	if !access.IsAdmin {
+		if user.IsAdmin == 1 {
+			l.Infof("拒绝修改超级管理员账号: operator=%d, id=%d", callerId, req.Id)
+			return nil, response.ErrForbidden
+		}
+		if req.Password != "" && uint64(callerId) != user.Id {
+			l.Infof("拒绝修改其他用户密码: operator=%d, id=%d", callerId, req.Id)
+			return nil, response.ErrForbidden
+		}
+		// 角色决定用户的全部权限，只允许超级管理员变更，提交原角色时视为未修改
+		if req.RoleId > 0 {
+			var roleIds []int
+			err = l.svcCtx.DB.Table("txy_user_roles").
+				Where("user_id = ?", req.Id).
+				Pluck("role_id", &roleIds).Error
+			if err != nil {
+				l.Errorf("查询用户角色失败: user_id=%d, err=%v", req.Id, err)
+				return nil, err
+			}
+			if len(roleIds) != 1 || roleIds[0] != req.RoleId {
+				l.Infof("拒绝变更用户角色: operator=%d, id=%d, role_id=%d", callerId, req.Id, req.RoleId)
+				return nil, response.ErrForbidden
+			}
+			req.RoleId = 0
+		}
+	}
+
 	// 构建更新字段，只包含非默认值的字段
 	updates := make(map[string]interface{})
 
@@ -55,14 +92,15 @@ func (l *UserSaveLogic) UserSave(req *types.UserSaveReq) (resp *types.UserSaveRe
 		updates["nickname"] = req.Nickname
 	}
 
-	// 密码：如果提供了且不为空，则加密并更新
+	// 密码：如果提供了且不为空，则生成哈希并更新，同时清除强制重置标记
 	if req.Password != "" {
-		encryptedBytes, err := utils.Encrypt([]byte(req.Password))
+		passwordHash, err := password.Hash(req.Password)
 		if err != nil {
-			l.Errorf("密码加密失败: err=%v", err)
-			return nil, fmt.Errorf("密码加密失败: %w", err)
+			l.Errorf("密码哈希失败: err=%v", err)
+			return nil, fmt.Errorf("密码哈希失败: %w", err)
 		}
-		updates["password"] = base64.StdEncoding.EncodeToString(encryptedBytes)
+		updates["password"] = passwordHash
+		updates["password_reset_required"] = 0
 	}
 
 	// 邮箱：如果提供了且不为空，则更新
@@ -122,6 +160,11 @@ func (l *UserSaveLogic) UserSave(req *types.UserSaveReq) (resp *types.UserSaveRe
 			return nil, err
 		}
 		l.Infof("更新用户角色关联成功: user_id=%d, role_id=%d", req.Id, req.RoleId)
+
+		// 用户角色已变更，清除用户权限缓存
+		if err := l.svcCtx.Permissions.Invalidate(l.ctx); err != nil {
+			l.Errorf("清除权限缓存失败: user_id=%d, err=%v", req.Id, err)
+		}
 	}
 
 	resp = new(types.UserSaveResp)
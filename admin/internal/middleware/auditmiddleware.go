@@ -0,0 +1,81 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"lxtian-blog/admin/internal/audit"
+	"lxtian-blog/admin/internal/rbac"
+	"net/http"
+	"strings"
+)
+
+const (
+	// auditMaxRequestBody 审计记录的请求体最大字节数，超出时不记录请求参数
+	auditMaxRequestBody = 64 << 10
+	// auditMaxResponseBody 用于判断操作结果的响应体最大字节数
+	auditMaxResponseBody = 4 << 10
+)
+
+type AuditMiddleware struct {
+	recorder    *audit.Recorder
+	permissions *rbac.Loader
+}
+
+func NewAuditMiddleware(recorder *audit.Recorder, permissions *rbac.Loader) *AuditMiddleware {
+	return &AuditMiddleware{
+		recorder:    recorder,
+		permissions: permissions,
+	}
+}
+
+// Handle 记录非 GET 请求的审计日志，需在 JwtMiddleware 之后、PermissionMiddleware 之前执行
+// 无权限的操作也会被记录，但不查询操作对象的快照
+func (m *AuditMiddleware) Handle(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet || r.Method == http.MethodHead || r.Method == http.MethodOptions {
+			next(w, r)
+			return
+		}
+
+		var body []byte
+		if r.Body != nil && !strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/") {
+			body, _ = io.ReadAll(io.LimitReader(r.Body, auditMaxRequestBody+1))
+			r.Body = io.NopCloser(io.MultiReader(bytes.NewReader(body), r.Body))
+			if len(body) > auditMaxRequestBody {
+				body = nil
+			}
+		}
+
+		// 将被 PermissionMiddleware 拒绝的请求不查询操作对象，避免无权限的请求读取用户等数据
+		userId, _ := r.Context().Value("user_id").(uint)
+		allowed, err := m.permissions.CanAccess(r.Context(), userId, r.Method, r.URL.Path)
+		op := m.recorder.Begin(r, body, err == nil && allowed)
+		aw := &auditResponseWriter{ResponseWriter: w, statusCode: http.StatusOK}
+		next(aw, r)
+		// 请求已结束，使用不随请求取消的 context 写入日志
+		op.Finish(context.WithoutCancel(r.Context()), aw.statusCode, aw.body.Bytes())
+	}
+}
+
+// auditResponseWriter 记录响应状态码和响应体开头部分
+type auditResponseWriter struct {
+	http.ResponseWriter
+	statusCode int
+	body       bytes.Buffer
+}
+
+func (w *auditResponseWriter) WriteHeader(statusCode int) {
+	w.statusCode = statusCode
+	w.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (w *auditResponseWriter) Write(p []byte) (int, error) {
+	if remain := auditMaxResponseBody - w.body.Len(); remain > 0 {
+		if len(p) < remain {
+			remain = len(p)
+		}
+		w.body.Write(p[:remain])
+	}
+	return w.ResponseWriter.Write(p)
+}
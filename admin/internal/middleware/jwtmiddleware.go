@@ -3,24 +3,22 @@ package middleware
 import (
 	"context"
 	"errors"
-	"fmt"
 	"github.com/zeromicro/go-zero/core/logc"
 	"lxtian-blog/common/pkg/jwts"
 	"lxtian-blog/common/restful/response"
 	"net/http"
 	"strings"
-	"time"
 )
 
 type JwtMiddleware struct {
-	accessSecret string
-	accessExpire int64
+	keySet   *jwts.KeySet
+	sessions *jwts.SessionManager
 }
 
-func NewJwtMiddleware(accessSecret string, accessExpire int64) *JwtMiddleware {
+func NewJwtMiddleware(keySet *jwts.KeySet, sessions *jwts.SessionManager) *JwtMiddleware {
 	return &JwtMiddleware{
-		accessSecret: accessSecret,
-		accessExpire: accessExpire,
+		keySet:   keySet,
+		sessions: sessions,
 	}
 }
 
@@ -40,23 +38,27 @@ func (m *JwtMiddleware) Handle(next http.HandlerFunc) http.HandlerFunc {
 			response.Response(r, w, nil, errors.New("请求头中token格式有误"))
 			return
 		}
-		claims, err := jwts.ParseToken(parts[1], m.accessSecret, m.accessExpire)
+		claims, err := m.keySet.ParseToken(parts[1])
 		if err != nil {
 			logc.Errorf(r.Context(), "JwtMiddleware error: %s", err)
 			response.Response(r, w, nil, response.ErrTokenInvalid)
 			return
 		}
-		isExpire := claims.ExpiresAt.Before(time.Now())
-		if isExpire {
-			token, _ := jwts.GenToken(jwts.JwtPayLoad{
-				UserID:   claims.UserID,
-				Username: claims.Username,
-				Role:     1,
-			}, m.accessSecret, m.accessExpire)
-			w.Header().Set("Authorization", fmt.Sprintf("Bearer %s", token))
+		// 过期的 token 由解析时校验拒绝，客户端需使用刷新令牌换取新 token；此处再校验是否已退出登录或被强制下线
+		revoked, err := m.sessions.IsRevoked(r.Context(), claims)
+		if err != nil {
+			logc.Errorf(r.Context(), "JwtMiddleware check revoked error: %s", err)
+			response.Response(r, w, nil, errors.New("登录状态校验失败，请稍后重试"))
+			return
+		}
+		if revoked {
+			logc.Infof(r.Context(), "JwtMiddleware: token已吊销, user_id=%d, jti=%s", claims.UserID, claims.ID)
+			response.Response(r, w, nil, response.ErrTokenInvalid)
+			return
 		}
 		r = r.WithContext(context.WithValue(r.Context(), "user_id", claims.UserID))
 		r = r.WithContext(context.WithValue(r.Context(), "username", claims.Username))
+		r = r.WithContext(context.WithValue(r.Context(), "jwt_claims", claims))
 		next(w, r)
 	}
 }
@@ -0,0 +1,48 @@
+package middleware
+
+import (
+	"errors"
+	"github.com/zeromicro/go-zero/core/logc"
+	"lxtian-blog/admin/internal/rbac"
+	"lxtian-blog/common/restful/response"
+	"net/http"
+)
+
+type PermissionMiddleware struct {
+	permissions *rbac.Loader
+}
+
+func NewPermissionMiddleware(permissions *rbac.Loader) *PermissionMiddleware {
+	return &PermissionMiddleware{
+		permissions: permissions,
+	}
+}
+
+// Handle 按路由所需的权限标识校验当前用户的角色权限，需在 JwtMiddleware 之后执行
+func (m *PermissionMiddleware) Handle(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		code, registered := rbac.RequiredCode(r.Method, r.URL.Path)
+		if registered && code == rbac.CodeAny {
+			next(w, r)
+			return
+		}
+
+		userId, ok := r.Context().Value("user_id").(uint)
+		if !ok || userId == 0 {
+			response.Response(r, w, nil, response.ErrTokenInvalid)
+			return
+		}
+		allowed, err := m.permissions.CanAccess(r.Context(), userId, r.Method, r.URL.Path)
+		if err != nil {
+			logc.Errorf(r.Context(), "PermissionMiddleware load permissions error: user_id=%d, err=%s", userId, err)
+			response.Response(r, w, nil, errors.New("权限校验失败，请稍后重试"))
+			return
+		}
+		if !allowed {
+			logc.Infof(r.Context(), "PermissionMiddleware: 无权限访问, user_id=%d, %s %s, code=%s", userId, r.Method, r.URL.Path, code)
+			response.Response(r, w, nil, response.ErrForbidden)
+			return
+		}
+		next(w, r)
+	}
+}
@@ -0,0 +1,93 @@
+package pathmatch
+
+import (
+	"sort"
+	"strings"
+)
+
+// route 已登记的路由
+type route[T any] struct {
+	method   string
+	pattern  string
+	segments []string
+	value    T
+}
+
+// Table 按 "METHOD /path/:param" 格式登记路由，根据请求方法和路径查找登记的值
+type Table[T any] struct {
+	routes []route[T]
+}
+
+// Match 路由匹配结果
+type Match[T any] struct {
+	Pattern string            // 登记的路由，如 /admin/tag/:id
+	Params  map[string]string // 路径参数
+	Value   T                 // 登记的值
+}
+
+// New 创建路由表，静态段多的路由优先匹配
+func New[T any](m map[string]T) *Table[T] {
+	routes := make([]route[T], 0, len(m))
+	for key, value := range m {
+		method, pattern, _ := strings.Cut(key, " ")
+		routes = append(routes, route[T]{
+			method:   method,
+			pattern:  pattern,
+			segments: splitPath(pattern),
+			value:    value,
+		})
+	}
+	sort.SliceStable(routes, func(i, j int) bool {
+		return countParams(routes[i].segments) < countParams(routes[j].segments)
+	})
+	return &Table[T]{routes: routes}
+}
+
+// Lookup 查找请求对应的路由，ok 为 false 表示路由未登记
+func (t *Table[T]) Lookup(method, path string) (match Match[T], ok bool) {
+	segments := splitPath(path)
+	for _, r := range t.routes {
+		if r.method != method {
+			continue
+		}
+		if params, ok := matchSegments(r.segments, segments); ok {
+			return Match[T]{Pattern: r.pattern, Params: params, Value: r.value}, true
+		}
+	}
+	return match, false
+}
+
+// matchSegments 按路径段匹配，":" 开头的段匹配任意值
+func matchSegments(pattern, segments []string) (map[string]string, bool) {
+	if len(pattern) != len(segments) {
+		return nil, false
+	}
+	var params map[string]string
+	for i, p := range pattern {
+		if name, ok := strings.CutPrefix(p, ":"); ok {
+			if params == nil {
+				params = make(map[string]string)
+			}
+			params[name] = segments[i]
+			continue
+		}
+		if p != segments[i] {
+			return nil, false
+		}
+	}
+	return params, true
+}
+
+func countParams(segments []string) int {
+	n := 0
+	for _, s := range segments {
+		if strings.HasPrefix(s, ":") {
+			n++
+		}
+	}
+	return n
+}
+
+func splitPath(path string) []string {
+	return strings.Split(strings.Trim(path, "/"), "/")
+}
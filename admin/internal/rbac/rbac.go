@@ -0,0 +1,127 @@
+package rbac
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	redisutil "lxtian-blog/common/pkg/redis"
+	"lxtian-blog/common/repository/user_repo"
+
+	"github.com/zeromicro/go-zero/core/logc"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+	"gorm.io/gorm"
+)
+
+// cacheExpire 用户权限缓存有效期（秒）
+const cacheExpire = 600
+
+// Access 后台用户的权限集合
+type Access struct {
+	IsAdmin bool     `json:"is_admin"` // 超级管理员（txy_user.is_admin=1），拥有全部权限
+	PermIds []uint64 `json:"perm_ids"` // 拥有的权限ID
+	Codes   []string `json:"codes"`    // 拥有的权限标识
+}
+
+// HasCode 判断是否拥有权限标识
+func (a *Access) HasCode(code string) bool {
+	if a.IsAdmin {
+		return true
+	}
+	for _, c := range a.Codes {
+		if c == code {
+			return true
+		}
+	}
+	return false
+}
+
+// HasPermId 判断是否拥有权限ID
+func (a *Access) HasPermId(permId uint64) bool {
+	if a.IsAdmin {
+		return true
+	}
+	for _, id := range a.PermIds {
+		if id == permId {
+			return true
+		}
+	}
+	return false
+}
+
+// Loader 加载后台用户的角色权限，结果按权限版本缓存在 Redis 中
+// 角色权限或用户角色变更时调用 Invalidate 递增版本，所有用户的缓存随即失效
+type Loader struct {
+	rds *redis.Redis
+	db  *gorm.DB
+}
+
+// NewLoader 创建权限加载器
+func NewLoader(rds *redis.Redis, db *gorm.DB) *Loader {
+	return &Loader{
+		rds: rds,
+		db:  db,
+	}
+}
+
+// Load 获取用户的权限集合，缓存读写失败时直接查库，不影响鉴权
+func (l *Loader) Load(ctx context.Context, userId uint) (*Access, error) {
+	version, err := l.rds.GetCtx(ctx, redisutil.ReturnRedisKey(redisutil.AdminPermissionVersion, nil))
+	if err != nil {
+		logc.Errorf(ctx, "rbac get permission version error: %s", err)
+	}
+	if version == "" {
+		version = "0"
+	}
+	key := redisutil.ReturnRedisKey(redisutil.AdminPermission, fmt.Sprintf("%s:%d", version, userId))
+
+	if data, err := l.rds.GetCtx(ctx, key); err != nil {
+		logc.Errorf(ctx, "rbac get permission cache error: %s", err)
+	} else if data != "" {
+		var access Access
+		if err := json.Unmarshal([]byte(data), &access); err == nil {
+			return &access, nil
+		}
+	}
+
+	access, err := l.loadFromDB(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+	if data, err := json.Marshal(access); err == nil {
+		if err := l.rds.SetexCtx(ctx, key, string(data), cacheExpire); err != nil {
+			logc.Errorf(ctx, "rbac set permission cache error: %s", err)
+		}
+	}
+	return access, nil
+}
+
+// Invalidate 使全部用户的权限缓存失效
+func (l *Loader) Invalidate(ctx context.Context) error {
+	_, err := l.rds.IncrCtx(ctx, redisutil.ReturnRedisKey(redisutil.AdminPermissionVersion, nil))
+	return err
+}
+
+// loadFromDB 从用户、角色和权限表加载权限集合
+func (l *Loader) loadFromDB(ctx context.Context, userId uint) (*Access, error) {
+	user, err := user_repo.NewTxyUserRepository(l.db).GetByID(ctx, uint64(userId))
+	if err != nil {
+		return nil, err
+	}
+	access := &Access{IsAdmin: user.IsAdmin == 1}
+	if access.IsAdmin {
+		return access, nil
+	}
+
+	permissions, err := user_repo.NewTxyPermissionsRepository(l.db).GetByUserId(ctx, uint64(userId))
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range permissions {
+		access.PermIds = append(access.PermIds, p.Id)
+		if p.Code != "" {
+			access.Codes = append(access.Codes, p.Code)
+		}
+	}
+	return access, nil
+}
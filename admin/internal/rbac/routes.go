@@ -0,0 +1,97 @@
+package rbac
+
+import (
+	"context"
+	"net/http"
+
+	"lxtian-blog/admin/internal/pathmatch"
+)
+
+// CodeAny 登录即可访问的路由，不校验权限
+const CodeAny = ""
+
+// routePermissions 后台路由与权限标识的映射，新增路由需在此登记，未登记的路由只允许超级管理员访问
+// 权限标识需在 txy_permissions.code 中存在并分配给角色，见 common/model/migrations/20261018_17_admin_rbac.sql
+var routePermissions = pathmatch.New(map[string]string{
+	// 内容管理
+	http.MethodGet + " /admin/article/:id":             "article:view",
+	http.MethodGet + " /admin/articles":                "article:view",
+	http.MethodPost + " /admin/article/save":           "article:save",
+	http.MethodGet + " /admin/category":                "article:view",
+	http.MethodGet + " /admin/book":                    "book:view",
+	http.MethodGet + " /admin/book/chapter/:id":        "book:view",
+	http.MethodGet + " /admin/book/chapter/detail/:id": "book:view",
+	http.MethodGet + " /admin/column/list":             "book:view",
+	http.MethodPost + " /admin/book/chapter/data/save": "book:save",
+	http.MethodPost + " /admin/book/chapter/save":      "book:save",
+	http.MethodPost + " /admin/column/save":            "book:save",
+	http.MethodDelete + " /admin/book/chapter/:id":     "book:delete",
+	http.MethodGet + " /admin/docs":                    "docs:view",
+	http.MethodGet + " /admin/docs/category/list":      "docs:view",
+	http.MethodPost + " /admin/docs/save":              "docs:save",
+	http.MethodDelete + " /admin/docs/:id":             "docs:delete",
+	http.MethodGet + " /admin/tags":                    "tag:view",
+	http.MethodPost + " /admin/tag/save":               "tag:save",
+	http.MethodDelete + " /admin/tag/:id":              "tag:delete",
+	http.MethodPost + " /admin/upload":                 "file:upload",
+
+	// 支付管理
+	http.MethodGet + " /admin/payment/orders":                         "payment:order:view",
+	http.MethodGet + " /admin/payment/order/:payment_id":              "payment:order:view",
+	http.MethodGet + " /admin/payment/notifies":                       "payment:order:view",
+	http.MethodGet + " /admin/payment/stats":                          "payment:order:view",
+	http.MethodPost + " /admin/payment/close-payment":                 "payment:order:close",
+	http.MethodPost + " /admin/payment/resend-notify":                 "payment:order:notify",
+	http.MethodGet + " /admin/payment/configs":                        "payment:config:view",
+	http.MethodPost + " /admin/payment/config/save":                   "payment:config:save",
+	http.MethodGet + " /admin/payment/coupons":                        "coupon:view",
+	http.MethodPost + " /admin/payment/coupon/save":                   "coupon:save",
+	http.MethodDelete + " /admin/payment/coupon/:id":                  "coupon:delete",
+	http.MethodGet + " /admin/payment/gift-codes":                     "gift-code:view",
+	http.MethodGet + " /admin/payment/gift-codes/export":              "gift-code:export",
+	http.MethodPost + " /admin/payment/gift-code/generate":            "gift-code:generate",
+	http.MethodPost + " /admin/payment/goods/list":                    "goods:view",
+	http.MethodPost + " /admin/payment/goods/file/save":               "goods:file:save",
+	http.MethodGet + " /admin/payment/goods/reviews":                  "goods:review:view",
+	http.MethodPost + " /admin/payment/goods/review/status":           "goods:review:status",
+	http.MethodGet + " /admin/payment/membership/list":                "membership:view",
+	http.MethodGet + " /admin/payment/refunds":                        "refund:view",
+	http.MethodGet + " /admin/payment/refund-requests":                "refund:view",
+	http.MethodPost + " /admin/payment/refund-request/review":         "refund:review",
+	http.MethodPost + " /admin/payment/manual-refund":                 "refund:manual",
+	http.MethodGet + " /admin/payment/reconcile/batches":              "reconcile:view",
+	http.MethodGet + " /admin/payment/reconcile/discrepancies":        "reconcile:view",
+	http.MethodPost + " /admin/payment/reconcile/run":                 "reconcile:run",
+	http.MethodPost + " /admin/payment/reconcile/discrepancy/resolve": "reconcile:resolve",
+
+	// 系统管理
+	http.MethodGet + " /admin/info":               CodeAny,
+	http.MethodPost + " /admin/logout":            CodeAny,
+	http.MethodGet + " /admin/menus":              CodeAny, // 菜单按权限过滤
+	http.MethodPost + " /admin/menu/save":         "menu:save",
+	http.MethodGet + " /admin/roles":              "role:view",
+	http.MethodPost + " /admin/prem/save":         "role:permission:save",
+	http.MethodGet + " /admin/users":              "user:view",
+	http.MethodPost + " /admin/user/save":         "user:save",
+	http.MethodPost + " /admin/user/force-logout": "user:force-logout",
+	http.MethodGet + " /admin/audit/logs":         "audit:view",
+})
+
+// RequiredCode 返回请求路由所需的权限标识，ok 为 false 表示路由未登记
+func RequiredCode(method, path string) (code string, ok bool) {
+	match, ok := routePermissions.Lookup(method, path)
+	return match.Value, ok
+}
+
+// CanAccess 判断用户能否访问路由：登录即可访问的路由直接放行，未登记的路由只允许超级管理员访问
+func (l *Loader) CanAccess(ctx context.Context, userId uint, method, path string) (bool, error) {
+	code, registered := RequiredCode(method, path)
+	if registered && code == CodeAny {
+		return true, nil
+	}
+	access, err := l.Load(ctx, userId)
+	if err != nil {
+		return false, err
+	}
+	return access.IsAdmin || (registered && access.HasCode(code)), nil
+}
@@ -3,20 +3,32 @@ package svc
 import (
 	"fmt"
 	"github.com/leiphp/gokit/pkg/sdk/qiniu"
+	"github.com/zeromicro/go-zero/core/logx"
 	"github.com/zeromicro/go-zero/core/stores/redis"
 	"github.com/zeromicro/go-zero/rest"
+	"github.com/zeromicro/go-zero/zrpc"
 	"gorm.io/gorm"
+	"lxtian-blog/admin/internal/audit"
 	"lxtian-blog/admin/internal/config"
 	"lxtian-blog/admin/internal/middleware"
+	"lxtian-blog/admin/internal/rbac"
 	"lxtian-blog/common/pkg/initdb"
+	"lxtian-blog/common/pkg/jwts"
+	"lxtian-blog/rpc/payment/paymentclient"
 )
 
 type ServiceContext struct {
-	Config        config.Config
-	JwtMiddleware rest.Middleware
-	Rds           *redis.Redis
-	DB            *gorm.DB
-	QiniuClient   *qiniu.QiniuClient
+	Config               config.Config
+	JwtMiddleware        rest.Middleware
+	AuditMiddleware      rest.Middleware
+	PermissionMiddleware rest.Middleware
+	Rds                  *redis.Redis
+	DB                   *gorm.DB
+	QiniuClient          *qiniu.QiniuClient
+	PaymentRpc           paymentclient.Payment
+	KeySet               *jwts.KeySet         // JWT 签名密钥集
+	Sessions             *jwts.SessionManager // 登录会话：签发与刷新令牌、令牌吊销、强制下线
+	Permissions          *rbac.Loader         // 后台角色权限
 }
 
 func NewServiceContext(c config.Config) *ServiceContext {
@@ -29,6 +41,12 @@ func NewServiceContext(c config.Config) *ServiceContext {
 		c.Mysql.DATABASE,
 	)
 	mysqlDb := initdb.InitDB(dataSource)
+	keySet := jwts.MustNewKeySet(c.Auth.KeySet, c.Auth.AccessSecret)
+	if c.Auth.KeySet.EtcdKey != "" {
+		logx.Must(keySet.WatchEtcd(c.PaymentRpc.Etcd.Hosts, c.Auth.KeySet.EtcdKey))
+	}
+	sessions := jwts.NewSessionManager(rds, keySet, jwts.AudienceAdmin, c.Auth.AccessExpire, c.Auth.RefreshExpire)
+	permissions := rbac.NewLoader(rds, mysqlDb)
 	client := qiniu.NewClient(qiniu.QiniuConfig{
 		AccessKey: c.QiniuOss.AccessKey,
 		SecretKey: c.QiniuOss.SecretKey,
@@ -37,10 +55,16 @@ func NewServiceContext(c config.Config) *ServiceContext {
 		Region:    c.QiniuOss.Region,
 	})
 	return &ServiceContext{
-		Config:        c,
-		JwtMiddleware: middleware.NewJwtMiddleware(c.Auth.AccessSecret, c.Auth.AccessExpire).Handle,
-		Rds:           rds,
-		DB:            mysqlDb,
-		QiniuClient:   client,
+		Config:               c,
+		JwtMiddleware:        middleware.NewJwtMiddleware(keySet, sessions).Handle,
+		AuditMiddleware:      middleware.NewAuditMiddleware(audit.NewRecorder(mysqlDb), permissions).Handle,
+		PermissionMiddleware: middleware.NewPermissionMiddleware(permissions).Handle,
+		Rds:                  rds,
+		DB:                   mysqlDb,
+		QiniuClient:          client,
+		PaymentRpc:           paymentclient.NewPayment(zrpc.MustNewClient(c.PaymentRpc)),
+		KeySet:               keySet,
+		Sessions:             sessions,
+		Permissions:          permissions,
 	}
 }
@@ -12,21 +12,22 @@ type ArticleResp struct {
 }
 
 type ArticleSaveReq struct {
-	Id          int64  `json:"id,optional"`
-	Title       string `json:"title"`
-	Cid         uint64 `json:"cid"`
-	Tid         []int  `json:"tid"`
-	Author      string `json:"author"`
-	Content     string `json:"content"`
-	Keywords    string `json:"keywords,optional"`
-	Path        string `json:"path"`
-	Description string `json:"description"`
-	IsHot       int64  `json:"is_hot"`
-	IsRec       int64  `json:"is_rec"`
-	IsTop       int64  `json:"is_top"`
-	IsOriginal  int64  `json:"is_original"`
-	Status      int64  `json:"status"`
-	CreatedAt   string `json:"created_at,optional"`
+	Id                 int64  `json:"id,optional"`
+	Title              string `json:"title"`
+	Cid                uint64 `json:"cid"`
+	Tid                []int  `json:"tid"`
+	Author             string `json:"author"`
+	Content            string `json:"content"`
+	Keywords           string `json:"keywords,optional"`
+	Path               string `json:"path"`
+	Description        string `json:"description"`
+	IsHot              int64  `json:"is_hot"`
+	IsRec              int64  `json:"is_rec"`
+	IsTop              int64  `json:"is_top"`
+	IsOriginal         int64  `json:"is_original"`
+	Status             int64  `json:"status"`
+	CreatedAt          string `json:"created_at,optional"`
+	RequiredPermission string `json:"required_permission,optional"` // 阅读全文所需的会员权限标识，为空不限制
 }
 
 type ArticleSaveResp struct {
@@ -47,6 +48,24 @@ type ArticlesResp struct {
 	Total    int64                    `json:"total"`
 }
 
+type AuditLogsReq struct {
+	UserId    int64  `form:"user_id,optional"`     // 操作人ID
+	Username  string `form:"username,optional"`    // 操作人用户名
+	Entity    string `form:"entity,optional"`      // 操作对象类型
+	EntityId  string `form:"entity_id,optional"`   // 操作对象ID
+	StartTime string `form:"start_time,optional"`  // 开始时间：2006-01-02 15:04:05
+	EndTime   string `form:"end_time,optional"`    // 结束时间：2006-01-02 15:04:05
+	Page      int    `form:"page,default=1"`       // 页码
+	PageSize  int    `form:"page_size,default=10"` // 每页数量
+}
+
+type AuditLogsResp struct {
+	Page     int                      `json:"page"`      // 页码
+	PageSize int                      `json:"page_size"` // 每页数量
+	Total    int64                    `json:"total"`     // 总数
+	List     []map[string]interface{} `json:"list"`      // 审计日志列表
+}
+
 type BaseJsonReq struct {
 	Page     int    `json:"page,default=1"`       // 页码，默认第1页
 	PageSize int    `json:"page_size,default=20"` // 每页数量，默认20条
@@ -69,11 +88,12 @@ type BasePageRes struct {
 }
 
 type BookChapterDataSaveReq struct {
-	Id      int64  `json:"id,optional"`
-	Title   string `json:"title"`
-	Author  string `json:"author"`
-	Content string `json:"content"`
-	Cate    string `json:"cate"`
+	Id                 int64  `json:"id,optional"`
+	Title              string `json:"title"`
+	Author             string `json:"author"`
+	Content            string `json:"content"`
+	Cate               string `json:"cate"`
+	RequiredPermission string `json:"required_permission,optional"` // 阅读全文所需的会员权限标识，为空不限制
 }
 
 type BookChapterDataSaveResp struct {
@@ -155,6 +175,54 @@ type ColumnListResp struct {
 	Data []map[string]interface{} `json:"data"`
 }
 
+type CouponDelReq struct {
+	Id int64 `path:"id"` // 优惠券ID
+}
+
+type CouponDelResp struct {
+	Data bool `json:"data"` // 删除结果
+}
+
+type CouponSaveReq struct {
+	Id                int64   `json:"id,optional"`                  // 优惠券ID，为空时新增
+	Code              string  `json:"code"`                         // 优惠码
+	Name              string  `json:"name"`                         // 优惠券名称
+	Type              string  `json:"type"`                         // 优惠类型：PERCENT折扣/FIXED立减
+	Value             float64 `json:"value"`                        // 优惠值：折扣为减免百分比，立减为金额
+	MaxDiscount       float64 `json:"max_discount,optional"`        // 折扣券最高减免金额，0不限
+	MinAmount         float64 `json:"min_amount,optional"`          // 订单最低金额，0不限
+	BuyType           int     `json:"buy_type,optional"`            // 适用购买类型：0不限2会员3商品
+	MembershipTypeId  int64   `json:"membership_type_id,optional"`  // 限定会员类型ID，0不限
+	ClassifyId        int     `json:"classify_id,optional"`         // 限定商品分类ID，0不限
+	FirstPurchaseOnly bool    `json:"first_purchase_only,optional"` // 是否仅限首单
+	TotalLimit        int     `json:"total_limit,optional"`         // 总使用次数上限，0不限
+	PerUserLimit      int     `json:"per_user_limit,default=1"`     // 每个用户使用次数上限，0不限
+	StartTime         string  `json:"start_time,optional"`          // 生效时间 yyyy-MM-dd HH:mm:ss
+	EndTime           string  `json:"end_time,optional"`            // 过期时间 yyyy-MM-dd HH:mm:ss
+	Status            int     `json:"status,default=1"`             // 状态：0停用1启用
+	Remark            string  `json:"remark,optional"`              // 备注
+}
+
+type CouponSaveResp struct {
+	Data bool  `json:"data"` // 保存结果
+	Id   int64 `json:"id"`   // 优惠券ID
+}
+
+type CouponsReq struct {
+	Code     string `form:"code,optional"`        // 优惠码
+	Name     string `form:"name,optional"`        // 优惠券名称（模糊搜索）
+	Status   int    `form:"status,default=-1"`    // 状态：0停用1启用，-1全部
+	Page     int    `form:"page,default=1"`       // 页码
+	PageSize int    `form:"page_size,default=10"` // 每页数量
+}
+
+type CouponsResp struct {
+	Page     int                      `json:"page"`      // 页码
+	PageSize int                      `json:"page_size"` // 每页数量
+	Total    int64                    `json:"total"`     // 总数
+	List     []map[string]interface{} `json:"list"`      // 优惠券列表
+}
+
 type DocsCategoryListResp struct {
 	Data []map[string]interface{} `json:"data"`
 }
@@ -183,22 +251,69 @@ type DocsResp struct {
 }
 
 type DocsSaveReq struct {
-	Id          int64    `json:"id,optional"`
-	Title       string   `json:"title"`
-	CategoryId  int      `json:"category_id"`
-	Description string   `json:"description"`
-	Content     string   `json:"content"`
-	Cover       string   `json:"cover"`
-	Level       string   `json:"level"`
-	Status      bool     `json:"status"`
-	Tags        []string `json:"tags"`
-	View        int64    `json:"view"`
+	Id                 int64    `json:"id,optional"`
+	Title              string   `json:"title"`
+	CategoryId         int      `json:"category_id"`
+	Description        string   `json:"description"`
+	Content            string   `json:"content"`
+	Cover              string   `json:"cover"`
+	Level              string   `json:"level"`
+	Status             bool     `json:"status"`
+	Tags               []string `json:"tags"`
+	View               int64    `json:"view"`
+	RequiredPermission string   `json:"required_permission,optional"` // 阅读全文所需的会员权限标识，为空不限制
 }
 
 type DocsSaveResp struct {
 	Data bool `json:"data"`
 }
 
+type GiftCodeGenerateReq struct {
+	BuyType          int    `json:"buy_type"`                    // 兑换类型：2会员3商品
+	MembershipTypeId int64  `json:"membership_type_id,optional"` // 会员类型ID，兑换会员时必填
+	GoodsId          int64  `json:"goods_id,optional"`           // 商品ID，兑换商品时必填
+	Count            int    `json:"count"`                       // 生成数量，单次最多1000个
+	ExpireAt         string `json:"expire_at,optional"`          // 过期时间 yyyy-MM-dd HH:mm:ss，为空不过期
+	Remark           string `json:"remark,optional"`             // 备注
+}
+
+type GiftCodeGenerateResp struct {
+	BatchNo string   `json:"batch_no"` // 批次号
+	Count   int      `json:"count"`    // 生成数量
+	Codes   []string `json:"codes"`    // 礼品码列表
+}
+
+type GiftCodesExportReq struct {
+	BatchNo string `form:"batch_no"`        // 批次号
+	Status  string `form:"status,optional"` // 状态：UNUSED/REDEEMED/DISABLED
+}
+
+type GiftCodesReq struct {
+	BatchNo  string `form:"batch_no,optional"`    // 批次号
+	Code     string `form:"code,optional"`        // 礼品码
+	Status   string `form:"status,optional"`      // 状态：UNUSED/REDEEMED/DISABLED
+	Page     int    `form:"page,default=1"`       // 页码
+	PageSize int    `form:"page_size,default=10"` // 每页数量
+}
+
+type GiftCodesResp struct {
+	Page     int                      `json:"page"`      // 页码
+	PageSize int                      `json:"page_size"` // 每页数量
+	Total    int64                    `json:"total"`     // 总数
+	List     []map[string]interface{} `json:"list"`      // 礼品码列表
+}
+
+type GoodsFileSaveReq struct {
+	Id            int64  `json:"id"`                      // 商品ID
+	FileStorage   string `json:"file_storage"`            // 商品文件存储方式：qiniu七牛；local本地
+	FileKey       string `json:"file_key,optional"`       // 商品文件七牛key或本地相对路径，为空时清除商品文件
+	DownloadLimit int32  `json:"download_limit,optional"` // 每个用户可下载次数，0使用默认配置
+}
+
+type GoodsFileSaveResp struct {
+	Data bool `json:"data"` // 保存结果
+}
+
 type GoodsListReq struct {
 	ClassifyId int     `json:"classify_id"`
 	PriceMin   float32 `json:"price_min,optional"`
@@ -210,6 +325,29 @@ type GoodsListResp struct {
 	BasePageRes
 }
 
+type GoodsReviewStatusReq struct {
+	Id     int64 `json:"id"`     // 评价ID
+	Status int   `json:"status"` // 状态：0隐藏1展示
+}
+
+type GoodsReviewStatusResp struct {
+	Data bool `json:"data"` // 修改结果
+}
+
+type GoodsReviewsReq struct {
+	GoodsId  int64 `form:"goods_id,optional"`    // 商品ID
+	Status   int   `form:"status,default=-1"`    // 状态：0隐藏1展示，-1全部
+	Page     int   `form:"page,default=1"`       // 页码
+	PageSize int   `form:"page_size,default=10"` // 每页数量
+}
+
+type GoodsReviewsResp struct {
+	Page     int                      `json:"page"`      // 页码
+	PageSize int                      `json:"page_size"` // 每页数量
+	Total    int64                    `json:"total"`     // 总数
+	List     []map[string]interface{} `json:"list"`      // 评价列表
+}
+
 type InfoResp struct {
 	User
 }
@@ -222,8 +360,15 @@ type LoginReq struct {
 }
 
 type LoginResp struct {
-	Token string `json:"token"`
-	User  User   `json:"user"`
+	Token            string `json:"token"`
+	ExpiresIn        uint64 `json:"expires_in"`
+	RefreshToken     string `json:"refresh_token"`
+	RefreshExpiresIn uint64 `json:"refresh_expires_in"`
+	User             User   `json:"user"`
+}
+
+type LogoutResp struct {
+	Data bool `json:"data"`
 }
 
 type ManualRefundReq struct {
@@ -233,8 +378,9 @@ type ManualRefundReq struct {
 }
 
 type ManualRefundResp struct {
-	Data    bool   `json:"data"`    // 退款结果
-	Message string `json:"message"` // 返回消息
+	Data      bool   `json:"data"`       // 退款结果
+	Message   string `json:"message"`    // 返回消息
+	RequestId string `json:"request_id"` // 退款申请ID
 }
 
 type MembershipListResp struct {
@@ -332,6 +478,8 @@ type PaymentOrderResp struct {
 type PaymentOrdersReq struct {
 	UserId        uint64 `form:"user_id,optional"`        // 用户ID
 	OrderId       string `form:"order_id,optional"`       // 订单ID
+	OutTradeNo    string `form:"out_trade_no,optional"`   // 商户订单号
+	BuyType       int    `form:"buy_type,optional"`       // 购买类型：1捐赠2会员3商品
 	PaymentStatus string `form:"payment_status,optional"` // 支付状态
 	StartTime     string `form:"start_time,optional"`     // 开始时间
 	EndTime       string `form:"end_time,optional"`       // 结束时间
@@ -370,7 +518,8 @@ type PaymentStatsReq struct {
 }
 
 type PaymentStatsResp struct {
-	Data []map[string]interface{} `json:"data"` // 统计数据
+	Data      []map[string]interface{} `json:"data"`        // 统计数据
+	ByBuyType []map[string]interface{} `json:"by_buy_type"` // 按购买类型统计
 }
 
 type PremSaveReq struct {
@@ -382,8 +531,91 @@ type PremSaveResp struct {
 	Data bool `json:"data"`
 }
 
+type ReconcileBatchesReq struct {
+	BillDate string `form:"bill_date,optional"`   // 账单日期 yyyy-MM-dd
+	Status   string `form:"status,optional"`      // 对账状态：RUNNING/SUCCESS/FAILED
+	Page     int    `form:"page,default=1"`       // 页码
+	PageSize int    `form:"page_size,default=10"` // 每页数量
+}
+
+type ReconcileBatchesResp struct {
+	Page     int                      `json:"page"`      // 页码
+	PageSize int                      `json:"page_size"` // 每页数量
+	Total    int64                    `json:"total"`     // 总数
+	List     []map[string]interface{} `json:"list"`      // 对账批次列表
+}
+
+type ReconcileDiscrepanciesReq struct {
+	BillDate      string `form:"bill_date,optional"`      // 账单日期 yyyy-MM-dd
+	BatchId       string `form:"batch_id,optional"`       // 对账批次ID
+	Type          string `form:"type,optional"`           // 差异类型：MISSING_LOCAL/MISSING_BILL/AMOUNT_MISMATCH/STATUS_MISMATCH
+	ResolveStatus string `form:"resolve_status,optional"` // 处理状态：PENDING/RESOLVED/IGNORED
+	OutTradeNo    string `form:"out_trade_no,optional"`   // 商户订单号
+	Page          int    `form:"page,default=1"`          // 页码
+	PageSize      int    `form:"page_size,default=10"`    // 每页数量
+}
+
+type ReconcileDiscrepanciesResp struct {
+	Page     int                      `json:"page"`      // 页码
+	PageSize int                      `json:"page_size"` // 每页数量
+	Total    int64                    `json:"total"`     // 总数
+	List     []map[string]interface{} `json:"list"`      // 对账差异列表
+}
+
+type ReconcileDiscrepancyResolveReq struct {
+	Id            int64  `json:"id"`              // 差异ID
+	ResolveStatus string `json:"resolve_status"`  // 处理结果：RESOLVED/IGNORED
+	Remark        string `json:"remark,optional"` // 处理备注
+}
+
+type ReconcileDiscrepancyResolveResp struct {
+	Data    bool   `json:"data"`    // 处理结果
+	Message string `json:"message"` // 返回消息
+}
+
+type ReconcileRunReq struct {
+	BillDate string `json:"bill_date"`          // 账单日期 yyyy-MM-dd
+	FilePath string `json:"file_path,optional"` // 服务器账单目录下的账单文件相对路径，为空时从支付宝下载
+}
+
+type ReconcileRunResp struct {
+	BatchId          string `json:"batch_id"`          // 对账批次ID
+	TotalCount       int64  `json:"total_count"`       // 账单明细数
+	MatchedCount     int64  `json:"matched_count"`     // 核对一致数
+	DiscrepancyCount int64  `json:"discrepancy_count"` // 差异数
+	Message          string `json:"message"`           // 返回消息
+}
+
+type RefundRequestReviewReq struct {
+	RequestId string `json:"request_id"`      // 退款申请ID
+	Approve   bool   `json:"approve"`         // 是否通过
+	Remark    string `json:"remark,optional"` // 审批备注
+}
+
+type RefundRequestReviewResp struct {
+	Data    bool   `json:"data"`    // 审批结果
+	Message string `json:"message"` // 返回消息
+}
+
+type RefundRequestsReq struct {
+	PaymentId string `form:"payment_id,optional"`  // 支付ID
+	Status    string `form:"status,optional"`      // 审批状态：PENDING/APPROVED/REJECTED/FAILED
+	StartTime string `form:"start_time,optional"`  // 开始时间
+	EndTime   string `form:"end_time,optional"`    // 结束时间
+	Page      int    `form:"page,default=1"`       // 页码
+	PageSize  int    `form:"page_size,default=10"` // 每页数量
+}
+
+type RefundRequestsResp struct {
+	Page     int                      `json:"page"`      // 页码
+	PageSize int                      `json:"page_size"` // 每页数量
+	Total    int64                    `json:"total"`     // 总数
+	List     []map[string]interface{} `json:"list"`      // 退款申请列表
+}
+
 type ResendNotifyReq struct {
-	NotifyId string `json:"notify_id"` // 通知ID
+	NotifyId  string `json:"notify_id,optional"`  // 通知ID
+	PaymentId string `json:"payment_id,optional"` // 支付ID（未提供通知ID时重放该订单最近一条支付通知）
 }
 
 type ResendNotifyResp struct {
@@ -436,6 +668,17 @@ type TagsResp struct {
 	Total    int64                    `json:"total"`
 }
 
+type TokenRefreshReq struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
+type TokenRefreshResp struct {
+	AccessToken      string `json:"access_token"`
+	ExpiresIn        uint64 `json:"expires_in"`
+	RefreshToken     string `json:"refresh_token"`
+	RefreshExpiresIn uint64 `json:"refresh_expires_in"`
+}
+
 type UploadReq struct {
 	Path string `form:"path,optional"`
 }
@@ -451,6 +694,14 @@ type User struct {
 	Permissions []string `json:"permissions"`
 }
 
+type UserForceLogoutReq struct {
+	UserId int64 `json:"user_id"`
+}
+
+type UserForceLogoutResp struct {
+	Data bool `json:"data"`
+}
+
 type UserSaveReq struct {
 	Id       int64  `json:"id"`
 	Nickname string `json:"nickname"`
@@ -0,0 +1,8 @@
+package constant
+
+// 会员专享内容类型
+const (
+	ContentTypeArticle = "article" // 文章
+	ContentTypeDocs    = "docs"    // 文档
+	ContentTypeChapter = "chapter" // 书籍章节
+)
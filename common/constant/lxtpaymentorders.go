@@ -47,6 +47,14 @@ const (
 	ProcessStatusFailed  = "FAILED"  // 处理失败
 )
 
+// 支付方式
+const (
+	PayTypeAlipay   = 1 // 支付宝
+	PayTypeWechat   = 2 // 微信支付
+	PayTypeBankCard = 3 // 银行卡
+	PayTypeGiftCode = 4 // 礼品码兑换（零元订单，不经过支付渠道）
+)
+
 // 购买类型
 const (
 	BuyTypeDonate     = 1 // 捐赠
@@ -58,4 +66,103 @@ const (
 const (
 	MembershipRenewalTypeRenewal = 1 // 同级续费
 	MembershipRenewalTypeUpgrade = 2 // 向上升级
+	MembershipRenewalTypeGift    = 3 // 礼品码兑换
+)
+
+// 支付宝退款状态
+const (
+	AlipayRefundStatusSuccess = "REFUND_SUCCESS" // 退款成功
+	AlipayRefundStatusClosed  = "REFUND_CLOSED"  // 退款关闭
+)
+
+// 退款申请审批状态
+const (
+	RefundRequestStatusPending  = "PENDING"  // 待审批
+	RefundRequestStatusApproved = "APPROVED" // 已审批，已提交退款
+	RefundRequestStatusRejected = "REJECTED" // 已驳回
+	RefundRequestStatusFailed   = "FAILED"   // 审批通过但提交退款失败
+)
+
+// 对账来源
+const (
+	ReconcileSourceFile   = "FILE"   // 本地账单文件
+	ReconcileSourceAlipay = "ALIPAY" // 支付宝下载
+)
+
+// 对账批次状态
+const (
+	ReconcileStatusRunning = "RUNNING" // 对账中
+	ReconcileStatusSuccess = "SUCCESS" // 对账完成
+	ReconcileStatusFailed  = "FAILED"  // 对账失败
+)
+
+// 对账差异类型
+const (
+	DiscrepancyTypeMissingLocal   = "MISSING_LOCAL"   // 账单有记录，本地缺失
+	DiscrepancyTypeMissingBill    = "MISSING_BILL"    // 本地有记录，账单缺失
+	DiscrepancyTypeAmountMismatch = "AMOUNT_MISMATCH" // 金额不一致
+	DiscrepancyTypeStatusMismatch = "STATUS_MISMATCH" // 状态不一致
+)
+
+// 对账差异处理状态
+const (
+	DiscrepancyResolvePending  = "PENDING"  // 待处理
+	DiscrepancyResolveResolved = "RESOLVED" // 已处理
+	DiscrepancyResolveIgnored  = "IGNORED"  // 已忽略
+)
+
+// 幂等键处理状态
+const (
+	IdempotencyStatusProcessing = "PROCESSING" // 首次请求处理中
+	IdempotencyStatusSuccess    = "SUCCESS"    // 已处理，保存了响应
+)
+
+// 支付订单状态变更事件
+const (
+	OrderEventCreateFailed = "CREATE_FAILED" // 支付渠道下单失败
+	OrderEventPaySuccess   = "PAY_SUCCESS"   // 支付成功（异步通知或主动查询）
+	OrderEventTradeClosed  = "TRADE_CLOSED"  // 支付渠道交易关闭
+	OrderEventExpired      = "EXPIRED"       // 超时未支付关闭
+	OrderEventUserClose    = "USER_CLOSE"    // 用户关闭
+	OrderEventUserCancel   = "USER_CANCEL"   // 用户取消
+	OrderEventRefund       = "REFUND"        // 退款结算
+	OrderEventGiftRedeem   = "GIFT_REDEEM"   // 礼品码兑换，订单创建即为已支付
+)
+
+// 优惠券类型
+const (
+	CouponTypePercent = "PERCENT" // 折扣：按百分比减免
+	CouponTypeFixed   = "FIXED"   // 立减：减免固定金额
+)
+
+// 优惠券状态
+const (
+	CouponStatusDisabled = 0 // 停用
+	CouponStatusEnabled  = 1 // 启用
+)
+
+// 优惠券使用状态
+const (
+	CouponUsageLocked   = "LOCKED"   // 下单锁定，待支付
+	CouponUsageUsed     = "USED"     // 订单支付成功，已使用
+	CouponUsageReleased = "RELEASED" // 订单关闭，已释放
+)
+
+// 礼品码状态
+const (
+	GiftCodeStatusUnused   = "UNUSED"   // 未使用
+	GiftCodeStatusRedeemed = "REDEEMED" // 已兑换
+	GiftCodeStatusDisabled = "DISABLED" // 已作废
+)
+
+// 商品文件存储方式
+const (
+	GoodsStorageQiniu = "qiniu" // 七牛云私有空间
+	GoodsStorageLocal = "local" // 本地文件，由网关校验签名后下发
+)
+
+// 商品评价状态
+const (
+	GoodsReviewStatusHidden  = 0 // 隐藏
+	GoodsReviewStatusVisible = 1 // 展示
 )
@@ -0,0 +1,6 @@
+package constant
+
+// 站内通知类型
+const (
+	NoticeTypeMembershipExpiring = "MEMBERSHIP_EXPIRING" // 会员即将到期
+)
@@ -0,0 +1,8 @@
+package model
+
+import "github.com/shopspring/decimal"
+
+func init() {
+	// 金额字段序列化为 JSON 数字而非字符串，保持接口返回格式不变且不丢失精度
+	decimal.MarshalJSONWithoutQuotes = true
+}
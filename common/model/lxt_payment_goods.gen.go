@@ -7,6 +7,7 @@ package model
 import (
 	"time"
 
+	"github.com/shopspring/decimal"
 	"gorm.io/gorm"
 )
 
@@ -14,24 +15,29 @@ const TableNameLxtPaymentGood = "lxt_payment_goods"
 
 // LxtPaymentGood 商品表
 type LxtPaymentGood struct {
-	ID            int64          `gorm:"column:id;primaryKey;autoIncrement:true;comment:主键ID" json:"id"`                      // 主键ID
-	Name          string         `gorm:"column:name;not null;comment:商品名称" json:"name"`                                       // 商品名称
-	Desc          string         `gorm:"column:desc;not null;comment:商品描述" json:"desc"`                                       // 商品描述
-	Detail        *string        `gorm:"column:detail;comment:商品详情" json:"detail"`                                            // 商品详情
-	ClassifyID    int32          `gorm:"column:classify_id;not null;comment:商品分类ID" json:"classify_id"`                       // 商品分类ID
-	Price         float64        `gorm:"column:price;not null;comment:商品价格" json:"price"`                                     // 商品价格
-	OriginalPrice float64        `gorm:"column:original_price;not null;comment:商品原价" json:"original_price"`                   // 商品原价
-	Rating        float64        `gorm:"column:rating;not null;comment:评分" json:"rating"`                                     // 评分
-	Sales         int32          `gorm:"column:sales;not null;comment:销量" json:"sales"`                                       // 销量
-	Downloads     int32          `gorm:"column:downloads;not null;comment:下载" json:"downloads"`                                 // 下载
-	Size          int32          `gorm:"column:size;not null;comment:文件大小" json:"size"`                                       // 文件大小
-	Status        int32          `gorm:"column:status;not null;comment:状态：0待发布；1已发布" json:"status"`                           // 状态：0待发布；1已发布
-	ProductCode   *string        `gorm:"column:product_code;default:FAST_INSTANT_TRADE_PAY;comment:产品码" json:"product_code"`  // 产品码
-	PicURL        *string        `gorm:"column:pic_url;comment:商品封面图片url" json:"pic_url"`                                     // 商品封面图片url
-	Tags          *string        `gorm:"column:tags;comment:标签" json:"tags"`                                                  // 标签
-	CreatedAt     time.Time      `gorm:"column:created_at;not null;default:CURRENT_TIMESTAMP;comment:创建时间" json:"created_at"` // 创建时间
-	UpdatedAt     time.Time      `gorm:"column:updated_at;not null;default:CURRENT_TIMESTAMP;comment:更新时间" json:"updated_at"` // 更新时间
-	DeletedAt     gorm.DeletedAt `gorm:"column:deleted_at;comment:删除时间" json:"deleted_at"`                                    // 删除时间
+	ID            int64           `gorm:"column:id;primaryKey;autoIncrement:true;comment:主键ID" json:"id"`                      // 主键ID
+	Name          string          `gorm:"column:name;not null;comment:商品名称" json:"name"`                                       // 商品名称
+	Desc          string          `gorm:"column:desc;not null;comment:商品描述" json:"desc"`                                       // 商品描述
+	Detail        *string         `gorm:"column:detail;comment:商品详情" json:"detail"`                                            // 商品详情
+	ClassifyID    int32           `gorm:"column:classify_id;not null;comment:商品分类ID" json:"classify_id"`                       // 商品分类ID
+	Price         decimal.Decimal `gorm:"column:price;not null;comment:商品价格" json:"price"`                                     // 商品价格
+	OriginalPrice decimal.Decimal `gorm:"column:original_price;not null;comment:商品原价" json:"original_price"`                   // 商品原价
+	Rating        float64         `gorm:"column:rating;not null;comment:评分" json:"rating"`                                     // 评分
+	RatingTotal   int64           `gorm:"column:rating_total;not null;comment:展示中评价的评分合计" json:"rating_total"`                 // 展示中评价的评分合计
+	ReviewCount   int32           `gorm:"column:review_count;not null;comment:展示中评价数" json:"review_count"`                     // 展示中评价数
+	Sales         int32           `gorm:"column:sales;not null;comment:销量" json:"sales"`                                       // 销量
+	Downloads     int32           `gorm:"column:downloads;not null;comment:下载" json:"downloads"`                               // 下载
+	Size          int32           `gorm:"column:size;not null;comment:文件大小" json:"size"`                                       // 文件大小
+	FileStorage   string          `gorm:"column:file_storage;not null;comment:商品文件存储方式：qiniu七牛；local本地" json:"file_storage"`   // 商品文件存储方式：qiniu七牛；local本地
+	FileKey       *string         `gorm:"column:file_key;comment:商品文件七牛key或本地相对路径" json:"file_key"`                            // 商品文件七牛key或本地相对路径
+	DownloadLimit int32           `gorm:"column:download_limit;not null;comment:每个用户可下载次数，0使用默认配置" json:"download_limit"`      // 每个用户可下载次数，0使用默认配置
+	Status        int32           `gorm:"column:status;not null;comment:状态：0待发布；1已发布" json:"status"`                           // 状态：0待发布；1已发布
+	ProductCode   *string         `gorm:"column:product_code;default:FAST_INSTANT_TRADE_PAY;comment:产品码" json:"product_code"`  // 产品码
+	PicURL        *string         `gorm:"column:pic_url;comment:商品封面图片url" json:"pic_url"`                                     // 商品封面图片url
+	Tags          *string         `gorm:"column:tags;comment:标签" json:"tags"`                                                  // 标签
+	CreatedAt     time.Time       `gorm:"column:created_at;not null;default:CURRENT_TIMESTAMP;comment:创建时间" json:"created_at"` // 创建时间
+	UpdatedAt     time.Time       `gorm:"column:updated_at;not null;default:CURRENT_TIMESTAMP;comment:更新时间" json:"updated_at"` // 更新时间
+	DeletedAt     gorm.DeletedAt  `gorm:"column:deleted_at;comment:删除时间" json:"deleted_at"`                                    // 删除时间
 }
 
 // TableName LxtPaymentGood's table name
@@ -24,6 +24,8 @@ type LxtPaymentNotify struct {
 	VerifyStatus  string         `gorm:"column:verify_status;not null;default:PENDING;comment:验证状态" json:"verify_status"`     // 验证状态
 	ProcessStatus string         `gorm:"column:process_status;not null;default:PENDING;comment:处理状态" json:"process_status"`   // 处理状态
 	ClientIP      *string        `gorm:"column:client_ip;comment:客户端IP" json:"client_ip"`                                     // 客户端IP
+	PayType       int32          `gorm:"column:pay_type;not null;default:1;comment:支付类型：1支付宝2微信" json:"pay_type"`             // 支付类型：1支付宝2微信
+	Headers       *string        `gorm:"column:headers;comment:通知请求头（JSON）" json:"headers"`                                   // 通知请求头（JSON）
 	ErrorMessage  *string        `gorm:"column:error_message;comment:错误信息" json:"error_message"`                              // 错误信息
 	ProcessedAt   *time.Time     `gorm:"column:processed_at;comment:处理时间" json:"processed_at"`                                // 处理时间
 	CreatedAt     time.Time      `gorm:"column:created_at;not null;default:CURRENT_TIMESTAMP;comment:创建时间" json:"created_at"` // 创建时间
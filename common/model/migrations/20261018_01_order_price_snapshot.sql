@@ -0,0 +1,6 @@
+-- 下单金额由服务端计算：订单记录下单时的单价、抵扣金额和价格快照，便于对账和售后核对
+
+ALTER TABLE `lxt_payment_orders`
+    ADD COLUMN `unit_price`      DOUBLE NOT NULL DEFAULT 0 COMMENT '下单时商品/会员单价' AFTER `amount`,
+    ADD COLUMN `discount_amount` DOUBLE NOT NULL DEFAULT 0 COMMENT '抵扣金额（会员升级剩余时长折算等）' AFTER `unit_price`,
+    ADD COLUMN `price_snapshot`  TEXT   NULL COMMENT '下单时价格快照（JSON）' AFTER `discount_amount`;
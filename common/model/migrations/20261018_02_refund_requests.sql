@@ -0,0 +1,28 @@
+-- 退款审批：后台提交退款申请，审批通过后按申请ID作为退款单号向支付宝发起退款，支持部分退款
+
+CREATE TABLE IF NOT EXISTS `lxt_payment_refund_requests`
+(
+    `id`             BIGINT       NOT NULL AUTO_INCREMENT COMMENT '主键ID',
+    `request_id`     VARCHAR(64)  NOT NULL COMMENT '退款申请ID（同时作为支付宝退款单号）',
+    `payment_id`     VARCHAR(64)  NOT NULL COMMENT '支付ID',
+    `order_sn`       VARCHAR(64)  NOT NULL COMMENT '订单编号',
+    `user_id`        BIGINT       NOT NULL COMMENT '订单用户ID',
+    `refund_amount`  DOUBLE       NOT NULL DEFAULT 0 COMMENT '申请退款金额',
+    `refund_reason`  VARCHAR(255) NOT NULL DEFAULT '' COMMENT '退款原因',
+    `status`         VARCHAR(16)  NOT NULL DEFAULT 'PENDING' COMMENT '审批状态：PENDING/APPROVED/REJECTED/FAILED',
+    `requester_id`   BIGINT       NOT NULL COMMENT '申请人ID',
+    `requester_name` VARCHAR(64)  NOT NULL DEFAULT '' COMMENT '申请人',
+    `reviewer_id`    BIGINT       NULL COMMENT '审批人ID',
+    `reviewer_name`  VARCHAR(64)  NULL COMMENT '审批人',
+    `review_remark`  VARCHAR(255) NULL COMMENT '审批备注',
+    `reviewed_at`    DATETIME     NULL COMMENT '审批时间',
+    `refund_id`      VARCHAR(64)  NULL COMMENT '关联退款记录ID',
+    `error_message`  VARCHAR(512) NULL COMMENT '提交退款失败原因',
+    `created_at`     DATETIME     NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    `updated_at`     DATETIME     NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+    `deleted_at`     DATETIME     NULL COMMENT '删除时间',
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_request_id` (`request_id`),
+    KEY `idx_payment_status` (`payment_id`, `status`)
+) ENGINE = InnoDB
+  DEFAULT CHARSET = utf8mb4 COMMENT ='退款申请表';
@@ -0,0 +1,6 @@
+-- 会员订单退款：按退款比例扣回续费记录对应的会员时长，记录已扣回的天数/月数，避免重复扣回
+
+ALTER TABLE `lxt_user_membership_renewals`
+    ADD COLUMN `revoked_days`   INT      NOT NULL DEFAULT 0 COMMENT '退款已扣回天数' AFTER `amount`,
+    ADD COLUMN `revoked_months` INT      NOT NULL DEFAULT 0 COMMENT '退款已扣回月数' AFTER `revoked_days`,
+    ADD COLUMN `revoked_at`     DATETIME NULL COMMENT '退款扣回时间' AFTER `revoked_months`;
@@ -0,0 +1,6 @@
+-- 打赏订单落库：记录是否匿名、支付宝交易号和支付时间，用于公开打赏墙展示
+
+ALTER TABLE `txy_order`
+    ADD COLUMN `anonymous` TINYINT     NOT NULL DEFAULT 0 COMMENT '是否匿名：1是0否' AFTER `ip`,
+    ADD COLUMN `trade_no`  VARCHAR(64) NOT NULL DEFAULT '' COMMENT '支付宝交易号' AFTER `anonymous`,
+    ADD COLUMN `pay_time`  DATETIME    NULL COMMENT '支付时间' AFTER `trade_no`;
@@ -0,0 +1,50 @@
+-- 支付对账：按账单日期下载或导入支付宝账单，与本地支付、退款记录逐笔核对，差异记录待后台处理
+
+CREATE TABLE IF NOT EXISTS `lxt_payment_reconciliations`
+(
+    `id`                BIGINT       NOT NULL AUTO_INCREMENT COMMENT '主键ID',
+    `batch_id`          VARCHAR(64)  NOT NULL COMMENT '对账批次ID',
+    `bill_date`         VARCHAR(10)  NOT NULL COMMENT '账单日期（yyyy-MM-dd）',
+    `source`            VARCHAR(16)  NOT NULL COMMENT '账单来源：FILE/ALIPAY',
+    `status`            VARCHAR(16)  NOT NULL DEFAULT 'RUNNING' COMMENT '对账状态：RUNNING/SUCCESS/FAILED',
+    `total_count`       BIGINT       NOT NULL DEFAULT 0 COMMENT '账单明细数',
+    `matched_count`     BIGINT       NOT NULL DEFAULT 0 COMMENT '核对一致数',
+    `discrepancy_count` BIGINT       NOT NULL DEFAULT 0 COMMENT '差异数',
+    `bill_amount`       DOUBLE       NOT NULL DEFAULT 0 COMMENT '账单交易总额',
+    `error_message`     VARCHAR(512) NULL COMMENT '失败原因',
+    `created_at`        DATETIME     NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    `updated_at`        DATETIME     NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+    `deleted_at`        DATETIME     NULL COMMENT '删除时间',
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_batch_id` (`batch_id`),
+    KEY `idx_bill_date_status` (`bill_date`, `status`)
+) ENGINE = InnoDB
+  DEFAULT CHARSET = utf8mb4 COMMENT ='支付对账批次表';
+
+CREATE TABLE IF NOT EXISTS `lxt_payment_reconcile_discrepancies`
+(
+    `id`             BIGINT       NOT NULL AUTO_INCREMENT COMMENT '主键ID',
+    `batch_id`       VARCHAR(64)  NOT NULL COMMENT '对账批次ID',
+    `bill_date`      VARCHAR(10)  NOT NULL COMMENT '账单日期（yyyy-MM-dd）',
+    `type`           VARCHAR(32)  NOT NULL COMMENT '差异类型：MISSING_LOCAL/MISSING_BILL/AMOUNT_MISMATCH/STATUS_MISMATCH',
+    `biz_type`       VARCHAR(16)  NOT NULL DEFAULT '' COMMENT '业务类型：交易/退款',
+    `out_trade_no`   VARCHAR(64)  NOT NULL DEFAULT '' COMMENT '商户订单号',
+    `trade_no`       VARCHAR(64)  NOT NULL DEFAULT '' COMMENT '支付宝交易号',
+    `out_request_no` VARCHAR(64)  NOT NULL DEFAULT '' COMMENT '退款单号',
+    `local_amount`   DOUBLE       NULL COMMENT '本地金额',
+    `bill_amount`    DOUBLE       NULL COMMENT '账单金额',
+    `local_status`   VARCHAR(32)  NULL COMMENT '本地状态',
+    `detail`         VARCHAR(512) NOT NULL DEFAULT '' COMMENT '差异说明',
+    `resolve_status` VARCHAR(16)  NOT NULL DEFAULT 'PENDING' COMMENT '处理状态：PENDING/RESOLVED/IGNORED',
+    `resolver_id`    BIGINT       NULL COMMENT '处理人ID',
+    `resolver_name`  VARCHAR(64)  NULL COMMENT '处理人',
+    `resolve_remark` VARCHAR(255) NULL COMMENT '处理备注',
+    `resolved_at`    DATETIME     NULL COMMENT '处理时间',
+    `created_at`     DATETIME     NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    `updated_at`     DATETIME     NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+    `deleted_at`     DATETIME     NULL COMMENT '删除时间',
+    PRIMARY KEY (`id`),
+    KEY `idx_batch_id` (`batch_id`),
+    KEY `idx_bill_date_resolve` (`bill_date`, `resolve_status`)
+) ENGINE = InnoDB
+  DEFAULT CHARSET = utf8mb4 COMMENT ='支付对账差异表';
@@ -0,0 +1,5 @@
+-- 微信支付接入：支付通知记录渠道类型和请求头（微信通知验签依赖请求头），便于后台重放通知
+
+ALTER TABLE `lxt_payment_notifies`
+    ADD COLUMN `pay_type` TINYINT NOT NULL DEFAULT 1 COMMENT '支付类型：1支付宝2微信' AFTER `client_ip`,
+    ADD COLUMN `headers`  TEXT    NULL COMMENT '通知请求头（JSON）' AFTER `pay_type`;
@@ -0,0 +1,17 @@
+-- 支付接口幂等：客户端通过 Idempotency-Key 请求头重试下单/退款时返回首次请求的结果
+-- (scope, idempotency_key) 唯一索引保证并发重试只有一个请求写入成功并执行业务
+
+CREATE TABLE IF NOT EXISTS `lxt_payment_idempotency_keys`
+(
+    `id`              BIGINT       NOT NULL AUTO_INCREMENT COMMENT '主键ID',
+    `scope`           VARCHAR(64)  NOT NULL COMMENT '接口名称，与幂等键组成唯一索引',
+    `idempotency_key` VARCHAR(64)  NOT NULL COMMENT '客户端传入的幂等键',
+    `request_hash`    CHAR(64)     NOT NULL COMMENT '请求参数摘要（SHA-256）',
+    `status`          VARCHAR(16)  NOT NULL DEFAULT 'PROCESSING' COMMENT '处理状态：PROCESSING/SUCCESS',
+    `response`        TEXT         NULL COMMENT '首次请求的响应（JSON）',
+    `created_at`      DATETIME     NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    `updated_at`      DATETIME     NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_scope_key` (`scope`, `idempotency_key`)
+) ENGINE = InnoDB
+  DEFAULT CHARSET = utf8mb4 COMMENT ='支付接口幂等键表';
@@ -0,0 +1,15 @@
+-- 支付订单状态机：订单每次状态流转记录一条日志，便于排查状态异常
+
+CREATE TABLE IF NOT EXISTS `lxt_payment_order_status_logs`
+(
+    `id`          BIGINT       NOT NULL AUTO_INCREMENT COMMENT '主键ID',
+    `payment_id`  VARCHAR(64)  NOT NULL COMMENT '支付ID',
+    `from_status` VARCHAR(32)  NOT NULL COMMENT '变更前状态',
+    `to_status`   VARCHAR(32)  NOT NULL COMMENT '变更后状态',
+    `event`       VARCHAR(32)  NOT NULL COMMENT '触发事件',
+    `remark`      VARCHAR(512) NULL COMMENT '备注',
+    `created_at`  DATETIME     NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    PRIMARY KEY (`id`),
+    KEY `idx_payment_id` (`payment_id`)
+) ENGINE = InnoDB
+  DEFAULT CHARSET = utf8mb4 COMMENT ='支付订单状态变更记录表';
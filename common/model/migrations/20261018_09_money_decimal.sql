@@ -0,0 +1,64 @@
+-- 金额字段统一改为 DECIMAL(10,2)，程序中以 decimal.Decimal 读写，避免浮点误差
+-- 先按两位小数修正历史数据，再修改列类型；执行前请备份相关表
+-- 依赖 01、02、04、05 号迁移创建的表和列，迁移按文件名顺序执行
+
+UPDATE `lxt_payment_orders`
+SET `amount`          = ROUND(`amount`, 2),
+    `unit_price`      = ROUND(`unit_price`, 2),
+    `discount_amount` = ROUND(`discount_amount`, 2),
+    `receipt_amount`  = ROUND(`receipt_amount`, 2);
+ALTER TABLE `lxt_payment_orders`
+    MODIFY COLUMN `amount` DECIMAL(10, 2) NOT NULL DEFAULT 0.00 COMMENT '支付金额',
+    MODIFY COLUMN `unit_price` DECIMAL(10, 2) NOT NULL DEFAULT 0.00 COMMENT '下单时商品/会员单价',
+    MODIFY COLUMN `discount_amount` DECIMAL(10, 2) NOT NULL DEFAULT 0.00 COMMENT '抵扣金额（会员升级剩余时长折算等）',
+    MODIFY COLUMN `receipt_amount` DECIMAL(10, 2) NOT NULL DEFAULT 0.00 COMMENT '实收金额';
+
+UPDATE `lxt_payment_refunds`
+SET `refund_amount` = ROUND(`refund_amount`, 2),
+    `refund_fee`    = ROUND(`refund_fee`, 2);
+ALTER TABLE `lxt_payment_refunds`
+    MODIFY COLUMN `refund_amount` DECIMAL(10, 2) NOT NULL DEFAULT 0.00 COMMENT '退款金额',
+    MODIFY COLUMN `refund_fee` DECIMAL(10, 2) NULL COMMENT '退款手续费';
+
+UPDATE `lxt_payment_refund_requests`
+SET `refund_amount` = ROUND(`refund_amount`, 2);
+ALTER TABLE `lxt_payment_refund_requests`
+    MODIFY COLUMN `refund_amount` DECIMAL(10, 2) NOT NULL DEFAULT 0.00 COMMENT '申请退款金额';
+
+UPDATE `lxt_payment_goods`
+SET `price`          = ROUND(`price`, 2),
+    `original_price` = ROUND(`original_price`, 2);
+ALTER TABLE `lxt_payment_goods`
+    MODIFY COLUMN `price` DECIMAL(10, 2) NOT NULL DEFAULT 0.00 COMMENT '商品价格',
+    MODIFY COLUMN `original_price` DECIMAL(10, 2) NOT NULL DEFAULT 0.00 COMMENT '商品原价';
+
+UPDATE `lxt_payment_reconciliations`
+SET `bill_amount` = ROUND(`bill_amount`, 2);
+ALTER TABLE `lxt_payment_reconciliations`
+    MODIFY COLUMN `bill_amount` DECIMAL(12, 2) NOT NULL DEFAULT 0.00 COMMENT '账单交易总额';
+
+UPDATE `lxt_payment_reconcile_discrepancies`
+SET `local_amount` = ROUND(`local_amount`, 2),
+    `bill_amount`  = ROUND(`bill_amount`, 2);
+ALTER TABLE `lxt_payment_reconcile_discrepancies`
+    MODIFY COLUMN `local_amount` DECIMAL(10, 2) NULL COMMENT '本地金额',
+    MODIFY COLUMN `bill_amount` DECIMAL(10, 2) NULL COMMENT '账单金额';
+
+UPDATE `lxt_user_membership_types`
+SET `price`          = ROUND(`price`, 2),
+    `original_price` = ROUND(`original_price`, 2),
+    `discount`       = ROUND(`discount`, 2);
+ALTER TABLE `lxt_user_membership_types`
+    MODIFY COLUMN `price` DECIMAL(10, 2) NOT NULL DEFAULT 0.00 COMMENT '价格',
+    MODIFY COLUMN `original_price` DECIMAL(10, 2) NOT NULL DEFAULT 0.00 COMMENT '原价',
+    MODIFY COLUMN `discount` DECIMAL(10, 2) NOT NULL DEFAULT 0.00 COMMENT '折扣价';
+
+UPDATE `lxt_user_membership_renewals`
+SET `amount` = ROUND(`amount`, 2);
+ALTER TABLE `lxt_user_membership_renewals`
+    MODIFY COLUMN `amount` DECIMAL(10, 2) NOT NULL DEFAULT 0.00 COMMENT '支付金额';
+
+UPDATE `txy_order`
+SET `amount` = ROUND(`amount`, 2);
+ALTER TABLE `txy_order`
+    MODIFY COLUMN `amount` DECIMAL(10, 2) NOT NULL DEFAULT 0.00 COMMENT '支付金额';
@@ -0,0 +1,50 @@
+-- 优惠券：下单时锁定使用记录，订单支付成功时核销，关闭/取消/下单失败时释放
+
+CREATE TABLE IF NOT EXISTS `lxt_payment_coupons`
+(
+    `id`                  BIGINT         NOT NULL AUTO_INCREMENT COMMENT '主键ID',
+    `code`                VARCHAR(32)    NOT NULL COMMENT '优惠码（唯一）',
+    `name`                VARCHAR(100)   NOT NULL COMMENT '优惠券名称',
+    `type`                VARCHAR(16)    NOT NULL COMMENT '优惠类型：PERCENT折扣/FIXED立减',
+    `value`               DECIMAL(10, 2) NOT NULL DEFAULT 0.00 COMMENT '优惠值：折扣为减免百分比，立减为金额',
+    `max_discount`        DECIMAL(10, 2) NOT NULL DEFAULT 0.00 COMMENT '折扣券最高减免金额，0不限',
+    `min_amount`          DECIMAL(10, 2) NOT NULL DEFAULT 0.00 COMMENT '订单最低金额，0不限',
+    `buy_type`            TINYINT        NOT NULL DEFAULT 0 COMMENT '适用购买类型：0不限2会员3商品',
+    `membership_type_id`  BIGINT         NOT NULL DEFAULT 0 COMMENT '限定会员类型ID，0不限',
+    `classify_id`         INT            NOT NULL DEFAULT 0 COMMENT '限定商品分类ID，0不限',
+    `first_purchase_only` TINYINT        NOT NULL DEFAULT 0 COMMENT '是否仅限首单：0否1是',
+    `total_limit`         INT            NOT NULL DEFAULT 0 COMMENT '总使用次数上限，0不限',
+    `per_user_limit`      INT            NOT NULL DEFAULT 1 COMMENT '每个用户使用次数上限，0不限',
+    `used_count`          INT            NOT NULL DEFAULT 0 COMMENT '已使用次数（订单支付成功）',
+    `start_time`          DATETIME       NULL COMMENT '生效时间',
+    `end_time`            DATETIME       NULL COMMENT '过期时间',
+    `status`              TINYINT        NOT NULL DEFAULT 1 COMMENT '状态：0停用1启用',
+    `remark`              VARCHAR(255)   NOT NULL DEFAULT '' COMMENT '备注',
+    `created_at`          DATETIME       NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    `updated_at`          DATETIME       NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+    `deleted_at`          DATETIME       NULL COMMENT '删除时间',
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_code` (`code`)
+) ENGINE = InnoDB
+  DEFAULT CHARSET = utf8mb4 COMMENT ='优惠券表';
+
+CREATE TABLE IF NOT EXISTS `lxt_payment_coupon_usages`
+(
+    `id`              BIGINT         NOT NULL AUTO_INCREMENT COMMENT '主键ID',
+    `coupon_id`       BIGINT         NOT NULL COMMENT '优惠券ID',
+    `code`            VARCHAR(32)    NOT NULL COMMENT '优惠码',
+    `user_id`         BIGINT         NOT NULL COMMENT '用户ID',
+    `payment_id`      VARCHAR(64)    NOT NULL COMMENT '支付ID（唯一）',
+    `discount_amount` DECIMAL(10, 2) NOT NULL DEFAULT 0.00 COMMENT '优惠金额',
+    `status`          VARCHAR(16)    NOT NULL DEFAULT 'LOCKED' COMMENT '使用状态：LOCKED/USED/RELEASED',
+    `created_at`      DATETIME       NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    `updated_at`      DATETIME       NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_payment_id` (`payment_id`),
+    KEY `idx_coupon_user` (`coupon_id`, `user_id`, `status`)
+) ENGINE = InnoDB
+  DEFAULT CHARSET = utf8mb4 COMMENT ='优惠券使用记录表';
+
+ALTER TABLE `lxt_payment_orders`
+    ADD COLUMN `coupon_code` VARCHAR(32) NOT NULL DEFAULT '' COMMENT '使用的优惠码' AFTER `discount_amount`,
+    ADD COLUMN `coupon_discount` DECIMAL(10, 2) NOT NULL DEFAULT 0.00 COMMENT '优惠券抵扣金额' AFTER `coupon_code`;
@@ -0,0 +1,23 @@
+-- 站内通知：会员到期提醒等系统通知，按 biz_key 去重，同一业务事件只发送一次
+
+CREATE TABLE IF NOT EXISTS `lxt_message_notices`
+(
+    `id`         BIGINT       NOT NULL AUTO_INCREMENT COMMENT '主键ID',
+    `user_id`    BIGINT       NOT NULL COMMENT '接收用户ID',
+    `type`       VARCHAR(32)  NOT NULL COMMENT '通知类型',
+    `title`      VARCHAR(100) NOT NULL COMMENT '通知标题',
+    `content`    VARCHAR(500) NOT NULL COMMENT '通知内容',
+    `biz_key`    VARCHAR(128) NOT NULL COMMENT '业务去重键（唯一）',
+    `is_read`    TINYINT      NOT NULL DEFAULT 0 COMMENT '是否已读：0否1是',
+    `read_at`    DATETIME     NULL COMMENT '阅读时间',
+    `created_at` DATETIME     NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    `updated_at` DATETIME     NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_biz_key` (`biz_key`),
+    KEY `idx_user_id` (`user_id`, `is_read`)
+) ENGINE = InnoDB
+  DEFAULT CHARSET = utf8mb4 COMMENT ='站内通知表';
+
+-- 会员到期任务按到期时间扫描激活会员
+ALTER TABLE `lxt_user_memberships`
+    ADD INDEX `idx_active_end_time` (`is_active`, `end_time`);
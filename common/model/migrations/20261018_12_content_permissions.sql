@@ -0,0 +1,14 @@
+-- 会员专享内容：文章、文档、书籍章节设置阅读全文所需的会员权限标识（对应 lxt_user_membership_permissions.permission_key）
+
+CREATE TABLE IF NOT EXISTS `lxt_content_permissions`
+(
+    `id`             BIGINT       NOT NULL AUTO_INCREMENT COMMENT '主键ID',
+    `content_type`   VARCHAR(16)  NOT NULL COMMENT '内容类型：article/docs/chapter',
+    `content_id`     BIGINT       NOT NULL COMMENT '内容ID',
+    `permission_key` VARCHAR(64)  NOT NULL COMMENT '阅读全文所需的会员权限标识',
+    `created_at`     DATETIME     NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    `updated_at`     DATETIME     NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_content` (`content_type`, `content_id`)
+) ENGINE = InnoDB
+  DEFAULT CHARSET = utf8mb4 COMMENT ='内容访问权限表';
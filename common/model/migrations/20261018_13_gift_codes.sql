@@ -0,0 +1,24 @@
+-- 礼品码：管理员按批次生成一次性兑换码，用户兑换后生成零元已支付订单并开通会员或获得商品
+
+CREATE TABLE IF NOT EXISTS `lxt_payment_gift_codes`
+(
+    `id`                 BIGINT       NOT NULL AUTO_INCREMENT COMMENT '主键ID',
+    `batch_no`           VARCHAR(32)  NOT NULL COMMENT '批次号',
+    `code`               VARCHAR(32)  NOT NULL COMMENT '礼品码（唯一）',
+    `buy_type`           TINYINT      NOT NULL COMMENT '兑换类型：2会员3商品',
+    `membership_type_id` BIGINT       NOT NULL DEFAULT 0 COMMENT '会员类型ID',
+    `goods_id`           BIGINT       NOT NULL DEFAULT 0 COMMENT '商品ID',
+    `status`             VARCHAR(16)  NOT NULL DEFAULT 'UNUSED' COMMENT '状态：UNUSED/REDEEMED/DISABLED',
+    `expire_at`          DATETIME     NULL COMMENT '过期时间，为空不过期',
+    `redeemed_by`        BIGINT       NOT NULL DEFAULT 0 COMMENT '兑换用户ID',
+    `redeemed_at`        DATETIME     NULL COMMENT '兑换时间',
+    `payment_id`         VARCHAR(64)  NOT NULL DEFAULT '' COMMENT '兑换生成的订单支付ID',
+    `remark`             VARCHAR(255) NOT NULL DEFAULT '' COMMENT '备注',
+    `created_by`         BIGINT       NOT NULL DEFAULT 0 COMMENT '生成人（管理员ID）',
+    `created_at`         DATETIME     NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    `updated_at`         DATETIME     NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_code` (`code`),
+    KEY `idx_batch_no` (`batch_no`)
+) ENGINE = InnoDB
+  DEFAULT CHARSET = utf8mb4 COMMENT ='礼品码表';
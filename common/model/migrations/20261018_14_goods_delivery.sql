@@ -0,0 +1,23 @@
+-- 商品文件交付：商品关联七牛或本地文件，已购用户获取限时下载地址，按用户统计下载次数
+
+ALTER TABLE `lxt_payment_goods`
+    ADD COLUMN `file_storage`   VARCHAR(16)  NOT NULL DEFAULT 'qiniu' COMMENT '商品文件存储方式：qiniu七牛；local本地' AFTER `size`,
+    ADD COLUMN `file_key`       VARCHAR(512) NULL COMMENT '商品文件七牛key或本地相对路径' AFTER `file_storage`,
+    ADD COLUMN `download_limit` INT          NOT NULL DEFAULT 0 COMMENT '每个用户可下载次数，0使用默认配置' AFTER `file_key`;
+
+CREATE TABLE IF NOT EXISTS `lxt_payment_goods_downloads`
+(
+    `id`         BIGINT      NOT NULL AUTO_INCREMENT COMMENT '主键ID',
+    `user_id`    BIGINT      NOT NULL COMMENT '用户ID',
+    `goods_id`   BIGINT      NOT NULL COMMENT '商品ID',
+    `payment_id` VARCHAR(64) NOT NULL COMMENT '购买订单支付ID',
+    `client_ip`  VARCHAR(64) NOT NULL DEFAULT '' COMMENT '客户端IP',
+    `created_at` DATETIME    NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '下载时间',
+    PRIMARY KEY (`id`),
+    KEY `idx_user_goods` (`user_id`, `goods_id`)
+) ENGINE = InnoDB
+  DEFAULT CHARSET = utf8mb4 COMMENT ='商品下载记录表';
+
+-- 按用户和商品查询已支付订单
+ALTER TABLE `lxt_payment_orders`
+    ADD INDEX `idx_user_goods_status` (`user_id`, `goods_id`, `status`);
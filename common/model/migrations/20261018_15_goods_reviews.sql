@@ -0,0 +1,22 @@
+-- 商品评价：已购用户对商品评分和评价，商品评分按展示中的评价实时汇总
+
+ALTER TABLE `lxt_payment_goods`
+    ADD COLUMN `rating_total` BIGINT NOT NULL DEFAULT 0 COMMENT '展示中评价的评分合计' AFTER `rating`,
+    ADD COLUMN `review_count` INT    NOT NULL DEFAULT 0 COMMENT '展示中评价数量' AFTER `rating_total`;
+
+CREATE TABLE IF NOT EXISTS `lxt_payment_goods_reviews`
+(
+    `id`         BIGINT      NOT NULL AUTO_INCREMENT COMMENT '主键ID',
+    `goods_id`   BIGINT      NOT NULL COMMENT '商品ID',
+    `user_id`    BIGINT      NOT NULL COMMENT '用户ID',
+    `payment_id` VARCHAR(64) NOT NULL COMMENT '购买订单支付ID',
+    `rating`     TINYINT     NOT NULL COMMENT '评分：1-5',
+    `content`    TEXT        NOT NULL COMMENT '评价内容',
+    `status`     TINYINT     NOT NULL DEFAULT 1 COMMENT '状态：0隐藏；1展示',
+    `created_at` DATETIME    NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    `updated_at` DATETIME    NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_user_goods` (`user_id`, `goods_id`),
+    KEY `idx_goods_status` (`goods_id`, `status`)
+) ENGINE = InnoDB
+  DEFAULT CHARSET = utf8mb4 COMMENT ='商品评价表';
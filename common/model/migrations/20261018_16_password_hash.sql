@@ -0,0 +1,5 @@
+-- 密码存储改为 argon2id 哈希：旧版 AES 密文在用户下次登录成功时升级，截止日期后仍未升级的账号清空密文并标记为强制重置
+
+ALTER TABLE `txy_user`
+    MODIFY COLUMN `password` VARCHAR(255) NOT NULL DEFAULT '' COMMENT '密码（argon2id哈希）',
+    ADD COLUMN `password_reset_required` TINYINT NOT NULL DEFAULT 0 COMMENT '是否需要重置密码：0否；1是' AFTER `password`;
@@ -0,0 +1,48 @@
+-- 后台接口按角色权限鉴权：登记接口所需的按钮权限标识，已存在的标识不重复插入
+-- 超级管理员（txy_user.is_admin=1）拥有全部权限；其他后台用户需为其角色分配下列权限后才能访问对应接口
+
+INSERT INTO `txy_permissions` (`name`, `code`, `type`, `parent_id`, `menu_id`, `path`, `description`, `created_at`, `updated_at`)
+SELECT t.`name`, t.`code`, 'button', 0, 0, '', t.`name`, NOW(), NOW()
+FROM (
+      SELECT '查看文章' AS `name`, 'article:view' AS `code`
+      UNION ALL SELECT '保存文章', 'article:save'
+      UNION ALL SELECT '查看书单', 'book:view'
+      UNION ALL SELECT '保存书单', 'book:save'
+      UNION ALL SELECT '删除章节', 'book:delete'
+      UNION ALL SELECT '查看文档', 'docs:view'
+      UNION ALL SELECT '保存文档', 'docs:save'
+      UNION ALL SELECT '删除文档', 'docs:delete'
+      UNION ALL SELECT '查看标签', 'tag:view'
+      UNION ALL SELECT '保存标签', 'tag:save'
+      UNION ALL SELECT '删除标签', 'tag:delete'
+      UNION ALL SELECT '上传图片', 'file:upload'
+      UNION ALL SELECT '查看支付订单', 'payment:order:view'
+      UNION ALL SELECT '关闭支付订单', 'payment:order:close'
+      UNION ALL SELECT '重发支付通知', 'payment:order:notify'
+      UNION ALL SELECT '查看支付配置', 'payment:config:view'
+      UNION ALL SELECT '保存支付配置', 'payment:config:save'
+      UNION ALL SELECT '查看优惠券', 'coupon:view'
+      UNION ALL SELECT '保存优惠券', 'coupon:save'
+      UNION ALL SELECT '删除优惠券', 'coupon:delete'
+      UNION ALL SELECT '查看礼品码', 'gift-code:view'
+      UNION ALL SELECT '导出礼品码', 'gift-code:export'
+      UNION ALL SELECT '生成礼品码', 'gift-code:generate'
+      UNION ALL SELECT '查看商品', 'goods:view'
+      UNION ALL SELECT '设置商品文件', 'goods:file:save'
+      UNION ALL SELECT '查看商品评价', 'goods:review:view'
+      UNION ALL SELECT '隐藏/展示商品评价', 'goods:review:status'
+      UNION ALL SELECT '查看会员套餐', 'membership:view'
+      UNION ALL SELECT '查看退款', 'refund:view'
+      UNION ALL SELECT '审批退款申请', 'refund:review'
+      UNION ALL SELECT '手动退款', 'refund:manual'
+      UNION ALL SELECT '查看对账', 'reconcile:view'
+      UNION ALL SELECT '手动对账', 'reconcile:run'
+      UNION ALL SELECT '处理对账差异', 'reconcile:resolve'
+      UNION ALL SELECT '保存菜单', 'menu:save'
+      UNION ALL SELECT '查看角色', 'role:view'
+      UNION ALL SELECT '分配角色权限', 'role:permission:save'
+      UNION ALL SELECT '查看用户', 'user:view'
+      UNION ALL SELECT '保存用户', 'user:save'
+      UNION ALL SELECT '强制用户下线', 'user:force-logout'
+     ) AS t
+WHERE NOT EXISTS(SELECT 1 FROM `txy_permissions` AS p WHERE p.`code` = t.`code` AND p.`deleted_at` IS NULL);
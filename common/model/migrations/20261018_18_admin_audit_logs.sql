@@ -0,0 +1,32 @@
+-- 后台操作审计：记录后台每个非 GET 请求的操作人、路由、操作对象、变更前后的字段差异、IP 和结果
+
+CREATE TABLE IF NOT EXISTS `lxt_admin_audit_logs`
+(
+    `id`          BIGINT       NOT NULL AUTO_INCREMENT COMMENT '主键ID',
+    `user_id`     BIGINT       NOT NULL DEFAULT 0 COMMENT '操作人ID',
+    `username`    VARCHAR(64)  NOT NULL DEFAULT '' COMMENT '操作人用户名',
+    `method`      VARCHAR(10)  NOT NULL DEFAULT '' COMMENT '请求方法',
+    `route`       VARCHAR(255) NOT NULL DEFAULT '' COMMENT '路由',
+    `path`        VARCHAR(255) NOT NULL DEFAULT '' COMMENT '请求路径',
+    `entity`      VARCHAR(64)  NOT NULL DEFAULT '' COMMENT '操作对象类型',
+    `entity_id`   VARCHAR(64)  NOT NULL DEFAULT '' COMMENT '操作对象ID',
+    `before_data` MEDIUMTEXT COMMENT '变更前的字段（JSON）',
+    `after_data`  MEDIUMTEXT COMMENT '变更后的字段（JSON）',
+    `request`     MEDIUMTEXT COMMENT '请求参数（JSON，敏感字段已脱敏）',
+    `client_ip`   VARCHAR(64)  NOT NULL DEFAULT '' COMMENT '客户端IP',
+    `status_code` INT          NOT NULL DEFAULT 0 COMMENT 'HTTP状态码',
+    `result`      TINYINT      NOT NULL DEFAULT 0 COMMENT '结果：0失败1成功',
+    `error_msg`   VARCHAR(512) NOT NULL DEFAULT '' COMMENT '失败原因',
+    `duration`    BIGINT       NOT NULL DEFAULT 0 COMMENT '耗时（毫秒）',
+    `created_at`  DATETIME     NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    PRIMARY KEY (`id`),
+    KEY `idx_user_created` (`user_id`, `created_at`),
+    KEY `idx_entity` (`entity`, `entity_id`),
+    KEY `idx_created_at` (`created_at`)
+) ENGINE = InnoDB
+  DEFAULT CHARSET = utf8mb4 COMMENT ='后台操作审计日志表';
+
+INSERT INTO `txy_permissions` (`name`, `code`, `type`, `parent_id`, `menu_id`, `path`, `description`, `created_at`, `updated_at`)
+SELECT '查看审计日志', 'audit:view', 'button', 0, 0, '', '查看审计日志', NOW(), NOW()
+FROM DUAL
+WHERE NOT EXISTS(SELECT 1 FROM `txy_permissions` WHERE `code` = 'audit:view' AND `deleted_at` IS NULL);
@@ -0,0 +1,178 @@
+package alipay
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/shopspring/decimal"
+	"golang.org/x/text/encoding/simplifiedchinese"
+)
+
+// 账单业务类型
+const (
+	BillBizTypeTrade  = "交易"
+	BillBizTypeRefund = "退款"
+)
+
+// BillRecord 业务账单明细
+type BillRecord struct {
+	TradeNo       string          // 支付宝交易号
+	OutTradeNo    string          // 商户订单号
+	BizType       string          // 业务类型：交易/退款
+	Subject       string          // 商品名称
+	CreatedAt     string          // 创建时间
+	FinishedAt    string          // 完成时间
+	TotalAmount   decimal.Decimal // 订单金额（元），退款为负数
+	ReceiptAmount decimal.Decimal // 商家实收（元）
+	OutRequestNo  string          // 退款批次号/请求号
+	ServiceFee    decimal.Decimal // 服务费（元）
+}
+
+// 账单明细列名
+var billColumns = map[string]string{
+	"支付宝交易号":    "trade_no",
+	"商户订单号":     "out_trade_no",
+	"业务类型":      "biz_type",
+	"商品名称":      "subject",
+	"创建时间":      "created_at",
+	"完成时间":      "finished_at",
+	"订单金额（元）":   "total_amount",
+	"商家实收（元）":   "receipt_amount",
+	"退款批次号/请求号": "out_request_no",
+	"服务费（元）":    "service_fee",
+}
+
+// ParseBillZip 解析支付宝下载的账单压缩包，读取其中的业务明细文件（不含汇总文件）
+func ParseBillZip(data []byte) ([]*BillRecord, error) {
+	reader, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to open bill zip: %w", err)
+	}
+
+	for _, file := range reader.File {
+		name := decodeBillText([]byte(file.Name))
+		if !strings.HasSuffix(name, ".csv") || strings.Contains(name, "汇总") {
+			continue
+		}
+
+		rc, err := file.Open()
+		if err != nil {
+			return nil, fmt.Errorf("failed to open bill file %s: %w", name, err)
+		}
+		content, err := io.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read bill file %s: %w", name, err)
+		}
+
+		return ParseBillCSV(content)
+	}
+
+	return nil, fmt.Errorf("bill detail file not found in zip")
+}
+
+// ParseBillCSV 解析业务明细 CSV，支持支付宝默认的 GBK 编码
+// 文件以 # 开头的行为说明信息，表头行包含「支付宝交易号」
+func ParseBillCSV(data []byte) ([]*BillRecord, error) {
+	content := decodeBillText(data)
+
+	var (
+		records []*BillRecord
+		columns map[int]string
+	)
+	for _, line := range strings.Split(content, "\n") {
+		line = strings.TrimRight(line, "\r")
+		if strings.TrimSpace(line) == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields, err := csv.NewReader(strings.NewReader(line)).Read()
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse bill line %q: %w", line, err)
+		}
+		for i := range fields {
+			fields[i] = strings.TrimSpace(fields[i])
+		}
+
+		if columns == nil {
+			if len(fields) > 0 && fields[0] == "支付宝交易号" {
+				columns = make(map[int]string)
+				for i, name := range fields {
+					if key, ok := billColumns[name]; ok {
+						columns[i] = key
+					}
+				}
+			}
+			continue
+		}
+
+		record, err := newBillRecord(columns, fields)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+
+	if columns == nil {
+		return nil, fmt.Errorf("bill header not found")
+	}
+
+	return records, nil
+}
+
+func newBillRecord(columns map[int]string, fields []string) (*BillRecord, error) {
+	record := &BillRecord{}
+	for i, value := range fields {
+		var err error
+		switch columns[i] {
+		case "trade_no":
+			record.TradeNo = value
+		case "out_trade_no":
+			record.OutTradeNo = value
+		case "biz_type":
+			record.BizType = value
+		case "subject":
+			record.Subject = value
+		case "created_at":
+			record.CreatedAt = value
+		case "finished_at":
+			record.FinishedAt = value
+		case "total_amount":
+			record.TotalAmount, err = parseBillAmount(value)
+		case "receipt_amount":
+			record.ReceiptAmount, err = parseBillAmount(value)
+		case "out_request_no":
+			record.OutRequestNo = value
+		case "service_fee":
+			record.ServiceFee, err = parseBillAmount(value)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid amount %q for out_trade_no %s: %w", value, record.OutTradeNo, err)
+		}
+	}
+	return record, nil
+}
+
+func parseBillAmount(value string) (decimal.Decimal, error) {
+	if value == "" {
+		return decimal.Zero, nil
+	}
+	return decimal.NewFromString(value)
+}
+
+// decodeBillText 账单文件默认为 GBK 编码，非 UTF-8 内容按 GBK 转换
+func decodeBillText(data []byte) string {
+	if utf8.Valid(data) {
+		return strings.TrimPrefix(string(data), "\uFEFF")
+	}
+	decoded, err := simplifiedchinese.GBK.NewDecoder().Bytes(data)
+	if err != nil {
+		return string(data)
+	}
+	return string(decoded)
+}
@@ -16,6 +16,8 @@ import (
 	"sort"
 	"strings"
 	"time"
+
+	"github.com/shopspring/decimal"
 )
 
 // AlipayClient 支付宝客户端
@@ -89,34 +91,54 @@ type TradeQueryRequest struct {
 
 // TradeQueryResponse 查询支付订单响应
 type TradeQueryResponse struct {
-	OutTradeNo    string  `json:"out_trade_no"`   // 商户订单号
-	TradeNo       string  `json:"trade_no"`       // 支付宝交易号
-	TradeStatus   string  `json:"trade_status"`   // 交易状态
-	TotalAmount   float64 `json:"total_amount"`   // 交易金额
-	ReceiptAmount float64 `json:"receipt_amount"` // 实收金额
-	BuyerUserId   string  `json:"buyer_user_id"`  // 买家支付宝用户ID
-	BuyerLogonId  string  `json:"buyer_logon_id"` // 买家支付宝账号
-	GmtPayment    string  `json:"gmt_payment"`    // 支付时间
-	GmtClose      string  `json:"gmt_close"`      // 交易关闭时间
+	OutTradeNo    string          `json:"out_trade_no"`   // 商户订单号
+	TradeNo       string          `json:"trade_no"`       // 支付宝交易号
+	TradeStatus   string          `json:"trade_status"`   // 交易状态
+	TotalAmount   decimal.Decimal `json:"total_amount"`   // 交易金额
+	ReceiptAmount decimal.Decimal `json:"receipt_amount"` // 实收金额
+	BuyerUserId   string          `json:"buyer_user_id"`  // 买家支付宝用户ID
+	BuyerLogonId  string          `json:"buyer_logon_id"` // 买家支付宝账号
+	GmtPayment    string          `json:"gmt_payment"`    // 支付时间
+	GmtClose      string          `json:"gmt_close"`      // 交易关闭时间
 }
 
 // TradeRefundRequest 退款请求
 type TradeRefundRequest struct {
-	OutTradeNo   string  `json:"out_trade_no"`             // 商户订单号
-	TradeNo      string  `json:"trade_no,omitempty"`       // 支付宝交易号
-	RefundAmount float64 `json:"refund_amount"`            // 退款金额
-	RefundReason string  `json:"refund_reason,omitempty"`  // 退款原因
-	OutRequestNo string  `json:"out_request_no,omitempty"` // 退款单号
+	OutTradeNo   string `json:"out_trade_no"`             // 商户订单号
+	TradeNo      string `json:"trade_no,omitempty"`       // 支付宝交易号
+	RefundAmount string `json:"refund_amount"`            // 退款金额（字符串格式，如"88.88"）
+	RefundReason string `json:"refund_reason,omitempty"`  // 退款原因
+	OutRequestNo string `json:"out_request_no,omitempty"` // 退款单号
 }
 
 // TradeRefundResponse 退款响应
 type TradeRefundResponse struct {
-	OutTradeNo   string  `json:"out_trade_no"`   // 商户订单号
-	OutRequestNo string  `json:"out_request_no"` // 退款单号
-	RefundAmount float64 `json:"refund_amount"`  // 退款金额
-	RefundFee    float64 `json:"refund_fee"`     // 退款手续费
-	RefundStatus string  `json:"refund_status"`  // 退款状态
-	GmtRefund    string  `json:"gmt_refund"`     // 退款时间
+	OutTradeNo   string          `json:"out_trade_no"`             // 商户订单号
+	OutRequestNo string          `json:"out_request_no"`           // 退款单号
+	RefundAmount decimal.Decimal `json:"refund_amount"`            // 退款金额
+	RefundFee    decimal.Decimal `json:"refund_fee"`               // 退款手续费
+	RefundStatus string          `json:"refund_status"`            // 退款状态
+	FundChange   string          `json:"fund_change"`              // 本次退款是否发生了资金变化：Y/N
+	GmtRefund    string          `json:"gmt_refund_pay,omitempty"` // 退款时间
+}
+
+// TradeRefundQueryRequest 退款查询请求
+type TradeRefundQueryRequest struct {
+	OutTradeNo   string   `json:"out_trade_no,omitempty"`  // 商户订单号
+	TradeNo      string   `json:"trade_no,omitempty"`      // 支付宝交易号
+	OutRequestNo string   `json:"out_request_no"`          // 退款单号
+	QueryOptions []string `json:"query_options,omitempty"` // 查询选项，如 gmt_refund_pay
+}
+
+// TradeRefundQueryResponse 退款查询响应
+type TradeRefundQueryResponse struct {
+	OutTradeNo   string `json:"out_trade_no"`   // 商户订单号
+	TradeNo      string `json:"trade_no"`       // 支付宝交易号
+	OutRequestNo string `json:"out_request_no"` // 退款单号
+	TotalAmount  string `json:"total_amount"`   // 交易金额
+	RefundAmount string `json:"refund_amount"`  // 本次退款金额
+	RefundStatus string `json:"refund_status"`  // 退款状态，为空表示退款未成功
+	GmtRefundPay string `json:"gmt_refund_pay"` // 退款时间
 }
 
 // TradeCloseRequest 关闭订单请求
@@ -141,6 +163,17 @@ type TradeCancelResponse struct {
 	OutTradeNo string `json:"out_trade_no"` // 商户订单号
 }
 
+// BillDownloadUrlQueryRequest 查询对账单下载地址请求
+type BillDownloadUrlQueryRequest struct {
+	BillType string `json:"bill_type"` // 账单类型：trade 业务账单，signcustomer 资金账单
+	BillDate string `json:"bill_date"` // 账单日期：日账单 yyyy-MM-dd，月账单 yyyy-MM
+}
+
+// BillDownloadUrlQueryResponse 查询对账单下载地址响应
+type BillDownloadUrlQueryResponse struct {
+	BillDownloadUrl string `json:"bill_download_url"` // 账单下载地址，30秒内有效
+}
+
 // CreatePayment 创建支付订单（电脑网站支付）
 // 返回支付URL，可直接用于重定向或在浏览器中打开
 func (c *AlipayClient) CreatePayment(req *TradeCreateRequest) (string, error) {
@@ -244,6 +277,66 @@ func (c *AlipayClient) RefundPayment(req *TradeRefundRequest) (*TradeRefundRespo
 	return &result, nil
 }
 
+// QueryRefund 查询退款结果（alipay.trade.fastpay.refund.query）
+func (c *AlipayClient) QueryRefund(req *TradeRefundQueryRequest) (*TradeRefundQueryResponse, error) {
+	bizContent, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal biz_content: %w", err)
+	}
+
+	response, err := c.call("alipay.trade.fastpay.refund.query", string(bizContent))
+	if err != nil {
+		return nil, err
+	}
+
+	var result TradeRefundQueryResponse
+	if err := json.Unmarshal(response, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// QueryBillDownloadUrl 查询对账单下载地址
+func (c *AlipayClient) QueryBillDownloadUrl(req *BillDownloadUrlQueryRequest) (*BillDownloadUrlQueryResponse, error) {
+	bizContent, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal biz_content: %w", err)
+	}
+
+	response, err := c.call("alipay.data.dataservice.bill.downloadurl.query", string(bizContent))
+	if err != nil {
+		return nil, err
+	}
+
+	var result BillDownloadUrlQueryResponse
+	if err := json.Unmarshal(response, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// DownloadBill 下载对账单文件（ZIP 压缩包）
+func (c *AlipayClient) DownloadBill(downloadUrl string) ([]byte, error) {
+	resp, err := c.client.Get(downloadUrl)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download bill: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to download bill: status=%d", resp.StatusCode)
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read bill: %w", err)
+	}
+
+	return data, nil
+}
+
 // ClosePayment 关闭支付订单
 func (c *AlipayClient) ClosePayment(req *TradeCloseRequest) (*TradeCloseResponse, error) {
 	bizContent, err := json.Marshal(req)
@@ -0,0 +1,98 @@
+package alipay
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// VerifyNotify 验证异步通知签名，rawData 为通知原始报文（form 编码）
+func (c *AlipayClient) VerifyNotify(rawData, sign string) error {
+	if strings.TrimSpace(sign) == "" {
+		return fmt.Errorf("sign is empty")
+	}
+
+	signContent, err := BuildNotifySignContent(rawData)
+	if err != nil {
+		return fmt.Errorf("failed to build sign content: %w", err)
+	}
+	if signContent == "" {
+		return fmt.Errorf("sign content is empty")
+	}
+
+	return c.VerifySign(signContent, sign)
+}
+
+// BuildNotifySignContent 构建异步通知待验签字符串：去掉 sign、sign_type 和空值参数后按参数名排序拼接
+func BuildNotifySignContent(rawData string) (string, error) {
+	if strings.TrimSpace(rawData) == "" {
+		return "", fmt.Errorf("raw notify data is empty")
+	}
+
+	segments := strings.Split(rawData, "&")
+	if len(segments) == 0 {
+		return "", fmt.Errorf("invalid notify data")
+	}
+
+	values := make(map[string][]string)
+	for _, segment := range segments {
+		if segment == "" {
+			continue
+		}
+
+		kv := strings.SplitN(segment, "=", 2)
+		key := kv[0]
+		var value string
+		if len(kv) == 2 {
+			value = kv[1]
+		}
+
+		if key == "sign" || key == "sign_type" {
+			continue
+		}
+
+		decodedKey, err := url.QueryUnescape(key)
+		if err != nil {
+			decodedKey = key
+		}
+
+		decodedValue, err := url.QueryUnescape(value)
+		if err != nil {
+			decodedValue = value
+		}
+
+		values[decodedKey] = append(values[decodedKey], decodedValue)
+	}
+
+	if len(values) == 0 {
+		return "", fmt.Errorf("no parameters available for sign")
+	}
+
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var builder strings.Builder
+	first := true
+	for _, key := range keys {
+		for _, value := range values[key] {
+			// 排除空值参数（支付宝规则：空值参数不参与签名）
+			if value == "" {
+				continue
+			}
+			if !first {
+				builder.WriteByte('&')
+			} else {
+				first = false
+			}
+			builder.WriteString(key)
+			builder.WriteByte('=')
+			builder.WriteString(value)
+		}
+	}
+
+	return builder.String(), nil
+}
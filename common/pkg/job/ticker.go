@@ -0,0 +1,62 @@
+package job
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/service"
+	"github.com/zeromicro/go-zero/core/threading"
+)
+
+// tickerJob 按固定间隔执行的后台任务，实现 service.Service 接口
+type tickerJob struct {
+	name     string
+	interval time.Duration
+	run      func(ctx context.Context) error
+	done     chan struct{}
+}
+
+// NewTickerJob 创建按固定间隔执行的后台任务，间隔未配置时默认1分钟，单次执行的超时时间与间隔相同
+func NewTickerJob(name string, intervalSeconds int, run func(ctx context.Context) error) service.Service {
+	interval := time.Duration(intervalSeconds) * time.Second
+	if interval <= 0 {
+		interval = time.Minute
+	}
+	return &tickerJob{
+		name:     name,
+		interval: interval,
+		run:      run,
+		done:     make(chan struct{}),
+	}
+}
+
+// Start 启动定时任务，实现 service.Service 接口
+func (j *tickerJob) Start() {
+	logx.Infof("Starting %s job, interval=%s", j.name, j.interval)
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			threading.RunSafe(j.runOnce)
+		case <-j.done:
+			return
+		}
+	}
+}
+
+// Stop 停止定时任务，实现 service.Service 接口
+func (j *tickerJob) Stop() {
+	close(j.done)
+}
+
+func (j *tickerJob) runOnce() {
+	ctx, cancel := context.WithTimeout(context.Background(), j.interval)
+	defer cancel()
+
+	if err := j.run(ctx); err != nil {
+		logx.Errorf("%s job failed: %v", j.name, err)
+	}
+}
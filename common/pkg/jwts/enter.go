@@ -3,6 +3,7 @@ package jwts
 import (
 	"errors"
 	"github.com/golang-jwt/jwt/v4"
+	"github.com/google/uuid"
 	"time"
 )
 
@@ -11,27 +12,38 @@ type JwtPayLoad struct {
 	UserID   uint   `json:"user_id"`
 	Username string `json:"username"` // 用户名
 	Role     int    `json:"role"`     // 权限  1 普通用户  2 管理员
+	// 会话ID，同一次登录签发的访问令牌和刷新令牌共用，退出登录时据此结束会话
+	SessionID string `json:"sid,omitempty"`
 }
 
 type CustomClaims struct {
 	JwtPayLoad
+	// 毫秒级签发时间，iat 只精确到秒，强制下线按毫秒比较，避免同一秒内重新登录签发的令牌被误判为已吊销
+	IssuedAtMs int64 `json:"iat_ms,omitempty"`
 	jwt.RegisteredClaims
 }
 
-// GenToken 创建 Token
+// GenToken 使用 HS256 密钥创建 Token，expires 为有效期（秒）
 func GenToken(user JwtPayLoad, accessSecret string, expires int64) (string, error) {
-	claim := CustomClaims{
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, newClaims(user, expires))
+	return token.SignedString([]byte(accessSecret))
+}
+
+// newClaims 构建 token 声明，每个 token 带唯一 jti 和签发时间，用于吊销校验
+func newClaims(user JwtPayLoad, expires int64) CustomClaims {
+	now := time.Now()
+	return CustomClaims{
 		JwtPayLoad: user,
+		IssuedAtMs: now.UnixMilli(),
 		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour * time.Duration(expires))),
+			ID:        uuid.NewString(),
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(time.Second * time.Duration(expires))),
 		},
 	}
-
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claim)
-	return token.SignedString([]byte(accessSecret))
 }
 
-// ParseToken 解析 token
+// ParseToken 解析 HS256 token
 func ParseToken(tokenStr string, accessSecret string, expires int64) (*CustomClaims, error) {
 
 	token, err := jwt.ParseWithClaims(tokenStr, &CustomClaims{}, func(token *jwt.Token) (interface{}, error) {
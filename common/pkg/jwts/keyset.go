@@ -0,0 +1,301 @@
+package jwts
+
+import (
+	"context"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+
+	"github.com/golang-jwt/jwt/v4"
+	configurator "github.com/zeromicro/go-zero/core/configcenter"
+	"github.com/zeromicro/go-zero/core/configcenter/subscriber"
+	"github.com/zeromicro/go-zero/core/logc"
+)
+
+// 支持的非对称签名算法
+const (
+	AlgRS256 = "RS256"
+	AlgEdDSA = "EdDSA"
+)
+
+// KeyConf 单个签名密钥配置
+type KeyConf struct {
+	Kid        string // 密钥ID，写入 token 头部的 kid
+	Alg        string // 签名算法：RS256 或 EdDSA
+	PrivateKey string `json:",optional"` // PEM 格式私钥，只用于验证的旧密钥可不配置
+	PublicKey  string `json:",optional"` // PEM 格式公钥，配置了私钥时可省略
+}
+
+// KeySetConf 签名密钥集配置，可写在配置文件中，也可从 etcd 加载
+// 轮换密钥时先加入新密钥并切换 SigningKid，旧密钥保留到其签发的 token 全部过期后再移除
+type KeySetConf struct {
+	SigningKid string    `json:",optional"` // 当前签名使用的密钥ID，为空时使用 AccessSecret 按 HS256 签名
+	Keys       []KeyConf `json:",optional"` // 签名和验证密钥
+	EtcdKey    string    `json:",optional"` // etcd 中密钥集配置的 key，配置后以 etcd 为准并监听变更
+}
+
+// signingKey 解析后的密钥
+type signingKey struct {
+	kid        string
+	method     jwt.SigningMethod
+	privateKey crypto.PrivateKey
+	publicKey  crypto.PublicKey
+}
+
+// KeySet 签名密钥集：按 kid 选择验证密钥，支持轮换期间多个密钥同时有效
+// 不带 kid 的 token 视为旧版 HS256 token，使用 AccessSecret 验证
+type KeySet struct {
+	legacySecret []byte
+
+	mu      sync.RWMutex
+	signing *signingKey
+	keys    map[string]*signingKey
+}
+
+// NewKeySet 创建签名密钥集，legacySecret 为原 HS256 密钥，未配置签名密钥时用于签发，并始终用于验证不带 kid 的 token
+func NewKeySet(c KeySetConf, legacySecret string) (*KeySet, error) {
+	ks := &KeySet{legacySecret: []byte(legacySecret)}
+	if err := ks.Update(c); err != nil {
+		return nil, err
+	}
+	return ks, nil
+}
+
+// MustNewKeySet 创建签名密钥集，配置错误时 panic
+func MustNewKeySet(c KeySetConf, legacySecret string) *KeySet {
+	ks, err := NewKeySet(c, legacySecret)
+	if err != nil {
+		panic(err)
+	}
+	return ks
+}
+
+// Update 替换密钥集，配置校验失败时保留原密钥集
+func (ks *KeySet) Update(c KeySetConf) error {
+	keys := make(map[string]*signingKey, len(c.Keys))
+	for _, kc := range c.Keys {
+		key, err := parseKey(kc)
+		if err != nil {
+			return err
+		}
+		if _, ok := keys[key.kid]; ok {
+			return fmt.Errorf("jwt key %s duplicated", key.kid)
+		}
+		keys[key.kid] = key
+	}
+
+	var signing *signingKey
+	if c.SigningKid != "" {
+		signing = keys[c.SigningKid]
+		if signing == nil {
+			return fmt.Errorf("jwt signing key %s not found", c.SigningKid)
+		}
+		if signing.privateKey == nil {
+			return fmt.Errorf("jwt signing key %s has no private key", c.SigningKid)
+		}
+	} else if len(ks.legacySecret) == 0 {
+		return errors.New("jwt signing key not configured")
+	}
+
+	ks.mu.Lock()
+	ks.signing = signing
+	ks.keys = keys
+	ks.mu.Unlock()
+	return nil
+}
+
+// WatchEtcd 从 etcd 加载密钥集并监听变更，密钥轮换时无需重启服务
+func (ks *KeySet) WatchEtcd(hosts []string, key string) error {
+	ss, err := subscriber.NewEtcdSubscriber(subscriber.EtcdConf{
+		Hosts: hosts,
+		Key:   key,
+	})
+	if err != nil {
+		return err
+	}
+	cc, err := configurator.NewConfigCenter[KeySetConf](configurator.Config{
+		Type: "yaml",
+	}, ss)
+	if err != nil {
+		return err
+	}
+
+	c, err := cc.GetConfig()
+	if err != nil {
+		return err
+	}
+	if err := ks.Update(c); err != nil {
+		return err
+	}
+	cc.AddListener(func() {
+		c, err := cc.GetConfig()
+		if err != nil {
+			logc.Errorf(context.Background(), "jwt keyset GetConfig error: %s", err)
+			return
+		}
+		if err := ks.Update(c); err != nil {
+			logc.Errorf(context.Background(), "jwt keyset update error: %s", err)
+			return
+		}
+		logc.Infof(context.Background(), "jwt keyset updated, signing kid=%s, keys=%d", c.SigningKid, len(c.Keys))
+	})
+	return nil
+}
+
+// GenToken 使用当前签名密钥创建 token，expires 为有效期（秒）
+func (ks *KeySet) GenToken(user JwtPayLoad, expires int64) (string, error) {
+	ks.mu.RLock()
+	signing := ks.signing
+	ks.mu.RUnlock()
+
+	if signing == nil {
+		return GenToken(user, string(ks.legacySecret), expires)
+	}
+	token := jwt.NewWithClaims(signing.method, newClaims(user, expires))
+	token.Header["kid"] = signing.kid
+	return token.SignedString(signing.privateKey)
+}
+
+// ParseToken 按 token 头部的 kid 选择密钥验证并解析 token
+func (ks *KeySet) ParseToken(tokenStr string) (*CustomClaims, error) {
+	token, err := jwt.ParseWithClaims(tokenStr, &CustomClaims{}, ks.keyFunc)
+	if err != nil {
+		return nil, err
+	}
+	if claims, ok := token.Claims.(*CustomClaims); ok && token.Valid {
+		return claims, nil
+	}
+	return nil, errors.New("invalid token")
+}
+
+// keyFunc 根据 kid 返回验证密钥，并校验算法与密钥一致，防止算法混淆攻击
+func (ks *KeySet) keyFunc(token *jwt.Token) (interface{}, error) {
+	kid, _ := token.Header["kid"].(string)
+	if kid == "" {
+		if len(ks.legacySecret) == 0 || token.Method != jwt.SigningMethodHS256 {
+			return nil, errors.New("token kid missing")
+		}
+		return ks.legacySecret, nil
+	}
+
+	ks.mu.RLock()
+	key := ks.keys[kid]
+	ks.mu.RUnlock()
+	if key == nil {
+		return nil, fmt.Errorf("unknown token kid %s", kid)
+	}
+	if token.Method.Alg() != key.method.Alg() {
+		return nil, fmt.Errorf("token alg %s mismatch for kid %s", token.Method.Alg(), kid)
+	}
+	return key.publicKey, nil
+}
+
+// JWK JSON Web Key 公钥
+type JWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Alg string `json:"alg"`
+	Use string `json:"use"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+}
+
+// JWKS JSON Web Key Set，供其他服务在没有私钥的情况下验证 token
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+// JWKS 导出当前全部验证公钥，HS256 密钥不导出
+func (ks *KeySet) JWKS() JWKS {
+	ks.mu.RLock()
+	defer ks.mu.RUnlock()
+
+	jwks := JWKS{Keys: make([]JWK, 0, len(ks.keys))}
+	for _, key := range ks.keys {
+		switch pub := key.publicKey.(type) {
+		case *rsa.PublicKey:
+			jwks.Keys = append(jwks.Keys, JWK{
+				Kty: "RSA",
+				Kid: key.kid,
+				Alg: AlgRS256,
+				Use: "sig",
+				N:   base64.RawURLEncoding.EncodeToString(pub.N.Bytes()),
+				E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes()),
+			})
+		case ed25519.PublicKey:
+			jwks.Keys = append(jwks.Keys, JWK{
+				Kty: "OKP",
+				Kid: key.kid,
+				Alg: AlgEdDSA,
+				Use: "sig",
+				Crv: "Ed25519",
+				X:   base64.RawURLEncoding.EncodeToString(pub),
+			})
+		}
+	}
+	sort.Slice(jwks.Keys, func(i, j int) bool {
+		return jwks.Keys[i].Kid < jwks.Keys[j].Kid
+	})
+	return jwks
+}
+
+// parseKey 解析 PEM 格式密钥，只配置私钥时从私钥导出公钥
+func parseKey(c KeyConf) (*signingKey, error) {
+	if c.Kid == "" {
+		return nil, errors.New("jwt key kid is empty")
+	}
+	if c.PrivateKey == "" && c.PublicKey == "" {
+		return nil, fmt.Errorf("jwt key %s has neither private nor public key", c.Kid)
+	}
+
+	key := &signingKey{kid: c.Kid}
+	switch c.Alg {
+	case AlgRS256:
+		key.method = jwt.SigningMethodRS256
+		if c.PrivateKey != "" {
+			priv, err := jwt.ParseRSAPrivateKeyFromPEM([]byte(c.PrivateKey))
+			if err != nil {
+				return nil, fmt.Errorf("jwt key %s: %w", c.Kid, err)
+			}
+			key.privateKey, key.publicKey = priv, &priv.PublicKey
+		}
+		if c.PublicKey != "" {
+			pub, err := jwt.ParseRSAPublicKeyFromPEM([]byte(c.PublicKey))
+			if err != nil {
+				return nil, fmt.Errorf("jwt key %s: %w", c.Kid, err)
+			}
+			key.publicKey = pub
+		}
+	case AlgEdDSA:
+		key.method = jwt.SigningMethodEdDSA
+		if c.PrivateKey != "" {
+			priv, err := jwt.ParseEdPrivateKeyFromPEM([]byte(c.PrivateKey))
+			if err != nil {
+				return nil, fmt.Errorf("jwt key %s: %w", c.Kid, err)
+			}
+			edPriv, ok := priv.(ed25519.PrivateKey)
+			if !ok {
+				return nil, fmt.Errorf("jwt key %s: not an ed25519 private key", c.Kid)
+			}
+			key.privateKey, key.publicKey = edPriv, edPriv.Public()
+		}
+		if c.PublicKey != "" {
+			pub, err := jwt.ParseEdPublicKeyFromPEM([]byte(c.PublicKey))
+			if err != nil {
+				return nil, fmt.Errorf("jwt key %s: %w", c.Kid, err)
+			}
+			key.publicKey = pub
+		}
+	default:
+		return nil, fmt.Errorf("jwt key %s: unsupported alg %s", c.Kid, c.Alg)
+	}
+	return key, nil
+}
@@ -0,0 +1,248 @@
+package jwts
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"time"
+
+	redisutil "lxtian-blog/common/pkg/redis"
+
+	"github.com/google/uuid"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+)
+
+var (
+	// ErrRefreshTokenInvalid 刷新令牌无效、已过期或会话已结束
+	ErrRefreshTokenInvalid = errors.New("登录已过期，请重新登录")
+	// ErrRefreshTokenReused 已轮换的刷新令牌被再次使用，会话已被吊销
+	ErrRefreshTokenReused = errors.New("登录状态异常，请重新登录")
+)
+
+// rotateRefreshScript 轮换会话的刷新令牌：只有提交的是会话当前的刷新令牌才替换为新令牌
+// 返回 1 轮换成功；0 会话不存在；-1 提交的是已轮换的旧令牌，删除会话使整条令牌链失效
+const rotateRefreshScript = `
+local current = redis.call('GET', KEYS[1])
+if not current then
+	return 0
+end
+if current == ARGV[1] then
+	redis.call('SET', KEYS[1], ARGV[2], 'EX', ARGV[3])
+	return 1
+end
+redis.call('DEL', KEYS[1])
+return -1`
+
+// TokenPair 登录或刷新后签发的令牌对
+type TokenPair struct {
+	AccessToken      string
+	RefreshToken     string
+	ExpiresIn        int64 // 访问令牌有效期（秒）
+	RefreshExpiresIn int64 // 刷新令牌有效期（秒）
+}
+
+// 会话所属的服务，网关和后台共用 Redis，刷新令牌只能在签发它的服务中使用
+const (
+	AudienceGateway = "gateway"
+	AudienceAdmin   = "admin"
+)
+
+// refreshSession 刷新令牌对应的会话信息，以令牌哈希为 key 保存在 Redis 中
+type refreshSession struct {
+	JwtPayLoad
+	Audience   string `json:"audience"`     // 签发会话的服务
+	IssuedAtMs int64  `json:"issued_at_ms"` // 签发时间（毫秒）
+}
+
+// SessionManager 登录会话管理：签发短期访问令牌和可轮换的刷新令牌，并维护吊销列表
+// 刷新令牌每次使用后即轮换，旧令牌再次使用视为泄露，吊销整个会话
+type SessionManager struct {
+	rds           *redis.Redis
+	audience      string
+	keySet        *KeySet
+	accessExpire  int64
+	refreshExpire int64
+}
+
+// NewSessionManager 创建会话管理器，audience 为签发会话的服务，accessExpire、refreshExpire 单位为秒
+func NewSessionManager(rds *redis.Redis, keySet *KeySet, audience string, accessExpire, refreshExpire int64) *SessionManager {
+	return &SessionManager{
+		rds:           rds,
+		audience:      audience,
+		keySet:        keySet,
+		accessExpire:  accessExpire,
+		refreshExpire: refreshExpire,
+	}
+}
+
+// IssueTokens 登录成功后开启新会话，签发访问令牌和刷新令牌
+func (m *SessionManager) IssueTokens(ctx context.Context, user JwtPayLoad) (*TokenPair, error) {
+	user.SessionID = uuid.NewString()
+	refreshToken, err := m.storeRefreshToken(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.rds.SetexCtx(ctx, redisutil.ReturnRedisKey(redisutil.UserSessionRefresh, user.SessionID),
+		hashToken(refreshToken), int(m.refreshExpire)); err != nil {
+		return nil, err
+	}
+	return m.newTokenPair(user, refreshToken)
+}
+
+// Refresh 使用刷新令牌换取新的令牌对，提交的刷新令牌随即失效
+func (m *SessionManager) Refresh(ctx context.Context, refreshToken string) (*TokenPair, error) {
+	if refreshToken == "" {
+		return nil, ErrRefreshTokenInvalid
+	}
+	oldHash := hashToken(refreshToken)
+	data, err := m.rds.GetCtx(ctx, redisutil.ReturnRedisKey(redisutil.UserRefreshToken, oldHash))
+	if err != nil {
+		return nil, err
+	}
+	if data == "" {
+		return nil, ErrRefreshTokenInvalid
+	}
+	var session refreshSession
+	if err := json.Unmarshal([]byte(data), &session); err != nil {
+		return nil, ErrRefreshTokenInvalid
+	}
+	// 其他服务签发的刷新令牌不能在本服务换取访问令牌
+	if session.Audience != m.audience {
+		return nil, ErrRefreshTokenInvalid
+	}
+
+	// 用户被强制下线后，之前签发的刷新令牌全部失效
+	revoked, err := m.revokedByUser(ctx, session.UserID, session.IssuedAtMs)
+	if err != nil {
+		return nil, err
+	}
+	if revoked {
+		return nil, ErrRefreshTokenInvalid
+	}
+
+	// 旧令牌记录保留到自然过期，轮换后再次提交时据此识别为重复使用
+	newToken, err := m.storeRefreshToken(ctx, session.JwtPayLoad)
+	if err != nil {
+		return nil, err
+	}
+	result, err := m.rds.EvalCtx(ctx, rotateRefreshScript,
+		[]string{redisutil.ReturnRedisKey(redisutil.UserSessionRefresh, session.SessionID)},
+		oldHash, hashToken(newToken), m.refreshExpire)
+	if err != nil {
+		return nil, err
+	}
+	switch result {
+	case int64(1):
+		return m.newTokenPair(session.JwtPayLoad, newToken)
+	case int64(-1):
+		return nil, ErrRefreshTokenReused
+	default:
+		return nil, ErrRefreshTokenInvalid
+	}
+}
+
+// Logout 退出登录：吊销当前访问令牌并结束会话，会话内的刷新令牌随之失效
+func (m *SessionManager) Logout(ctx context.Context, claims *CustomClaims) error {
+	if err := m.RevokeToken(ctx, claims); err != nil {
+		return err
+	}
+	if claims.SessionID == "" {
+		return nil
+	}
+	_, err := m.rds.DelCtx(ctx, redisutil.ReturnRedisKey(redisutil.UserSessionRefresh, claims.SessionID))
+	return err
+}
+
+// RevokeToken 将访问令牌加入吊销列表，保留到令牌过期为止
+func (m *SessionManager) RevokeToken(ctx context.Context, claims *CustomClaims) error {
+	if claims.ID == "" || claims.ExpiresAt == nil {
+		return nil
+	}
+	ttl := int(time.Until(claims.ExpiresAt.Time).Seconds()) + 1
+	if ttl <= 1 {
+		return nil
+	}
+	return m.rds.SetexCtx(ctx, redisutil.ReturnRedisKey(redisutil.UserTokenRevoked, claims.ID), "1", ttl)
+}
+
+// RevokeUser 强制用户下线：此刻之前签发的访问令牌和刷新令牌全部失效，下线时间按毫秒记录
+func (m *SessionManager) RevokeUser(ctx context.Context, userId uint) error {
+	ttl := m.accessExpire
+	if m.refreshExpire > ttl {
+		ttl = m.refreshExpire
+	}
+	return m.rds.SetexCtx(ctx, redisutil.ReturnRedisKey(redisutil.UserRevokedBefore, userId),
+		strconv.FormatInt(time.Now().UnixMilli(), 10), int(ttl))
+}
+
+// IsRevoked 检查访问令牌是否已被吊销（按 jti 或按用户）
+func (m *SessionManager) IsRevoked(ctx context.Context, claims *CustomClaims) (bool, error) {
+	if claims.ID != "" {
+		exists, err := m.rds.ExistsCtx(ctx, redisutil.ReturnRedisKey(redisutil.UserTokenRevoked, claims.ID))
+		if err != nil || exists {
+			return exists, err
+		}
+	}
+	issuedAtMs := claims.IssuedAtMs
+	if issuedAtMs == 0 && claims.IssuedAt != nil {
+		// 未携带毫秒签发时间的旧令牌按 iat 比较
+		issuedAtMs = claims.IssuedAt.UnixMilli()
+	}
+	return m.revokedByUser(ctx, claims.UserID, issuedAtMs)
+}
+
+// revokedByUser 判断签发时间（毫秒）是否早于用户的强制下线时间
+func (m *SessionManager) revokedByUser(ctx context.Context, userId uint, issuedAtMs int64) (bool, error) {
+	val, err := m.rds.GetCtx(ctx, redisutil.ReturnRedisKey(redisutil.UserRevokedBefore, userId))
+	if err != nil || val == "" {
+		return false, err
+	}
+	revokedAt, err := strconv.ParseInt(val, 10, 64)
+	if err != nil {
+		return false, nil
+	}
+	return issuedAtMs <= revokedAt, nil
+}
+
+// storeRefreshToken 生成刷新令牌并保存会话信息，Redis 中只保存令牌哈希
+func (m *SessionManager) storeRefreshToken(ctx context.Context, user JwtPayLoad) (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	token := hex.EncodeToString(buf)
+
+	data, err := json.Marshal(refreshSession{JwtPayLoad: user, Audience: m.audience, IssuedAtMs: time.Now().UnixMilli()})
+	if err != nil {
+		return "", err
+	}
+	if err := m.rds.SetexCtx(ctx, redisutil.ReturnRedisKey(redisutil.UserRefreshToken, hashToken(token)),
+		string(data), int(m.refreshExpire)); err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+// newTokenPair 签发访问令牌并与刷新令牌组成令牌对
+func (m *SessionManager) newTokenPair(user JwtPayLoad, refreshToken string) (*TokenPair, error) {
+	accessToken, err := m.keySet.GenToken(user, m.accessExpire)
+	if err != nil {
+		return nil, err
+	}
+	return &TokenPair{
+		AccessToken:      accessToken,
+		RefreshToken:     refreshToken,
+		ExpiresIn:        m.accessExpire,
+		RefreshExpiresIn: m.refreshExpire,
+	}, nil
+}
+
+// hashToken 计算刷新令牌的 SHA-256 哈希
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
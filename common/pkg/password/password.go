@@ -0,0 +1,137 @@
+package password
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"lxtian-blog/common/pkg/utils"
+
+	"golang.org/x/crypto/argon2"
+)
+
+// argon2idPrefix argon2id 哈希的前缀，哈希按 PHC 格式保存：$argon2id$v=19$m=19456,t=2,p=1$<salt>$<hash>
+const argon2idPrefix = "$argon2id$"
+
+// ErrInvalidHash 密码哈希格式错误
+var ErrInvalidHash = errors.New("invalid password hash")
+
+// Params argon2id 参数，随哈希一起保存，调整默认参数后旧哈希仍可校验，并在下次登录时按新参数重新生成
+type Params struct {
+	Memory      uint32 // 内存开销（KiB）
+	Iterations  uint32 // 迭代次数
+	Parallelism uint8  // 并行度
+	SaltLength  uint32 // 盐长度（字节）
+	KeyLength   uint32 // 哈希长度（字节）
+}
+
+// DefaultParams 默认参数，参考 OWASP 推荐的 argon2id 最低配置
+var DefaultParams = Params{
+	Memory:      19 * 1024,
+	Iterations:  2,
+	Parallelism: 1,
+	SaltLength:  16,
+	KeyLength:   32,
+}
+
+// Hash 使用默认参数生成密码哈希
+func Hash(plain string) (string, error) {
+	return HashWithParams(plain, DefaultParams)
+}
+
+// HashWithParams 使用指定参数生成密码哈希
+func HashWithParams(plain string, p Params) (string, error) {
+	salt := make([]byte, p.SaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+	key := argon2.IDKey([]byte(plain), salt, p.Iterations, p.Memory, p.Parallelism, p.KeyLength)
+
+	return fmt.Sprintf("%sv=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2idPrefix, argon2.Version, p.Memory, p.Iterations, p.Parallelism,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key),
+	), nil
+}
+
+// Verify 校验密码，encoded 可以是 argon2id 哈希或旧版 AES 密文
+// needsRehash 为 true 表示密码正确但存储格式或参数已过时，调用方应使用 Hash 重新生成并保存
+func Verify(plain, encoded string) (ok bool, needsRehash bool, err error) {
+	if encoded == "" {
+		return false, false, nil
+	}
+	if IsLegacy(encoded) {
+		return verifyLegacy(plain, encoded)
+	}
+
+	p, salt, key, err := decode(encoded)
+	if err != nil {
+		return false, false, err
+	}
+	other := argon2.IDKey([]byte(plain), salt, p.Iterations, p.Memory, p.Parallelism, p.KeyLength)
+	if subtle.ConstantTimeCompare(key, other) != 1 {
+		return false, false, nil
+	}
+
+	needsRehash = p.Memory != DefaultParams.Memory ||
+		p.Iterations != DefaultParams.Iterations ||
+		p.Parallelism != DefaultParams.Parallelism ||
+		p.KeyLength != DefaultParams.KeyLength
+	return true, needsRehash, nil
+}
+
+// IsLegacy 判断是否为旧版 AES 加密存储的密码
+func IsLegacy(encoded string) bool {
+	return encoded != "" && !strings.HasPrefix(encoded, argon2idPrefix)
+}
+
+// verifyLegacy 校验旧版 AES 加密的密码，校验通过时总是需要重新哈希
+func verifyLegacy(plain, encoded string) (bool, bool, error) {
+	decodedBytes, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return false, false, ErrInvalidHash
+	}
+	decryptedText, err := utils.Decrypt(decodedBytes)
+	if err != nil {
+		return false, false, ErrInvalidHash
+	}
+	if subtle.ConstantTimeCompare([]byte(plain), []byte(decryptedText)) != 1 {
+		return false, false, nil
+	}
+	return true, true, nil
+}
+
+// decode 解析 PHC 格式的 argon2id 哈希
+func decode(encoded string) (Params, []byte, []byte, error) {
+	var p Params
+	parts := strings.Split(encoded, "$")
+	if len(parts) != 6 {
+		return p, nil, nil, ErrInvalidHash
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return p, nil, nil, ErrInvalidHash
+	}
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &p.Memory, &p.Iterations, &p.Parallelism); err != nil {
+		return p, nil, nil, ErrInvalidHash
+	}
+	if p.Memory == 0 || p.Iterations == 0 || p.Parallelism == 0 {
+		return p, nil, nil, ErrInvalidHash
+	}
+
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return p, nil, nil, ErrInvalidHash
+	}
+	key, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil || len(key) == 0 {
+		return p, nil, nil, ErrInvalidHash
+	}
+	p.SaltLength = uint32(len(salt))
+	p.KeyLength = uint32(len(key))
+	return p, salt, key, nil
+}
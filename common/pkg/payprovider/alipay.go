@@ -0,0 +1,194 @@
+package payprovider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"lxtian-blog/common/constant"
+	"lxtian-blog/common/pkg/alipay"
+
+	"github.com/shopspring/decimal"
+)
+
+// alipayProvider 支付宝渠道
+type alipayProvider struct {
+	client *alipay.AlipayClient
+}
+
+// NewAlipayProvider 将支付宝客户端适配为支付渠道
+func NewAlipayProvider(client *alipay.AlipayClient) PaymentProvider {
+	return &alipayProvider{client: client}
+}
+
+func (p *alipayProvider) Name() string {
+	return "alipay"
+}
+
+func (p *alipayProvider) CreatePayment(ctx context.Context, req *CreateRequest) (string, error) {
+	return p.client.CreatePayment(&alipay.TradeCreateRequest{
+		OutTradeNo:  req.OutTradeNo,
+		TotalAmount: req.Amount,
+		Subject:     req.Subject,
+		Body:        req.Body,
+		ProductCode: req.ProductCode,
+		Timeout:     req.Timeout,
+		ReturnUrl:   req.ReturnUrl,
+	})
+}
+
+func (p *alipayProvider) QueryPayment(ctx context.Context, req *QueryRequest) (*QueryResult, error) {
+	resp, err := p.client.QueryPayment(&alipay.TradeQueryRequest{
+		OutTradeNo: req.OutTradeNo,
+		TradeNo:    req.TradeNo,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &QueryResult{
+		OutTradeNo:    resp.OutTradeNo,
+		TradeNo:       resp.TradeNo,
+		TradeStatus:   resp.TradeStatus,
+		TotalAmount:   resp.TotalAmount,
+		ReceiptAmount: resp.ReceiptAmount,
+		BuyerUserId:   resp.BuyerUserId,
+		BuyerLogonId:  resp.BuyerLogonId,
+		PayTime:       parseAlipayTime(resp.GmtPayment),
+	}, nil
+}
+
+func (p *alipayProvider) RefundPayment(ctx context.Context, req *RefundRequest) (*RefundResult, error) {
+	resp, err := p.client.RefundPayment(&alipay.TradeRefundRequest{
+		OutTradeNo:   req.OutTradeNo,
+		TradeNo:      req.TradeNo,
+		RefundAmount: req.RefundAmount.StringFixed(2),
+		RefundReason: req.RefundReason,
+		OutRequestNo: req.OutRequestNo,
+	})
+	if err != nil {
+		if isAlipayBizRejected(err) {
+			return nil, fmt.Errorf("%w: %v", ErrRefundRejected, err)
+		}
+		return nil, err
+	}
+
+	// 支付宝同步返回 fund_change=Y 表示退款已成功
+	status := constant.RefundStatusPending
+	switch {
+	case resp.RefundStatus == constant.AlipayRefundStatusSuccess || resp.FundChange == "Y":
+		status = constant.RefundStatusSuccess
+	case resp.RefundStatus == constant.AlipayRefundStatusClosed:
+		status = constant.RefundStatusClosed
+	}
+
+	return &RefundResult{
+		OutRequestNo: req.OutRequestNo,
+		RefundAmount: resp.RefundAmount,
+		RefundFee:    resp.RefundFee,
+		Status:       status,
+		RawStatus:    resp.RefundStatus,
+		RefundTime:   parseAlipayTime(resp.GmtRefund),
+	}, nil
+}
+
+func (p *alipayProvider) QueryRefund(ctx context.Context, req *RefundQueryRequest) (*RefundResult, error) {
+	resp, err := p.client.QueryRefund(&alipay.TradeRefundQueryRequest{
+		OutTradeNo:   req.OutTradeNo,
+		OutRequestNo: req.OutRequestNo,
+		QueryOptions: []string{"gmt_refund_pay"},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// 支付宝未返回退款状态表示退款未成功，保持待确认
+	status := constant.RefundStatusPending
+	if resp.RefundStatus == constant.AlipayRefundStatusSuccess {
+		status = constant.RefundStatusSuccess
+	}
+	refundAmount, _ := decimal.NewFromString(resp.RefundAmount)
+
+	return &RefundResult{
+		OutRequestNo: resp.OutRequestNo,
+		RefundAmount: refundAmount,
+		Status:       status,
+		RawStatus:    resp.RefundStatus,
+		RefundTime:   parseAlipayTime(resp.GmtRefundPay),
+	}, nil
+}
+
+func (p *alipayProvider) ClosePayment(ctx context.Context, outTradeNo string) error {
+	_, err := p.client.ClosePayment(&alipay.TradeCloseRequest{
+		OutTradeNo: outTradeNo,
+	})
+	if err != nil && strings.Contains(err.Error(), "ACQ.TRADE_NOT_EXIST") {
+		return fmt.Errorf("%w: %v", ErrTradeNotExist, err)
+	}
+	return err
+}
+
+func (p *alipayProvider) CancelPayment(ctx context.Context, outTradeNo string) error {
+	_, err := p.client.CancelPayment(&alipay.TradeCancelRequest{
+		OutTradeNo: outTradeNo,
+	})
+	return err
+}
+
+func (p *alipayProvider) VerifyNotify(ctx context.Context, req *NotifyRequest) (*NotifyResult, error) {
+	values, err := url.ParseQuery(req.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse notify data: %w", err)
+	}
+
+	sign := req.Sign
+	if sign == "" {
+		sign = values.Get("sign")
+	}
+	if err := p.client.VerifyNotify(req.Body, sign); err != nil {
+		return nil, fmt.Errorf("alipay sign verification failed: %w", err)
+	}
+
+	result := &NotifyResult{
+		OutTradeNo:   strings.TrimSpace(values.Get("out_trade_no")),
+		TradeNo:      strings.TrimSpace(values.Get("trade_no")),
+		TradeStatus:  strings.ToUpper(strings.TrimSpace(values.Get("trade_status"))),
+		BuyerId:      values.Get("buyer_id"),
+		BuyerLogonId: values.Get("buyer_logon_id"),
+		PayTime:      parseAlipayTime(values.Get("gmt_payment")),
+	}
+	if result.OutTradeNo == "" {
+		return nil, fmt.Errorf("missing out_trade_no")
+	}
+	if result.TradeStatus == "" {
+		return nil, fmt.Errorf("missing trade_status")
+	}
+	if amount := values.Get("receipt_amount"); amount != "" {
+		if result.ReceiptAmount, err = decimal.NewFromString(amount); err != nil {
+			return nil, fmt.Errorf("invalid receipt_amount %q: %w", amount, err)
+		}
+	}
+
+	return result, nil
+}
+
+// parseAlipayTime 解析支付宝返回的时间（yyyy-MM-dd HH:mm:ss，北京时间）
+func parseAlipayTime(value string) *time.Time {
+	if value == "" {
+		return nil
+	}
+	t, err := time.ParseInLocation("2006-01-02 15:04:05", value, time.Local)
+	if err != nil {
+		return nil
+	}
+	return &t
+}
+
+// isAlipayBizRejected 判断支付宝明确返回的业务失败（code=40004），
+// 系统繁忙（ACQ.SYSTEM_ERROR）和网络、服务不可用等错误结果未知，不视为拒绝
+func isAlipayBizRejected(err error) bool {
+	msg := err.Error()
+	return strings.Contains(msg, "code=40004,") && !strings.Contains(msg, "ACQ.SYSTEM_ERROR")
+}
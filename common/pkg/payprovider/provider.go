@@ -0,0 +1,141 @@
+package payprovider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+var (
+	// ErrTradeNotExist 支付渠道侧不存在该交易（如用户未扫码时关闭订单）
+	ErrTradeNotExist = errors.New("trade not exist")
+	// ErrUnsupportedPayType 未配置该支付方式
+	ErrUnsupportedPayType = errors.New("unsupported pay type")
+	// ErrRefundRejected 支付渠道明确拒绝退款（如余额不足、交易状态不允许退款），
+	// 超时、系统繁忙等结果未知的错误不会返回该错误，需通过退款查询确认
+	ErrRefundRejected = errors.New("refund rejected")
+)
+
+// PaymentProvider 支付渠道，屏蔽支付宝、微信支付等渠道的接口差异
+// 交易状态统一为 constant.TradeStatus*，退款状态统一为 constant.RefundStatus*
+type PaymentProvider interface {
+	// Name 渠道名称
+	Name() string
+	// CreatePayment 创建支付，返回支付链接（支付宝为收银台地址，微信为二维码链接）
+	CreatePayment(ctx context.Context, req *CreateRequest) (string, error)
+	// QueryPayment 查询交易
+	QueryPayment(ctx context.Context, req *QueryRequest) (*QueryResult, error)
+	// RefundPayment 申请退款，渠道明确拒绝时返回 ErrRefundRejected
+	RefundPayment(ctx context.Context, req *RefundRequest) (*RefundResult, error)
+	// QueryRefund 查询退款结果
+	QueryRefund(ctx context.Context, req *RefundQueryRequest) (*RefundResult, error)
+	// ClosePayment 关闭未支付交易，渠道侧不存在交易时返回 ErrTradeNotExist
+	ClosePayment(ctx context.Context, outTradeNo string) error
+	// CancelPayment 撤销交易
+	CancelPayment(ctx context.Context, outTradeNo string) error
+	// VerifyNotify 验证支付结果通知并解析
+	VerifyNotify(ctx context.Context, req *NotifyRequest) (*NotifyResult, error)
+}
+
+// CreateRequest 创建支付请求
+type CreateRequest struct {
+	OutTradeNo  string // 商户订单号
+	Amount      string // 订单金额（元，保留两位小数）
+	Subject     string // 订单标题
+	Body        string // 订单描述
+	ProductCode string // 产品码（支付宝）
+	Timeout     string // 订单超时时间，如 30m、1h、1d
+	ReturnUrl   string // 支付成功跳转地址（支付宝）
+	ClientIp    string // 客户端IP
+}
+
+// QueryRequest 查询交易请求
+type QueryRequest struct {
+	OutTradeNo string // 商户订单号
+	TradeNo    string // 渠道交易号
+}
+
+// QueryResult 交易查询结果
+type QueryResult struct {
+	OutTradeNo    string          // 商户订单号
+	TradeNo       string          // 渠道交易号
+	TradeStatus   string          // 交易状态
+	TotalAmount   decimal.Decimal // 交易金额（元）
+	ReceiptAmount decimal.Decimal // 实收金额（元）
+	BuyerUserId   string          // 买家ID
+	BuyerLogonId  string          // 买家账号
+	PayTime       *time.Time      // 支付时间
+}
+
+// RefundRequest 退款请求
+type RefundRequest struct {
+	OutTradeNo   string          // 商户订单号
+	TradeNo      string          // 渠道交易号
+	OutRequestNo string          // 退款单号
+	RefundAmount decimal.Decimal // 退款金额（元）
+	TotalAmount  decimal.Decimal // 原订单金额（元），微信支付退款必填
+	RefundReason string          // 退款原因
+}
+
+// RefundQueryRequest 退款查询请求
+type RefundQueryRequest struct {
+	OutTradeNo   string // 商户订单号
+	OutRequestNo string // 退款单号
+}
+
+// RefundResult 退款结果
+type RefundResult struct {
+	OutRequestNo string          // 退款单号
+	RefundAmount decimal.Decimal // 退款金额（元）
+	RefundFee    decimal.Decimal // 退款手续费（元）
+	Status       string          // 退款状态，无法确认结果时为 PENDING
+	RawStatus    string          // 渠道返回的原始退款状态
+	RefundTime   *time.Time      // 退款时间
+}
+
+// NotifyRequest 支付结果通知
+type NotifyRequest struct {
+	Body    string            // 通知原始报文
+	Sign    string            // 签名（支付宝为报文中的 sign 参数）
+	Headers map[string]string // 通知请求头（微信支付签名信息在请求头中）
+}
+
+// NotifyResult 支付结果通知解析结果
+type NotifyResult struct {
+	OutTradeNo    string          // 商户订单号
+	TradeNo       string          // 渠道交易号
+	TradeStatus   string          // 交易状态
+	BuyerId       string          // 买家ID
+	BuyerLogonId  string          // 买家账号
+	ReceiptAmount decimal.Decimal // 实收金额（元）
+	PayTime       *time.Time      // 支付时间
+}
+
+// Registry 按支付方式（lxt_payment_orders.pay_type）选择支付渠道
+type Registry struct {
+	providers map[int32]PaymentProvider
+}
+
+// NewRegistry 创建支付渠道注册表
+func NewRegistry() *Registry {
+	return &Registry{
+		providers: make(map[int32]PaymentProvider),
+	}
+}
+
+// Register 注册支付方式对应的支付渠道，仅在服务启动时调用
+func (r *Registry) Register(payType int32, provider PaymentProvider) {
+	r.providers[payType] = provider
+}
+
+// Get 获取支付方式对应的支付渠道
+func (r *Registry) Get(payType int32) (PaymentProvider, error) {
+	provider, ok := r.providers[payType]
+	if !ok {
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedPayType, payType)
+	}
+	return provider, nil
+}
@@ -0,0 +1,245 @@
+package payprovider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"lxtian-blog/common/constant"
+	"lxtian-blog/common/pkg/utils"
+	"lxtian-blog/common/pkg/wechatpay"
+
+	"github.com/shopspring/decimal"
+)
+
+// wechatProvider 微信支付渠道（Native 扫码支付）
+type wechatProvider struct {
+	client *wechatpay.WechatPayClient
+}
+
+// NewWechatProvider 将微信支付客户端适配为支付渠道
+func NewWechatProvider(client *wechatpay.WechatPayClient) PaymentProvider {
+	return &wechatProvider{client: client}
+}
+
+func (p *wechatProvider) Name() string {
+	return "wechat"
+}
+
+func (p *wechatProvider) CreatePayment(ctx context.Context, req *CreateRequest) (string, error) {
+	total, err := yuanStringToFen(req.Amount)
+	if err != nil {
+		return "", err
+	}
+
+	nativeReq := &wechatpay.NativePayRequest{
+		Description: req.Subject,
+		OutTradeNo:  req.OutTradeNo,
+		Amount:      wechatpay.Amount{Total: total},
+		TimeExpire:  time.Now().Add(utils.OrderTimeoutDuration(req.Timeout)).Format(time.RFC3339),
+	}
+
+	resp, err := p.client.NativePay(ctx, nativeReq)
+	if err != nil {
+		return "", err
+	}
+	return resp.CodeUrl, nil
+}
+
+func (p *wechatProvider) QueryPayment(ctx context.Context, req *QueryRequest) (*QueryResult, error) {
+	transaction, err := p.client.QueryOrder(ctx, req.OutTradeNo)
+	if err != nil {
+		if isWechatNotFound(err) {
+			return nil, fmt.Errorf("%w: %v", ErrTradeNotExist, err)
+		}
+		return nil, err
+	}
+
+	return &QueryResult{
+		OutTradeNo:    transaction.OutTradeNo,
+		TradeNo:       transaction.TransactionId,
+		TradeStatus:   wechatTradeStatus(transaction.TradeState),
+		TotalAmount:   fenToYuan(transaction.Amount.Total),
+		ReceiptAmount: fenToYuan(transaction.Amount.PayerTotal),
+		BuyerUserId:   transaction.Payer.OpenId,
+		PayTime:       parseWechatTime(transaction.SuccessTime),
+	}, nil
+}
+
+func (p *wechatProvider) RefundPayment(ctx context.Context, req *RefundRequest) (*RefundResult, error) {
+	resp, err := p.client.Refund(ctx, &wechatpay.RefundRequest{
+		OutTradeNo:    req.OutTradeNo,
+		TransactionId: req.TradeNo,
+		OutRefundNo:   req.OutRequestNo,
+		Reason:        req.RefundReason,
+		Amount: wechatpay.RefundAmount{
+			Refund: yuanToFen(req.RefundAmount),
+			Total:  yuanToFen(req.TotalAmount),
+		},
+	})
+	if err != nil {
+		if isWechatBizRejected(err) {
+			return nil, fmt.Errorf("%w: %v", ErrRefundRejected, err)
+		}
+		return nil, err
+	}
+	return buildWechatRefundResult(resp), nil
+}
+
+func (p *wechatProvider) QueryRefund(ctx context.Context, req *RefundQueryRequest) (*RefundResult, error) {
+	resp, err := p.client.QueryRefund(ctx, req.OutRequestNo)
+	if err != nil {
+		return nil, err
+	}
+	return buildWechatRefundResult(resp), nil
+}
+
+func (p *wechatProvider) ClosePayment(ctx context.Context, outTradeNo string) error {
+	err := p.client.CloseOrder(ctx, outTradeNo)
+	if err != nil && isWechatNotFound(err) {
+		return fmt.Errorf("%w: %v", ErrTradeNotExist, err)
+	}
+	return err
+}
+
+// CancelPayment 微信支付 Native 交易不支持撤销，未支付交易直接关闭
+func (p *wechatProvider) CancelPayment(ctx context.Context, outTradeNo string) error {
+	return p.ClosePayment(ctx, outTradeNo)
+}
+
+func (p *wechatProvider) VerifyNotify(ctx context.Context, req *NotifyRequest) (*NotifyResult, error) {
+	header := wechatpay.NotifyHeader{
+		Timestamp: headerValue(req.Headers, wechatpay.HeaderTimestamp),
+		Nonce:     headerValue(req.Headers, wechatpay.HeaderNonce),
+		Signature: headerValue(req.Headers, wechatpay.HeaderSignature),
+		Serial:    headerValue(req.Headers, wechatpay.HeaderSerial),
+	}
+	if header.Signature == "" {
+		header.Signature = req.Sign
+	}
+
+	notification, err := p.client.ParseNotify(header, []byte(req.Body))
+	if err != nil {
+		return nil, err
+	}
+	if notification.Resource.OriginalType != "transaction" {
+		return nil, fmt.Errorf("unsupported notify type: %s", notification.EventType)
+	}
+
+	var transaction wechatpay.Transaction
+	if err := p.client.DecryptResource(notification.Resource, &transaction); err != nil {
+		return nil, err
+	}
+	if transaction.OutTradeNo == "" {
+		return nil, fmt.Errorf("missing out_trade_no")
+	}
+
+	return &NotifyResult{
+		OutTradeNo:    transaction.OutTradeNo,
+		TradeNo:       transaction.TransactionId,
+		TradeStatus:   wechatTradeStatus(transaction.TradeState),
+		BuyerId:       transaction.Payer.OpenId,
+		ReceiptAmount: fenToYuan(transaction.Amount.PayerTotal),
+		PayTime:       parseWechatTime(transaction.SuccessTime),
+	}, nil
+}
+
+// buildWechatRefundResult 将微信退款状态转换为本地退款状态，异常退款需人工处理，视为失败
+func buildWechatRefundResult(resp *wechatpay.RefundResponse) *RefundResult {
+	status := constant.RefundStatusPending
+	switch resp.Status {
+	case wechatpay.RefundStatusSuccess:
+		status = constant.RefundStatusSuccess
+	case wechatpay.RefundStatusClosed:
+		status = constant.RefundStatusClosed
+	case wechatpay.RefundStatusAbnormal:
+		status = constant.RefundStatusFailed
+	}
+
+	return &RefundResult{
+		OutRequestNo: resp.OutRefundNo,
+		RefundAmount: fenToYuan(resp.Amount.Refund),
+		Status:       status,
+		RawStatus:    resp.Status,
+		RefundTime:   parseWechatTime(resp.SuccessTime),
+	}
+}
+
+// wechatTradeStatus 将微信交易状态转换为统一交易状态（与支付宝交易状态一致）
+// 已转入退款的交易此前已支付成功，按支付成功处理
+func wechatTradeStatus(tradeState string) string {
+	switch tradeState {
+	case wechatpay.TradeStateSuccess, wechatpay.TradeStateRefund:
+		return constant.TradeStatusSuccess
+	case wechatpay.TradeStateClosed, wechatpay.TradeStateRevoked, wechatpay.TradeStatePayError:
+		return constant.TradeStatusClosed
+	case wechatpay.TradeStateNotPay, wechatpay.TradeStateUserPaying:
+		return constant.TradeStatusWaitBuyerPay
+	default:
+		return tradeState
+	}
+}
+
+// isWechatNotFound 判断微信支付返回的订单不存在错误
+func isWechatNotFound(err error) bool {
+	var apiErr *wechatpay.APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	return apiErr.StatusCode == http.StatusNotFound || apiErr.Code == "ORDER_NOT_EXIST" || apiErr.Code == "RESOURCE_NOT_EXISTS"
+}
+
+// isWechatBizRejected 判断微信支付明确返回的业务失败（4xx 应答），
+// 5xx、限频（429）、SYSTEM_ERROR 和网络错误结果未知，不视为拒绝
+func isWechatBizRejected(err error) bool {
+	var apiErr *wechatpay.APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	if apiErr.StatusCode < http.StatusBadRequest || apiErr.StatusCode >= http.StatusInternalServerError ||
+		apiErr.StatusCode == http.StatusTooManyRequests {
+		return false
+	}
+	return apiErr.Code != "SYSTEM_ERROR" && apiErr.Code != "FREQUENCY_LIMITED"
+}
+
+// headerValue 按规范化的名称读取请求头
+func headerValue(headers map[string]string, key string) string {
+	if value, ok := headers[key]; ok {
+		return value
+	}
+	return headers[http.CanonicalHeaderKey(key)]
+}
+
+// yuanStringToFen 金额（元）字符串转换为分
+func yuanStringToFen(amount string) (int64, error) {
+	value, err := decimal.NewFromString(strings.TrimSpace(amount))
+	if err != nil {
+		return 0, fmt.Errorf("invalid amount %q: %w", amount, err)
+	}
+	return value.Mul(decimal.NewFromInt(100)).Round(0).IntPart(), nil
+}
+
+func yuanToFen(amount decimal.Decimal) int64 {
+	return amount.Mul(decimal.NewFromInt(100)).Round(0).IntPart()
+}
+
+func fenToYuan(amount int64) decimal.Decimal {
+	return decimal.New(amount, -2)
+}
+
+// parseWechatTime 解析微信支付返回的 RFC3339 时间
+func parseWechatTime(value string) *time.Time {
+	if value == "" {
+		return nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil
+	}
+	local := t.Local()
+	return &local
+}
@@ -21,10 +21,21 @@ const (
 	OAuthStateString         = 11 //OAuth state
 	UserMemberShipString     = 12 //用户会员
 	DonatePendingOrderString = 13 //捐赠待支付订单
-	DonatePendingOrderSet    = 14 //捐赠订单集合
+	PendingOrderReserve      = 14 //待支付订单预占
 	ApiUserInfoSet           = 15 //用户详情
 	DocViewString            = 16 //文档浏览次数记录
 	ApiWebStringDocDetail    = 17 //文档详情
+	PaymentOrderCloseLock    = 18 //支付订单超时关闭锁
+	PaymentRefundSyncLock    = 19 //退款状态同步锁
+	PaymentReconcileLock     = 20 //账单对账锁
+	PaymentMembershipQuote   = 21 //会员报价
+	UserMembershipPermission = 22 //用户会员权限
+	UserRefreshToken         = 23 //用户刷新令牌
+	UserSessionRefresh       = 24 //用户会话当前刷新令牌
+	UserTokenRevoked         = 25 //已吊销的访问令牌
+	UserRevokedBefore        = 26 //用户令牌吊销时间
+	AdminPermission          = 27 //后台用户权限
+	AdminPermissionVersion   = 28 //后台权限缓存版本
 )
 
 var apiCacheKeys = map[int]string{
@@ -41,10 +52,21 @@ var apiCacheKeys = map[int]string{
 	ArticleViewString:        "article:view",
 	OAuthStateString:         "oauth:state",
 	DonatePendingOrderString: "donate:order",
-	DonatePendingOrderSet:    "donate:pending",
+	PendingOrderReserve:      "payment:pending:reserve",
 	ApiUserInfoSet:           "user:info:set",
 	DocViewString:            "doc:view",
 	ApiWebStringDocDetail:    "web:doc:detail",
+	PaymentOrderCloseLock:    "payment:close:lock",
+	PaymentRefundSyncLock:    "payment:refund:sync:lock",
+	PaymentReconcileLock:     "payment:reconcile:lock",
+	PaymentMembershipQuote:   "payment:membership:quote",
+	UserMembershipPermission: "user:membership:permission",
+	UserRefreshToken:         "user:refresh",
+	UserSessionRefresh:       "user:session:refresh",
+	UserTokenRevoked:         "user:token:revoked",
+	UserRevokedBefore:        "user:token:revoked:before",
+	AdminPermission:          "admin:permission",
+	AdminPermissionVersion:   "admin:permission:version",
 }
 
 /**
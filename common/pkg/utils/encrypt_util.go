@@ -5,12 +5,14 @@ import (
 	"crypto/aes"
 	"crypto/cipher"
 	"crypto/rand"
+	"errors"
 	"io"
 )
 
 var key = []byte("0123456789abcdef")
 
 // 加密
+// Deprecated: 密码存储已改用 common/pkg/password 哈希，此方法仅保留用于兼容旧数据
 func Encrypt(text []byte) ([]byte, error) {
 	block, err := aes.NewCipher(key)
 	if err != nil {
@@ -40,6 +42,11 @@ func Decrypt(ciphertext []byte) (string, error) {
 		return "", err
 	}
 
+	// 密文至少包含 IV 和一个分组，且长度为分组整数倍
+	if len(ciphertext) < 2*aes.BlockSize || len(ciphertext)%aes.BlockSize != 0 {
+		return "", errors.New("ciphertext length invalid")
+	}
+
 	// 提取 IV
 	iv := ciphertext[:aes.BlockSize]
 	ciphertext = ciphertext[aes.BlockSize:]
@@ -49,6 +56,10 @@ func Decrypt(ciphertext []byte) (string, error) {
 	mode.CryptBlocks(ciphertext, ciphertext)
 
 	// 去掉填充
+	padding := int(ciphertext[len(ciphertext)-1])
+	if padding == 0 || padding > aes.BlockSize {
+		return "", errors.New("ciphertext padding invalid")
+	}
 	ciphertext = PKCS7Unpadding(ciphertext)
 
 	return string(ciphertext), nil
@@ -0,0 +1,33 @@
+package utils
+
+import (
+	"crypto/rand"
+	"math/big"
+	"strings"
+)
+
+// 礼品码字符集，去掉了易混淆的 0/O、1/I
+const giftCodeCharset = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+
+// GiftCodeLength 礼品码长度
+const GiftCodeLength = 16
+
+// GenerateGiftCode 使用安全随机数生成礼品码
+func GenerateGiftCode() (string, error) {
+	buf := make([]byte, GiftCodeLength)
+	max := big.NewInt(int64(len(giftCodeCharset)))
+	for i := range buf {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		buf[i] = giftCodeCharset[n.Int64()]
+	}
+	return string(buf), nil
+}
+
+// NormalizeGiftCode 规范化用户输入的礼品码：去掉分隔符和空格并转为大写
+func NormalizeGiftCode(code string) string {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	return strings.NewReplacer("-", "", " ", "").Replace(code)
+}
@@ -0,0 +1,42 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+// ParseAmount 解析金额（元），金额必须大于0且最多两位小数
+func ParseAmount(value string) (decimal.Decimal, error) {
+	amount, err := decimal.NewFromString(strings.TrimSpace(value))
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("金额格式不正确: %s", value)
+	}
+	if !amount.IsPositive() {
+		return decimal.Zero, fmt.Errorf("金额必须大于0")
+	}
+	if !amount.Equal(amount.Round(2)) {
+		return decimal.Zero, fmt.Errorf("金额最多保留两位小数: %s", value)
+	}
+	return amount, nil
+}
+
+// FormatAmount 格式化金额（元），固定保留两位小数，用于 RPC 消息与渠道请求
+func FormatAmount(amount decimal.Decimal) string {
+	return amount.StringFixed(2)
+}
+
+// AmountFromFloat 将 HTTP 接口传入的浮点金额转换为两位小数的金额，仅用于接口边界
+func AmountFromFloat(amount float64) decimal.Decimal {
+	return decimal.NewFromFloat(amount).Round(2)
+}
+
+// AmountToFloat 将 RPC 返回的金额字符串转换为 HTTP 接口的数值金额，仅用于接口边界展示
+func AmountToFloat(value string) float64 {
+	amount, err := decimal.NewFromString(value)
+	if err != nil {
+		return 0
+	}
+	return amount.InexactFloat64()
+}
@@ -0,0 +1,33 @@
+package utils
+
+import (
+	"strconv"
+	"time"
+)
+
+// defaultOrderTimeout 订单超时时间未设置或格式不正确时的默认值
+const defaultOrderTimeout = 30 * time.Minute
+
+// OrderTimeoutDuration 解析订单超时时间：支持 m（分钟）、h（小时）、d/c（天），默认30分钟
+// 本地订单过期关单和支付渠道的交易过期时间均按此计算，保证两侧一致
+func OrderTimeoutDuration(timeout string) time.Duration {
+	if len(timeout) < 2 {
+		return defaultOrderTimeout
+	}
+
+	n, err := strconv.Atoi(timeout[:len(timeout)-1])
+	if err != nil || n <= 0 {
+		return defaultOrderTimeout
+	}
+
+	switch timeout[len(timeout)-1] {
+	case 'm':
+		return time.Duration(n) * time.Minute
+	case 'h':
+		return time.Duration(n) * time.Hour
+	case 'd', 'c':
+		return time.Duration(n) * 24 * time.Hour
+	default:
+		return defaultOrderTimeout
+	}
+}
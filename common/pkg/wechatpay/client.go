@@ -0,0 +1,416 @@
+package wechatpay
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// 签名认证类型
+const authSchema = "WECHATPAY2-SHA256-RSA2048"
+
+// 应答及回调签名相关请求头
+const (
+	HeaderTimestamp = "Wechatpay-Timestamp"
+	HeaderNonce     = "Wechatpay-Nonce"
+	HeaderSignature = "Wechatpay-Signature"
+	HeaderSerial    = "Wechatpay-Serial"
+)
+
+// 交易状态
+const (
+	TradeStateSuccess    = "SUCCESS"    // 支付成功
+	TradeStateRefund     = "REFUND"     // 转入退款
+	TradeStateNotPay     = "NOTPAY"     // 未支付
+	TradeStateClosed     = "CLOSED"     // 已关闭
+	TradeStateRevoked    = "REVOKED"    // 已撤销（仅付款码支付）
+	TradeStateUserPaying = "USERPAYING" // 用户支付中（仅付款码支付）
+	TradeStatePayError   = "PAYERROR"   // 支付失败
+)
+
+// 退款状态
+const (
+	RefundStatusSuccess    = "SUCCESS"    // 退款成功
+	RefundStatusClosed     = "CLOSED"     // 退款关闭
+	RefundStatusProcessing = "PROCESSING" // 退款处理中
+	RefundStatusAbnormal   = "ABNORMAL"   // 退款异常
+)
+
+// WechatPayClient 微信支付 APIv3 客户端
+type WechatPayClient struct {
+	config            *WechatPayConfig
+	client            *http.Client
+	privateKey        *rsa.PrivateKey
+	platformPublicKey *rsa.PublicKey
+}
+
+// NewWechatPayClient 创建微信支付客户端
+func NewWechatPayClient(config *WechatPayConfig) (*WechatPayClient, error) {
+	if err := config.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid config: %w", err)
+	}
+	if config.GatewayUrl == "" {
+		config.GatewayUrl = DefaultGatewayUrl
+	}
+
+	privateKey, err := parsePrivateKey(config.MchPrivateKey)
+	if err != nil {
+		return nil, err
+	}
+	platformPublicKey, err := parsePublicKey(config.PlatformPublicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &WechatPayClient{
+		config:            config,
+		privateKey:        privateKey,
+		platformPublicKey: platformPublicKey,
+		client: &http.Client{
+			Timeout: 30 * time.Second,
+		},
+	}, nil
+}
+
+// Amount 订单金额，单位为分
+type Amount struct {
+	Total    int64  `json:"total"`              // 订单总金额（分）
+	Currency string `json:"currency,omitempty"` // 货币类型，默认 CNY
+}
+
+// NativePayRequest Native 下单请求
+type NativePayRequest struct {
+	AppId       string `json:"appid"`                 // 应用ID
+	MchId       string `json:"mchid"`                 // 商户号
+	Description string `json:"description"`           // 商品描述
+	OutTradeNo  string `json:"out_trade_no"`          // 商户订单号
+	TimeExpire  string `json:"time_expire,omitempty"` // 交易结束时间（RFC3339）
+	Attach      string `json:"attach,omitempty"`      // 附加数据
+	NotifyUrl   string `json:"notify_url"`            // 支付结果通知地址
+	Amount      Amount `json:"amount"`                // 订单金额
+}
+
+// NativePayResponse Native 下单响应
+type NativePayResponse struct {
+	CodeUrl string `json:"code_url"` // 二维码链接
+}
+
+// TransactionPayer 支付者信息
+type TransactionPayer struct {
+	OpenId string `json:"openid"` // 用户在商户 AppID 下的唯一标识
+}
+
+// TransactionAmount 交易金额信息，单位为分
+type TransactionAmount struct {
+	Total      int64  `json:"total"`       // 订单总金额
+	PayerTotal int64  `json:"payer_total"` // 用户实际支付金额
+	Currency   string `json:"currency"`    // 货币类型
+}
+
+// Transaction 交易信息，查询订单和支付成功回调返回相同结构
+type Transaction struct {
+	AppId          string            `json:"appid"`            // 应用ID
+	MchId          string            `json:"mchid"`            // 商户号
+	OutTradeNo     string            `json:"out_trade_no"`     // 商户订单号
+	TransactionId  string            `json:"transaction_id"`   // 微信支付订单号
+	TradeType      string            `json:"trade_type"`       // 交易类型
+	TradeState     string            `json:"trade_state"`      // 交易状态
+	TradeStateDesc string            `json:"trade_state_desc"` // 交易状态描述
+	SuccessTime    string            `json:"success_time"`     // 支付完成时间（RFC3339）
+	Payer          TransactionPayer  `json:"payer"`            // 支付者
+	Amount         TransactionAmount `json:"amount"`           // 订单金额
+}
+
+// RefundAmount 退款金额，单位为分
+type RefundAmount struct {
+	Refund      int64  `json:"refund"`                 // 退款金额
+	Total       int64  `json:"total"`                  // 原订单金额
+	Currency    string `json:"currency"`               // 货币类型
+	PayerRefund int64  `json:"payer_refund,omitempty"` // 用户实际退款金额（仅应答）
+}
+
+// RefundRequest 申请退款请求
+type RefundRequest struct {
+	OutTradeNo    string       `json:"out_trade_no,omitempty"`   // 商户订单号
+	TransactionId string       `json:"transaction_id,omitempty"` // 微信支付订单号
+	OutRefundNo   string       `json:"out_refund_no"`            // 商户退款单号
+	Reason        string       `json:"reason,omitempty"`         // 退款原因
+	NotifyUrl     string       `json:"notify_url,omitempty"`     // 退款结果通知地址
+	Amount        RefundAmount `json:"amount"`                   // 退款金额
+}
+
+// RefundResponse 退款申请及退款查询响应
+type RefundResponse struct {
+	RefundId      string       `json:"refund_id"`      // 微信支付退款单号
+	OutRefundNo   string       `json:"out_refund_no"`  // 商户退款单号
+	TransactionId string       `json:"transaction_id"` // 微信支付订单号
+	OutTradeNo    string       `json:"out_trade_no"`   // 商户订单号
+	Status        string       `json:"status"`         // 退款状态
+	SuccessTime   string       `json:"success_time"`   // 退款成功时间（RFC3339）
+	Amount        RefundAmount `json:"amount"`         // 金额信息
+}
+
+// APIError 微信支付接口错误应答
+type APIError struct {
+	StatusCode int    `json:"-"`       // HTTP 状态码
+	Code       string `json:"code"`    // 错误码
+	Message    string `json:"message"` // 错误描述
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("wechatpay error: status=%d, code=%s, message=%s", e.StatusCode, e.Code, e.Message)
+}
+
+// NativePay Native 下单，返回用于生成支付二维码的 code_url
+func (c *WechatPayClient) NativePay(ctx context.Context, req *NativePayRequest) (*NativePayResponse, error) {
+	if req.AppId == "" {
+		req.AppId = c.config.AppId
+	}
+	if req.MchId == "" {
+		req.MchId = c.config.MchId
+	}
+	if req.NotifyUrl == "" {
+		req.NotifyUrl = c.config.NotifyUrl
+	}
+	if req.Amount.Currency == "" {
+		req.Amount.Currency = "CNY"
+	}
+
+	var resp NativePayResponse
+	if err := c.do(ctx, http.MethodPost, "/v3/pay/transactions/native", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// QueryOrder 按商户订单号查询订单
+func (c *WechatPayClient) QueryOrder(ctx context.Context, outTradeNo string) (*Transaction, error) {
+	path := fmt.Sprintf("/v3/pay/transactions/out-trade-no/%s?mchid=%s",
+		url.PathEscape(outTradeNo), url.QueryEscape(c.config.MchId))
+
+	var resp Transaction
+	if err := c.do(ctx, http.MethodGet, path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// CloseOrder 关闭未支付订单
+func (c *WechatPayClient) CloseOrder(ctx context.Context, outTradeNo string) error {
+	path := fmt.Sprintf("/v3/pay/transactions/out-trade-no/%s/close", url.PathEscape(outTradeNo))
+	return c.do(ctx, http.MethodPost, path, map[string]string{"mchid": c.config.MchId}, nil)
+}
+
+// Refund 申请退款
+func (c *WechatPayClient) Refund(ctx context.Context, req *RefundRequest) (*RefundResponse, error) {
+	if req.Amount.Currency == "" {
+		req.Amount.Currency = "CNY"
+	}
+
+	var resp RefundResponse
+	if err := c.do(ctx, http.MethodPost, "/v3/refund/domestic/refunds", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// QueryRefund 按商户退款单号查询退款
+func (c *WechatPayClient) QueryRefund(ctx context.Context, outRefundNo string) (*RefundResponse, error) {
+	path := "/v3/refund/domestic/refunds/" + url.PathEscape(outRefundNo)
+
+	var resp RefundResponse
+	if err := c.do(ctx, http.MethodGet, path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// do 发送签名请求并验证应答签名，result 为 nil 时忽略应答内容
+func (c *WechatPayClient) do(ctx context.Context, method, path string, body interface{}, result interface{}) error {
+	var payload []byte
+	if body != nil {
+		var err error
+		payload, err = json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("failed to marshal request: %w", err)
+		}
+	}
+
+	authorization, err := c.authorization(method, path, payload)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.config.GatewayUrl+path, bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Authorization", authorization)
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read response: %w", err)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		apiErr := &APIError{StatusCode: resp.StatusCode}
+		if err := json.Unmarshal(respBody, apiErr); err != nil {
+			apiErr.Message = string(respBody)
+		}
+		return apiErr
+	}
+
+	if err := c.VerifySign(NotifyHeader{
+		Timestamp: resp.Header.Get(HeaderTimestamp),
+		Nonce:     resp.Header.Get(HeaderNonce),
+		Signature: resp.Header.Get(HeaderSignature),
+		Serial:    resp.Header.Get(HeaderSerial),
+	}, respBody); err != nil {
+		return fmt.Errorf("failed to verify response sign: %w", err)
+	}
+
+	if result == nil || len(respBody) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(respBody, result); err != nil {
+		return fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+	return nil
+}
+
+// authorization 生成请求签名的 Authorization 头
+// 签名串：请求方法\nURL\n时间戳\n随机串\n请求报文主体\n
+func (c *WechatPayClient) authorization(method, path string, body []byte) (string, error) {
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	nonce, err := nonceStr()
+	if err != nil {
+		return "", err
+	}
+
+	message := method + "\n" + path + "\n" + timestamp + "\n" + nonce + "\n" + string(body) + "\n"
+	hashed := sha256.Sum256([]byte(message))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, c.privateKey, crypto.SHA256, hashed[:])
+	if err != nil {
+		return "", fmt.Errorf("failed to sign request: %w", err)
+	}
+
+	return fmt.Sprintf(`%s mchid="%s",nonce_str="%s",signature="%s",timestamp="%s",serial_no="%s"`,
+		authSchema, c.config.MchId, nonce, base64.StdEncoding.EncodeToString(signature), timestamp, c.config.MchSerialNo), nil
+}
+
+// VerifySign 使用微信支付公钥验证应答或回调签名
+// 验签串：时间戳\n随机串\n报文主体\n
+func (c *WechatPayClient) VerifySign(header NotifyHeader, body []byte) error {
+	if header.Signature == "" || header.Timestamp == "" || header.Nonce == "" {
+		return fmt.Errorf("signature headers are missing")
+	}
+	if c.config.PlatformPublicKeyId != "" && header.Serial != c.config.PlatformPublicKeyId {
+		return fmt.Errorf("unexpected wechatpay serial: %s", header.Serial)
+	}
+
+	signature, err := base64.StdEncoding.DecodeString(header.Signature)
+	if err != nil {
+		return fmt.Errorf("failed to decode signature: %w", err)
+	}
+
+	message := header.Timestamp + "\n" + header.Nonce + "\n" + string(body) + "\n"
+	hashed := sha256.Sum256([]byte(message))
+	return rsa.VerifyPKCS1v15(c.platformPublicKey, crypto.SHA256, hashed[:], signature)
+}
+
+// nonceStr 生成32位随机串
+func nonceStr() (string, error) {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate nonce: %w", err)
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+// parsePrivateKey 解析商户私钥，支持 PKCS#8 和 PKCS#1 格式
+func parsePrivateKey(key string) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode([]byte(formatPEM(key, "PRIVATE KEY")))
+	if block == nil {
+		return nil, fmt.Errorf("failed to decode merchant private key")
+	}
+
+	if parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes); err == nil {
+		privateKey, ok := parsed.(*rsa.PrivateKey)
+		if !ok {
+			return nil, fmt.Errorf("merchant private key is not RSA")
+		}
+		return privateKey, nil
+	}
+
+	privateKey, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse merchant private key: %w", err)
+	}
+	return privateKey, nil
+}
+
+// parsePublicKey 解析微信支付公钥
+func parsePublicKey(key string) (*rsa.PublicKey, error) {
+	block, _ := pem.Decode([]byte(formatPEM(key, "PUBLIC KEY")))
+	if block == nil {
+		return nil, fmt.Errorf("failed to decode wechatpay public key")
+	}
+
+	parsed, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse wechatpay public key: %w", err)
+	}
+	publicKey, ok := parsed.(*rsa.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("wechatpay public key is not RSA")
+	}
+	return publicKey, nil
+}
+
+// formatPEM 格式化密钥，处理环境变量中的字面量 \n，缺少 PEM 头尾时自动补全
+func formatPEM(key, blockType string) string {
+	key = strings.TrimSpace(strings.ReplaceAll(key, "\\n", "\n"))
+	if strings.HasPrefix(key, "-----BEGIN") {
+		return key
+	}
+
+	key = strings.NewReplacer(" ", "", "\n", "", "\r", "").Replace(key)
+
+	var formatted strings.Builder
+	formatted.WriteString("-----BEGIN " + blockType + "-----\n")
+	for i := 0; i < len(key); i += 64 {
+		end := i + 64
+		if end > len(key) {
+			end = len(key)
+		}
+		formatted.WriteString(key[i:end])
+		formatted.WriteString("\n")
+	}
+	formatted.WriteString("-----END " + blockType + "-----")
+	return formatted.String()
+}
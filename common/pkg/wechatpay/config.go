@@ -0,0 +1,53 @@
+package wechatpay
+
+import (
+	"fmt"
+)
+
+// 微信支付 APIv3 网关地址
+const DefaultGatewayUrl = "https://api.mch.weixin.qq.com"
+
+// WechatPayConfig 微信支付配置
+type WechatPayConfig struct {
+	AppId               string `json:"app_id"`                 // 公众号/小程序/应用 AppID
+	MchId               string `json:"mch_id"`                 // 商户号
+	MchSerialNo         string `json:"mch_serial_no"`          // 商户 API 证书序列号
+	MchPrivateKey       string `json:"mch_private_key"`        // 商户 API 证书私钥
+	ApiV3Key            string `json:"api_v3_key"`             // APIv3 密钥，用于解密回调报文
+	PlatformPublicKey   string `json:"platform_public_key"`    // 微信支付公钥，用于验证应答和回调签名
+	PlatformPublicKeyId string `json:"platform_public_key_id"` // 微信支付公钥ID（PUB_KEY_ID_开头）
+	NotifyUrl           string `json:"notify_url"`             // 支付结果通知地址
+	GatewayUrl          string `json:"gateway_url"`            // 网关地址，默认 https://api.mch.weixin.qq.com
+}
+
+// Validate 验证配置
+func (c *WechatPayConfig) Validate() error {
+	if c.AppId == "" {
+		return fmt.Errorf("app_id is required")
+	}
+	if c.MchId == "" {
+		return fmt.Errorf("mch_id is required")
+	}
+	if c.MchSerialNo == "" {
+		return fmt.Errorf("mch_serial_no is required")
+	}
+	if c.MchPrivateKey == "" {
+		return fmt.Errorf("mch_private_key is required")
+	}
+	if len(c.ApiV3Key) != 32 {
+		return fmt.Errorf("api_v3_key must be 32 bytes")
+	}
+	if c.PlatformPublicKey == "" {
+		return fmt.Errorf("platform_public_key is required")
+	}
+	if c.NotifyUrl == "" {
+		return fmt.Errorf("notify_url is required")
+	}
+	return nil
+}
+
+// String 返回配置的字符串表示
+func (c *WechatPayConfig) String() string {
+	return fmt.Sprintf("WechatPayConfig{AppId:%s, MchId:%s, GatewayUrl:%s}",
+		c.AppId, c.MchId, c.GatewayUrl)
+}
@@ -0,0 +1,88 @@
+package wechatpay
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+)
+
+// 回调通知事件类型
+const (
+	EventTransactionSuccess = "TRANSACTION.SUCCESS" // 支付成功
+	EventRefundSuccess      = "REFUND.SUCCESS"      // 退款成功
+	EventRefundAbnormal     = "REFUND.ABNORMAL"     // 退款异常
+	EventRefundClosed       = "REFUND.CLOSED"       // 退款关闭
+)
+
+// NotifyHeader 回调通知签名相关请求头
+type NotifyHeader struct {
+	Timestamp string // Wechatpay-Timestamp
+	Nonce     string // Wechatpay-Nonce
+	Signature string // Wechatpay-Signature
+	Serial    string // Wechatpay-Serial
+}
+
+// NotifyResource 回调通知加密数据
+type NotifyResource struct {
+	OriginalType   string `json:"original_type"`   // 原始回调类型
+	Algorithm      string `json:"algorithm"`       // 加密算法，AEAD_AES_256_GCM
+	Ciphertext     string `json:"ciphertext"`      // Base64 编码的密文
+	AssociatedData string `json:"associated_data"` // 附加数据
+	Nonce          string `json:"nonce"`           // 加密使用的随机串
+}
+
+// Notification 回调通知
+type Notification struct {
+	Id           string         `json:"id"`            // 通知ID
+	CreateTime   string         `json:"create_time"`   // 通知创建时间
+	EventType    string         `json:"event_type"`    // 通知类型
+	ResourceType string         `json:"resource_type"` // 通知数据类型
+	Summary      string         `json:"summary"`       // 回调摘要
+	Resource     NotifyResource `json:"resource"`      // 通知数据
+}
+
+// ParseNotify 验证回调签名并解析通知报文
+func (c *WechatPayClient) ParseNotify(header NotifyHeader, body []byte) (*Notification, error) {
+	if err := c.VerifySign(header, body); err != nil {
+		return nil, fmt.Errorf("failed to verify notify sign: %w", err)
+	}
+
+	var notification Notification
+	if err := json.Unmarshal(body, &notification); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal notify: %w", err)
+	}
+	return &notification, nil
+}
+
+// DecryptResource 使用 APIv3 密钥解密回调通知数据，结果反序列化到 result
+func (c *WechatPayClient) DecryptResource(resource NotifyResource, result interface{}) error {
+	if resource.Algorithm != "AEAD_AES_256_GCM" {
+		return fmt.Errorf("unsupported algorithm: %s", resource.Algorithm)
+	}
+
+	ciphertext, err := base64.StdEncoding.DecodeString(resource.Ciphertext)
+	if err != nil {
+		return fmt.Errorf("failed to decode ciphertext: %w", err)
+	}
+
+	block, err := aes.NewCipher([]byte(c.config.ApiV3Key))
+	if err != nil {
+		return fmt.Errorf("failed to create cipher: %w", err)
+	}
+	gcm, err := cipher.NewGCMWithNonceSize(block, len(resource.Nonce))
+	if err != nil {
+		return fmt.Errorf("failed to create gcm: %w", err)
+	}
+
+	plaintext, err := gcm.Open(nil, []byte(resource.Nonce), ciphertext, []byte(resource.AssociatedData))
+	if err != nil {
+		return fmt.Errorf("failed to decrypt resource: %w", err)
+	}
+
+	if err := json.Unmarshal(plaintext, result); err != nil {
+		return fmt.Errorf("failed to unmarshal resource: %w", err)
+	}
+	return nil
+}
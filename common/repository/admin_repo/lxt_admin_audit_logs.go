@@ -0,0 +1,81 @@
+package admin_repo
+
+import (
+	"context"
+	"time"
+
+	"lxtian-blog/common/model"
+	"lxtian-blog/common/repository"
+
+	"gorm.io/gorm"
+)
+
+// LxtAdminAuditLogsRepo 后台操作审计日志表仓储接口
+type LxtAdminAuditLogsRepo interface {
+	repository.BaseRepository[model.LxtAdminAuditLog]
+
+	CreateLog(ctx context.Context, log *model.LxtAdminAuditLog) error
+	Search(ctx context.Context, filter AuditLogFilter, page, pageSize int) ([]*model.LxtAdminAuditLog, int64, error)
+}
+
+// AuditLogFilter 审计日志查询条件，零值字段不参与过滤
+type AuditLogFilter struct {
+	UserId    int64     // 操作人ID
+	Username  string    // 操作人用户名
+	Entity    string    // 操作对象类型
+	EntityId  string    // 操作对象ID
+	StartTime time.Time // 开始时间（含）
+	EndTime   time.Time // 结束时间（不含）
+}
+
+// lxtAdminAuditLogsRepo 后台操作审计日志表仓储实现
+type lxtAdminAuditLogsRepo struct {
+	*repository.TransactionalBaseRepository[model.LxtAdminAuditLog]
+}
+
+// NewLxtAdminAuditLogsRepo 创建后台操作审计日志表仓储
+func NewLxtAdminAuditLogsRepo(db *gorm.DB) LxtAdminAuditLogsRepo {
+	return &lxtAdminAuditLogsRepo{
+		TransactionalBaseRepository: repository.NewTransactionalBaseRepository[model.LxtAdminAuditLog](db),
+	}
+}
+
+// CreateLog 写入审计日志
+func (r *lxtAdminAuditLogsRepo) CreateLog(ctx context.Context, log *model.LxtAdminAuditLog) error {
+	return r.GetDB(ctx).Create(log).Error
+}
+
+// Search 分页查询审计日志，按时间倒序
+func (r *lxtAdminAuditLogsRepo) Search(ctx context.Context, filter AuditLogFilter, page, pageSize int) ([]*model.LxtAdminAuditLog, int64, error) {
+	db := r.GetDB(ctx).Model(&model.LxtAdminAuditLog{})
+	if filter.UserId > 0 {
+		db = db.Where("user_id = ?", filter.UserId)
+	}
+	if filter.Username != "" {
+		db = db.Where("username = ?", filter.Username)
+	}
+	if filter.Entity != "" {
+		db = db.Where("entity = ?", filter.Entity)
+	}
+	if filter.EntityId != "" {
+		db = db.Where("entity_id = ?", filter.EntityId)
+	}
+	if !filter.StartTime.IsZero() {
+		db = db.Where("created_at >= ?", filter.StartTime)
+	}
+	if !filter.EndTime.IsZero() {
+		db = db.Where("created_at < ?", filter.EndTime)
+	}
+
+	var total int64
+	if err := db.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var logs []*model.LxtAdminAuditLog
+	err := db.Order("id DESC").
+		Offset((page - 1) * pageSize).
+		Limit(pageSize).
+		Find(&logs).Error
+	return logs, total, err
+}
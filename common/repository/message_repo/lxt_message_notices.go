@@ -0,0 +1,50 @@
+package message_repo
+
+import (
+	"context"
+	"fmt"
+
+	"lxtian-blog/common/model"
+	"lxtian-blog/common/repository"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// LxtMessageNoticesRepo 站内通知表仓储接口
+type LxtMessageNoticesRepo interface {
+	repository.BaseRepository[model.LxtMessageNotice]
+
+	CreateIfNotExists(ctx context.Context, notice *model.LxtMessageNotice) (bool, error)
+	GetByBizKey(ctx context.Context, bizKey string) (*model.LxtMessageNotice, error)
+}
+
+// lxtMessageNoticesRepo 站内通知表仓储实现
+type lxtMessageNoticesRepo struct {
+	*repository.TransactionalBaseRepository[model.LxtMessageNotice]
+}
+
+// NewLxtMessageNoticesRepo 创建站内通知表仓储
+func NewLxtMessageNoticesRepo(db *gorm.DB) LxtMessageNoticesRepo {
+	return &lxtMessageNoticesRepo{
+		TransactionalBaseRepository: repository.NewTransactionalBaseRepository[model.LxtMessageNotice](db),
+	}
+}
+
+// CreateIfNotExists 写入通知，biz_key 已存在时不写入并返回 false，保证同一业务事件只通知一次
+func (r *lxtMessageNoticesRepo) CreateIfNotExists(ctx context.Context, notice *model.LxtMessageNotice) (bool, error) {
+	result := r.GetDB(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(notice)
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to create message notice: %w", result.Error)
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// GetByBizKey 根据业务去重键查询通知
+func (r *lxtMessageNoticesRepo) GetByBizKey(ctx context.Context, bizKey string) (*model.LxtMessageNotice, error) {
+	var notice model.LxtMessageNotice
+	if err := r.GetDB(ctx).Where("biz_key = ?", bizKey).First(&notice).Error; err != nil {
+		return nil, err
+	}
+	return &notice, nil
+}
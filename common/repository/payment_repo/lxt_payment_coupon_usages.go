@@ -0,0 +1,101 @@
+package payment_repo
+
+import (
+	"context"
+	"fmt"
+
+	"lxtian-blog/common/constant"
+	"lxtian-blog/common/model"
+	"lxtian-blog/common/repository"
+
+	"gorm.io/gorm"
+)
+
+// LxtPaymentCouponUsagesRepo 优惠券使用记录表仓储接口
+type LxtPaymentCouponUsagesRepo interface {
+	repository.BaseRepository[model.LxtPaymentCouponUsage]
+
+	CreateUsage(ctx context.Context, usage *model.LxtPaymentCouponUsage) error
+	GetByPaymentId(ctx context.Context, paymentId string) (*model.LxtPaymentCouponUsage, error)
+	CountActive(ctx context.Context, couponId, userId int64) (int64, error)
+	Consume(ctx context.Context, paymentId string) (bool, error)
+	Release(ctx context.Context, paymentId string) (bool, error)
+	ChangePaymentId(ctx context.Context, oldPaymentId, newPaymentId string) error
+}
+
+// lxtPaymentCouponUsagesRepo 优惠券使用记录表仓储实现
+type lxtPaymentCouponUsagesRepo struct {
+	*repository.TransactionalBaseRepository[model.LxtPaymentCouponUsage]
+}
+
+// NewLxtPaymentCouponUsagesRepo 创建优惠券使用记录表仓储
+func NewLxtPaymentCouponUsagesRepo(db *gorm.DB) LxtPaymentCouponUsagesRepo {
+	return &lxtPaymentCouponUsagesRepo{
+		TransactionalBaseRepository: repository.NewTransactionalBaseRepository[model.LxtPaymentCouponUsage](db),
+	}
+}
+
+// CreateUsage 写入使用记录，在事务中调用时与订单一同提交
+func (r *lxtPaymentCouponUsagesRepo) CreateUsage(ctx context.Context, usage *model.LxtPaymentCouponUsage) error {
+	if err := r.GetDB(ctx).Create(usage).Error; err != nil {
+		return fmt.Errorf("failed to create coupon usage: %w", err)
+	}
+	return nil
+}
+
+// GetByPaymentId 查询订单的优惠券使用记录
+func (r *lxtPaymentCouponUsagesRepo) GetByPaymentId(ctx context.Context, paymentId string) (*model.LxtPaymentCouponUsage, error) {
+	var usage model.LxtPaymentCouponUsage
+	if err := r.GetDB(ctx).Where("payment_id = ?", paymentId).First(&usage).Error; err != nil {
+		return nil, err
+	}
+	return &usage, nil
+}
+
+// CountActive 统计优惠券锁定中和已使用的次数，userId 为 0 时统计全部用户
+func (r *lxtPaymentCouponUsagesRepo) CountActive(ctx context.Context, couponId, userId int64) (int64, error) {
+	var count int64
+	query := r.GetDB(ctx).Model(&model.LxtPaymentCouponUsage{}).
+		Where("coupon_id = ? AND status IN ?", couponId, []string{constant.CouponUsageLocked, constant.CouponUsageUsed})
+	if userId > 0 {
+		query = query.Where("user_id = ?", userId)
+	}
+	if err := query.Count(&count).Error; err != nil {
+		return 0, fmt.Errorf("failed to count coupon usages: %w", err)
+	}
+	return count, nil
+}
+
+// Consume 将订单的优惠券使用记录标记为已使用，迟到的支付成功通知可使已释放的记录重新生效
+// 返回是否更新成功，重复调用返回 false
+func (r *lxtPaymentCouponUsagesRepo) Consume(ctx context.Context, paymentId string) (bool, error) {
+	result := r.GetDB(ctx).Model(&model.LxtPaymentCouponUsage{}).
+		Where("payment_id = ? AND status IN ?", paymentId, []string{constant.CouponUsageLocked, constant.CouponUsageReleased}).
+		Update("status", constant.CouponUsageUsed)
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to consume coupon usage: %w", result.Error)
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// Release 释放订单锁定的优惠券，返回是否更新成功
+func (r *lxtPaymentCouponUsagesRepo) Release(ctx context.Context, paymentId string) (bool, error) {
+	result := r.GetDB(ctx).Model(&model.LxtPaymentCouponUsage{}).
+		Where("payment_id = ? AND status = ?", paymentId, constant.CouponUsageLocked).
+		Update("status", constant.CouponUsageReleased)
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to release coupon usage: %w", result.Error)
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// ChangePaymentId 订单重新发起支付生成新的支付ID时，将使用记录迁移到新的支付ID，需与订单更新在同一事务中调用
+func (r *lxtPaymentCouponUsagesRepo) ChangePaymentId(ctx context.Context, oldPaymentId, newPaymentId string) error {
+	err := r.GetDB(ctx).Model(&model.LxtPaymentCouponUsage{}).
+		Where("payment_id = ?", oldPaymentId).
+		Update("payment_id", newPaymentId).Error
+	if err != nil {
+		return fmt.Errorf("failed to change coupon usage payment id: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package payment_repo
+
+import (
+	"context"
+	"fmt"
+
+	"lxtian-blog/common/model"
+	"lxtian-blog/common/repository"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// LxtPaymentCouponsRepo 优惠券表仓储接口
+type LxtPaymentCouponsRepo interface {
+	repository.BaseRepository[model.LxtPaymentCoupon]
+
+	GetByCode(ctx context.Context, code string) (*model.LxtPaymentCoupon, error)
+	CodeExists(ctx context.Context, code string, excludeId int64) (bool, error)
+	LockByCode(ctx context.Context, code string) (*model.LxtPaymentCoupon, error)
+	IncrUsedCount(ctx context.Context, id int64) error
+}
+
+// lxtPaymentCouponsRepo 优惠券表仓储实现
+type lxtPaymentCouponsRepo struct {
+	*repository.TransactionalBaseRepository[model.LxtPaymentCoupon]
+}
+
+// NewLxtPaymentCouponsRepo 创建优惠券表仓储
+func NewLxtPaymentCouponsRepo(db *gorm.DB) LxtPaymentCouponsRepo {
+	return &lxtPaymentCouponsRepo{
+		TransactionalBaseRepository: repository.NewTransactionalBaseRepository[model.LxtPaymentCoupon](db),
+	}
+}
+
+// GetByCode 根据优惠码查询优惠券
+func (r *lxtPaymentCouponsRepo) GetByCode(ctx context.Context, code string) (*model.LxtPaymentCoupon, error) {
+	var coupon model.LxtPaymentCoupon
+	if err := r.GetDB(ctx).Where("code = ?", code).First(&coupon).Error; err != nil {
+		return nil, err
+	}
+	return &coupon, nil
+}
+
+// CodeExists 优惠码是否已被其他优惠券占用，已删除的优惠券同样占用优惠码，避免与历史使用记录混淆
+func (r *lxtPaymentCouponsRepo) CodeExists(ctx context.Context, code string, excludeId int64) (bool, error) {
+	var count int64
+	err := r.GetDB(ctx).Unscoped().Model(&model.LxtPaymentCoupon{}).
+		Where("code = ? AND id <> ?", code, excludeId).
+		Count(&count).Error
+	return count > 0, err
+}
+
+// LockByCode 加行锁查询优惠券，需在事务中调用，用于串行化同一优惠券的用量校验
+func (r *lxtPaymentCouponsRepo) LockByCode(ctx context.Context, code string) (*model.LxtPaymentCoupon, error) {
+	var coupon model.LxtPaymentCoupon
+	err := r.GetDB(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("code = ?", code).
+		First(&coupon).Error
+	if err != nil {
+		return nil, err
+	}
+	return &coupon, nil
+}
+
+// IncrUsedCount 优惠券已使用次数加一
+func (r *lxtPaymentCouponsRepo) IncrUsedCount(ctx context.Context, id int64) error {
+	err := r.GetDB(ctx).Model(&model.LxtPaymentCoupon{}).
+		Where("id = ?", id).
+		UpdateColumn("used_count", gorm.Expr("used_count + 1")).Error
+	if err != nil {
+		return fmt.Errorf("failed to increase coupon used count: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,46 @@
+package payment_repo
+
+import (
+	"context"
+
+	"lxtian-blog/common/model"
+	"lxtian-blog/common/repository"
+
+	"gorm.io/gorm"
+)
+
+// LxtPaymentGiftCodesRepo 礼品码表仓储接口
+type LxtPaymentGiftCodesRepo interface {
+	repository.BaseRepository[model.LxtPaymentGiftCode]
+
+	CreateBatch(ctx context.Context, codes []*model.LxtPaymentGiftCode) error
+	FindForExport(ctx context.Context, condition map[string]interface{}, limit int) ([]*model.LxtPaymentGiftCode, error)
+}
+
+// lxtPaymentGiftCodesRepo 礼品码表仓储实现
+type lxtPaymentGiftCodesRepo struct {
+	*repository.TransactionalBaseRepository[model.LxtPaymentGiftCode]
+}
+
+// NewLxtPaymentGiftCodesRepo 创建礼品码表仓储
+func NewLxtPaymentGiftCodesRepo(db *gorm.DB) LxtPaymentGiftCodesRepo {
+	return &lxtPaymentGiftCodesRepo{
+		TransactionalBaseRepository: repository.NewTransactionalBaseRepository[model.LxtPaymentGiftCode](db),
+	}
+}
+
+// CreateBatch 批量写入一批礼品码，礼品码重复时整批失败
+func (r *lxtPaymentGiftCodesRepo) CreateBatch(ctx context.Context, codes []*model.LxtPaymentGiftCode) error {
+	return r.GetDB(ctx).CreateInBatches(codes, 200).Error
+}
+
+// FindForExport 按条件查询礼品码用于导出，condition 的键为带占位符的查询条件
+func (r *lxtPaymentGiftCodesRepo) FindForExport(ctx context.Context, condition map[string]interface{}, limit int) ([]*model.LxtPaymentGiftCode, error) {
+	var codes []*model.LxtPaymentGiftCode
+	db := r.GetDB(ctx)
+	for query, arg := range condition {
+		db = db.Where(query, arg)
+	}
+	err := db.Order("id ASC").Limit(limit).Find(&codes).Error
+	return codes, err
+}
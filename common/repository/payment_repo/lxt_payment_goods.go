@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 	"lxtian-blog/common/model"
 	"lxtian-blog/common/repository"
+	"math"
 )
 
 // LxtPaymentGoodsRepo 商品表仓储接口
@@ -14,6 +16,8 @@ type LxtPaymentGoodsRepo interface {
 
 	GetById(ctx context.Context, Id int64) (*model.LxtPaymentGood, error)
 	GetByOrderId(ctx context.Context, orderId string) (*model.LxtPaymentGood, error)
+	IncrDownloads(ctx context.Context, id int64) error
+	AdjustRating(ctx context.Context, id int64, ratingDelta, countDelta int64) error
 }
 
 // lxtPaymentGoodsRepo 商品表仓储实现
@@ -42,3 +46,42 @@ func (r *lxtPaymentGoodsRepo) GetByOrderId(ctx context.Context, orderId string)
 		"order_id": orderId,
 	})
 }
+
+// IncrDownloads 商品下载次数加一
+func (r *lxtPaymentGoodsRepo) IncrDownloads(ctx context.Context, id int64) error {
+	return r.GetDB(ctx).Model(&model.LxtPaymentGood{}).
+		Where("id = ?", id).
+		UpdateColumn("downloads", gorm.Expr("downloads + ?", 1)).Error
+}
+
+// AdjustRating 增量调整商品的评分合计和评价数，并按调整后的值重算平均评分（保留一位小数）
+// 需在事务中调用，商品行锁保证并发评价时合计与评价数一致
+func (r *lxtPaymentGoodsRepo) AdjustRating(ctx context.Context, id int64, ratingDelta, countDelta int64) error {
+	db := r.GetDB(ctx)
+	var goods model.LxtPaymentGood
+	if err := db.Unscoped().
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Select("id", "rating_total", "review_count").
+		Where("id = ?", id).
+		First(&goods).Error; err != nil {
+		return err
+	}
+
+	total := goods.RatingTotal + ratingDelta
+	count := int64(goods.ReviewCount) + countDelta
+	if total < 0 || count <= 0 {
+		total, count = 0, 0
+	}
+	rating := 0.0
+	if count > 0 {
+		rating = math.Round(float64(total)/float64(count)*10) / 10
+	}
+
+	return db.Unscoped().Model(&model.LxtPaymentGood{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"rating_total": total,
+			"review_count": count,
+			"rating":       rating,
+		}).Error
+}
@@ -0,0 +1,44 @@
+package payment_repo
+
+import (
+	"context"
+
+	"lxtian-blog/common/model"
+	"lxtian-blog/common/repository"
+
+	"gorm.io/gorm"
+)
+
+// LxtPaymentGoodsDownloadsRepo 商品下载记录表仓储接口
+type LxtPaymentGoodsDownloadsRepo interface {
+	repository.BaseRepository[model.LxtPaymentGoodsDownload]
+
+	CreateDownload(ctx context.Context, download *model.LxtPaymentGoodsDownload) error
+	CountByUserGoods(ctx context.Context, userId, goodsId int64) (int64, error)
+}
+
+// lxtPaymentGoodsDownloadsRepo 商品下载记录表仓储实现
+type lxtPaymentGoodsDownloadsRepo struct {
+	*repository.TransactionalBaseRepository[model.LxtPaymentGoodsDownload]
+}
+
+// NewLxtPaymentGoodsDownloadsRepo 创建商品下载记录表仓储
+func NewLxtPaymentGoodsDownloadsRepo(db *gorm.DB) LxtPaymentGoodsDownloadsRepo {
+	return &lxtPaymentGoodsDownloadsRepo{
+		TransactionalBaseRepository: repository.NewTransactionalBaseRepository[model.LxtPaymentGoodsDownload](db),
+	}
+}
+
+// CreateDownload 写入下载记录，在事务中调用时与下载计数一同提交
+func (r *lxtPaymentGoodsDownloadsRepo) CreateDownload(ctx context.Context, download *model.LxtPaymentGoodsDownload) error {
+	return r.GetDB(ctx).Create(download).Error
+}
+
+// CountByUserGoods 统计用户对某个商品的下载次数
+func (r *lxtPaymentGoodsDownloadsRepo) CountByUserGoods(ctx context.Context, userId, goodsId int64) (int64, error) {
+	var count int64
+	err := r.GetDB(ctx).Model(&model.LxtPaymentGoodsDownload{}).
+		Where("user_id = ? AND goods_id = ?", userId, goodsId).
+		Count(&count).Error
+	return count, err
+}
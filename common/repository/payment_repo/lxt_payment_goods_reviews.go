@@ -0,0 +1,100 @@
+package payment_repo
+
+import (
+	"context"
+
+	"lxtian-blog/common/model"
+	"lxtian-blog/common/repository"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// LxtPaymentGoodsReviewsRepo 商品评价表仓储接口
+type LxtPaymentGoodsReviewsRepo interface {
+	repository.BaseRepository[model.LxtPaymentGoodsReview]
+
+	CreateReview(ctx context.Context, review *model.LxtPaymentGoodsReview) error
+	ExistsByUserGoods(ctx context.Context, userId, goodsId int64) (bool, error)
+	LockById(ctx context.Context, id int64) (*model.LxtPaymentGoodsReview, error)
+	UpdateStatus(ctx context.Context, id int64, status int32) error
+	FindByGoods(ctx context.Context, goodsId int64, status int32, page, pageSize int) ([]*GoodsReviewItem, int64, error)
+}
+
+// GoodsReviewItem 商品评价及评价用户信息
+type GoodsReviewItem struct {
+	model.LxtPaymentGoodsReview
+	Nickname string `json:"nickname"` // 用户昵称
+	HeadImg  string `json:"head_img"` // 用户头像
+}
+
+// lxtPaymentGoodsReviewsRepo 商品评价表仓储实现
+type lxtPaymentGoodsReviewsRepo struct {
+	*repository.TransactionalBaseRepository[model.LxtPaymentGoodsReview]
+}
+
+// NewLxtPaymentGoodsReviewsRepo 创建商品评价表仓储
+func NewLxtPaymentGoodsReviewsRepo(db *gorm.DB) LxtPaymentGoodsReviewsRepo {
+	return &lxtPaymentGoodsReviewsRepo{
+		TransactionalBaseRepository: repository.NewTransactionalBaseRepository[model.LxtPaymentGoodsReview](db),
+	}
+}
+
+// CreateReview 写入评价，在事务中调用时与评分调整一同提交
+func (r *lxtPaymentGoodsReviewsRepo) CreateReview(ctx context.Context, review *model.LxtPaymentGoodsReview) error {
+	return r.GetDB(ctx).Create(review).Error
+}
+
+// ExistsByUserGoods 用户是否已评价过该商品（含已隐藏的评价）
+func (r *lxtPaymentGoodsReviewsRepo) ExistsByUserGoods(ctx context.Context, userId, goodsId int64) (bool, error) {
+	var count int64
+	err := r.GetDB(ctx).Model(&model.LxtPaymentGoodsReview{}).
+		Where("user_id = ? AND goods_id = ?", userId, goodsId).
+		Count(&count).Error
+	return count > 0, err
+}
+
+// LockById 加行锁查询评价，需在事务中调用
+func (r *lxtPaymentGoodsReviewsRepo) LockById(ctx context.Context, id int64) (*model.LxtPaymentGoodsReview, error) {
+	var review model.LxtPaymentGoodsReview
+	err := r.GetDB(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ?", id).
+		First(&review).Error
+	if err != nil {
+		return nil, err
+	}
+	return &review, nil
+}
+
+// UpdateStatus 更新评价展示状态
+func (r *lxtPaymentGoodsReviewsRepo) UpdateStatus(ctx context.Context, id int64, status int32) error {
+	return r.GetDB(ctx).Model(&model.LxtPaymentGoodsReview{}).
+		Where("id = ?", id).
+		Update("status", status).Error
+}
+
+// FindByGoods 分页查询商品评价，按时间倒序，goodsId 为0时不限商品，status 小于0时不限状态
+func (r *lxtPaymentGoodsReviewsRepo) FindByGoods(ctx context.Context, goodsId int64, status int32, page, pageSize int) ([]*GoodsReviewItem, int64, error) {
+	db := r.GetDB(ctx).Table(model.TableNameLxtPaymentGoodsReview + " AS r")
+	if goodsId > 0 {
+		db = db.Where("r.goods_id = ?", goodsId)
+	}
+	if status >= 0 {
+		db = db.Where("r.status = ?", status)
+	}
+
+	var total int64
+	if err := db.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var items []*GoodsReviewItem
+	err := db.Select("r.*, IFNULL(u.nickname, '') AS nickname, IFNULL(u.head_img, '') AS head_img").
+		Joins("LEFT JOIN txy_user u ON u.id = r.user_id").
+		Order("r.id DESC").
+		Offset((page - 1) * pageSize).
+		Limit(pageSize).
+		Scan(&items).Error
+	return items, total, err
+}
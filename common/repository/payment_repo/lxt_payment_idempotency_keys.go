@@ -0,0 +1,83 @@
+package payment_repo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"lxtian-blog/common/constant"
+	"lxtian-blog/common/model"
+	"lxtian-blog/common/repository"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// LxtPaymentIdempotencyKeysRepo 支付接口幂等键表仓储接口
+type LxtPaymentIdempotencyKeysRepo interface {
+	repository.BaseRepository[model.LxtPaymentIdempotencyKey]
+
+	CreateIfNotExists(ctx context.Context, record *model.LxtPaymentIdempotencyKey) (bool, error)
+	GetByKey(ctx context.Context, scope, key string) (*model.LxtPaymentIdempotencyKey, error)
+	TakeOverStale(ctx context.Context, id int64, staleBefore time.Time) (bool, error)
+	MarkSuccess(ctx context.Context, id int64, response string) error
+	DeleteProcessing(ctx context.Context, id int64) error
+}
+
+// lxtPaymentIdempotencyKeysRepo 支付接口幂等键表仓储实现
+type lxtPaymentIdempotencyKeysRepo struct {
+	*repository.TransactionalBaseRepository[model.LxtPaymentIdempotencyKey]
+}
+
+// NewLxtPaymentIdempotencyKeysRepo 创建支付接口幂等键表仓储
+func NewLxtPaymentIdempotencyKeysRepo(db *gorm.DB) LxtPaymentIdempotencyKeysRepo {
+	return &lxtPaymentIdempotencyKeysRepo{
+		TransactionalBaseRepository: repository.NewTransactionalBaseRepository[model.LxtPaymentIdempotencyKey](db),
+	}
+}
+
+// CreateIfNotExists 写入幂等键，(scope, idempotency_key) 已存在时不写入并返回 false，依赖 uk_scope_key 唯一索引保证并发重试只有一个写入成功
+func (r *lxtPaymentIdempotencyKeysRepo) CreateIfNotExists(ctx context.Context, record *model.LxtPaymentIdempotencyKey) (bool, error) {
+	result := r.GetDB(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(record)
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to create idempotency key: %w", result.Error)
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// GetByKey 根据接口名称和幂等键获取记录
+func (r *lxtPaymentIdempotencyKeysRepo) GetByKey(ctx context.Context, scope, key string) (*model.LxtPaymentIdempotencyKey, error) {
+	return r.GetByCondition(ctx, map[string]interface{}{
+		"scope":           scope,
+		"idempotency_key": key,
+	})
+}
+
+// TakeOverStale 接管长时间未完成的处理中记录（如服务在处理过程中重启），返回是否接管成功
+func (r *lxtPaymentIdempotencyKeysRepo) TakeOverStale(ctx context.Context, id int64, staleBefore time.Time) (bool, error) {
+	result := r.GetDB(ctx).Model(&model.LxtPaymentIdempotencyKey{}).
+		Where("id = ? AND status = ? AND updated_at < ?", id, constant.IdempotencyStatusProcessing, staleBefore).
+		Update("updated_at", time.Now())
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to take over idempotency key: %w", result.Error)
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// MarkSuccess 保存首次请求的响应
+func (r *lxtPaymentIdempotencyKeysRepo) MarkSuccess(ctx context.Context, id int64, response string) error {
+	return r.GetDB(ctx).Model(&model.LxtPaymentIdempotencyKey{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"status":     constant.IdempotencyStatusSuccess,
+			"response":   response,
+			"updated_at": time.Now(),
+		}).Error
+}
+
+// DeleteProcessing 删除处理失败的记录，允许客户端使用同一幂等键重试
+func (r *lxtPaymentIdempotencyKeysRepo) DeleteProcessing(ctx context.Context, id int64) error {
+	return r.GetDB(ctx).
+		Where("id = ? AND status = ?", id, constant.IdempotencyStatusProcessing).
+		Delete(&model.LxtPaymentIdempotencyKey{}).Error
+}
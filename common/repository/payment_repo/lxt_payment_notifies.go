@@ -7,6 +7,7 @@ import (
 	"lxtian-blog/common/repository"
 	"time"
 
+	"github.com/shopspring/decimal"
 	"gorm.io/gorm"
 )
 
@@ -24,15 +25,15 @@ type LxtPaymentNotifiesRepo interface {
 
 	// 更新方法
 	UpdateStatus(ctx context.Context, paymentId string, status string) error
-	UpdateTradeInfo(ctx context.Context, paymentId string, tradeNo, tradeStatus, buyerUserId, buyerLogonId string, receiptAmount float64, gmtPayment interface{}) error
+	UpdateTradeInfo(ctx context.Context, paymentId string, tradeNo, tradeStatus, buyerUserId, buyerLogonId string, receiptAmount decimal.Decimal, gmtPayment interface{}) error
 	UpdateNotifyInfo(ctx context.Context, paymentId string, notifyData string) error
 
 	// 统计方法
 	GetCountByUserId(ctx context.Context, userId uint64) (int64, error)
 	GetCountByStatus(ctx context.Context, status string) (int64, error)
-	GetTotalAmountByUserId(ctx context.Context, userId uint64) (float64, error)
-	GetTotalAmountByStatus(ctx context.Context, status string) (float64, error)
-	GetTotalAmountByTimeRange(ctx context.Context, startTime, endTime time.Time) (float64, error)
+	GetTotalAmountByUserId(ctx context.Context, userId uint64) (decimal.Decimal, error)
+	GetTotalAmountByStatus(ctx context.Context, status string) (decimal.Decimal, error)
+	GetTotalAmountByTimeRange(ctx context.Context, startTime, endTime time.Time) (decimal.Decimal, error)
 
 	// 批量操作
 	BatchUpdateStatus(ctx context.Context, paymentIds []string, status string) error
@@ -103,7 +104,7 @@ func (r *lxtPaymentNotifiesRepo) UpdateStatus(ctx context.Context, paymentId str
 }
 
 // UpdateTradeInfo 更新交易信息
-func (r *lxtPaymentNotifiesRepo) UpdateTradeInfo(ctx context.Context, paymentId string, tradeNo, tradeStatus, buyerUserId, buyerLogonId string, receiptAmount float64, gmtPayment interface{}) error {
+func (r *lxtPaymentNotifiesRepo) UpdateTradeInfo(ctx context.Context, paymentId string, tradeNo, tradeStatus, buyerUserId, buyerLogonId string, receiptAmount decimal.Decimal, gmtPayment interface{}) error {
 	updates := map[string]interface{}{
 		"trade_no":       tradeNo,
 		"trade_status":   tradeStatus,
@@ -146,9 +147,9 @@ func (r *lxtPaymentNotifiesRepo) GetCountByStatus(ctx context.Context, status st
 }
 
 // GetTotalAmountByUserId 根据用户ID统计总金额
-func (r *lxtPaymentNotifiesRepo) GetTotalAmountByUserId(ctx context.Context, userId uint64) (float64, error) {
+func (r *lxtPaymentNotifiesRepo) GetTotalAmountByUserId(ctx context.Context, userId uint64) (decimal.Decimal, error) {
 	db := r.GetDB(ctx)
-	var total float64
+	var total decimal.Decimal
 
 	err := db.Model(&model.LxtPaymentOrder{}).
 		Where("user_id = ? AND status = ?", userId, constant.PaymentStatusPaid).
@@ -159,9 +160,9 @@ func (r *lxtPaymentNotifiesRepo) GetTotalAmountByUserId(ctx context.Context, use
 }
 
 // GetTotalAmountByStatus 根据状态统计总金额
-func (r *lxtPaymentNotifiesRepo) GetTotalAmountByStatus(ctx context.Context, status string) (float64, error) {
+func (r *lxtPaymentNotifiesRepo) GetTotalAmountByStatus(ctx context.Context, status string) (decimal.Decimal, error) {
 	db := r.GetDB(ctx)
-	var total float64
+	var total decimal.Decimal
 
 	err := db.Model(&model.LxtPaymentOrder{}).
 		Where("status = ?", status).
@@ -172,9 +173,9 @@ func (r *lxtPaymentNotifiesRepo) GetTotalAmountByStatus(ctx context.Context, sta
 }
 
 // GetTotalAmountByTimeRange 根据时间范围统计总金额
-func (r *lxtPaymentNotifiesRepo) GetTotalAmountByTimeRange(ctx context.Context, startTime, endTime time.Time) (float64, error) {
+func (r *lxtPaymentNotifiesRepo) GetTotalAmountByTimeRange(ctx context.Context, startTime, endTime time.Time) (decimal.Decimal, error) {
 	db := r.GetDB(ctx)
-	var total float64
+	var total decimal.Decimal
 
 	err := db.Model(&model.LxtPaymentOrder{}).
 		Where("created_at BETWEEN ? AND ? AND status = ?", startTime, endTime, constant.PaymentStatusPaid).
@@ -0,0 +1,49 @@
+package payment_repo
+
+import (
+	"context"
+	"fmt"
+
+	"lxtian-blog/common/model"
+	"lxtian-blog/common/repository"
+
+	"gorm.io/gorm"
+)
+
+// LxtPaymentOrderStatusLogsRepo 支付订单状态变更记录表仓储接口
+type LxtPaymentOrderStatusLogsRepo interface {
+	repository.BaseRepository[model.LxtPaymentOrderStatusLog]
+
+	CreateLog(ctx context.Context, log *model.LxtPaymentOrderStatusLog) error
+	FindByPaymentId(ctx context.Context, paymentId string) ([]*model.LxtPaymentOrderStatusLog, error)
+}
+
+// lxtPaymentOrderStatusLogsRepo 支付订单状态变更记录表仓储实现
+type lxtPaymentOrderStatusLogsRepo struct {
+	*repository.TransactionalBaseRepository[model.LxtPaymentOrderStatusLog]
+}
+
+// NewLxtPaymentOrderStatusLogsRepo 创建支付订单状态变更记录表仓储
+func NewLxtPaymentOrderStatusLogsRepo(db *gorm.DB) LxtPaymentOrderStatusLogsRepo {
+	return &lxtPaymentOrderStatusLogsRepo{
+		TransactionalBaseRepository: repository.NewTransactionalBaseRepository[model.LxtPaymentOrderStatusLog](db),
+	}
+}
+
+// CreateLog 写入状态变更记录，在事务中调用时与状态变更一同提交
+func (r *lxtPaymentOrderStatusLogsRepo) CreateLog(ctx context.Context, log *model.LxtPaymentOrderStatusLog) error {
+	if err := r.GetDB(ctx).Create(log).Error; err != nil {
+		return fmt.Errorf("failed to create order status log: %w", err)
+	}
+	return nil
+}
+
+// FindByPaymentId 按时间顺序查询订单的状态变更记录
+func (r *lxtPaymentOrderStatusLogsRepo) FindByPaymentId(ctx context.Context, paymentId string) ([]*model.LxtPaymentOrderStatusLog, error) {
+	var logs []*model.LxtPaymentOrderStatusLog
+	err := r.GetDB(ctx).
+		Where("payment_id = ?", paymentId).
+		Order("id ASC").
+		Find(&logs).Error
+	return logs, err
+}
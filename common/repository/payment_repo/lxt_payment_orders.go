@@ -4,10 +4,14 @@ import (
 	"context"
 	"lxtian-blog/common/constant"
 	"lxtian-blog/common/model"
+	"lxtian-blog/common/pkg/utils"
 	"lxtian-blog/common/repository"
+	"sort"
 	"time"
 
+	"github.com/shopspring/decimal"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // PaymentOrderRepository PaymentOrder表仓储接口
@@ -15,6 +19,7 @@ type PaymentOrderRepository interface {
 	repository.BaseRepository[model.LxtPaymentOrder]
 
 	// 支付订单特有方法
+	CreateOrder(ctx context.Context, order *model.LxtPaymentOrder) error
 	GetByPaymentId(ctx context.Context, paymentId string) (*model.LxtPaymentOrder, error)
 	GetByOrderSn(ctx context.Context, orderSn string) (*model.LxtPaymentOrder, error)
 	GetByOutTradeNo(ctx context.Context, outTradeNo string) (*model.LxtPaymentOrder, error)
@@ -24,8 +29,9 @@ type PaymentOrderRepository interface {
 
 	// 更新方法
 	UpdateStatus(ctx context.Context, paymentId string, status string) error
-	UpdateTradeInfo(ctx context.Context, paymentId string, tradeNo, tradeStatus, buyerUserId, buyerLogonId string, receiptAmount float64, gmtPayment interface{}) error
+	UpdateTradeInfo(ctx context.Context, paymentId string, tradeNo, tradeStatus, buyerUserId, buyerLogonId string, receiptAmount decimal.Decimal, gmtPayment interface{}) error
 	UpdateNotifyInfo(ctx context.Context, paymentId string, notifyData string) error
+	ChangePaymentId(ctx context.Context, oldPaymentId, newPaymentId string) (bool, error)
 
 	// 删除方法
 	SoftDeleteByOrderSn(ctx context.Context, orderSn string) error
@@ -33,23 +39,56 @@ type PaymentOrderRepository interface {
 	// 统计方法
 	GetCountByUserId(ctx context.Context, userId uint64) (int64, error)
 	GetCountByStatus(ctx context.Context, status string) (int64, error)
-	GetTotalAmountByUserId(ctx context.Context, userId uint64) (float64, error)
-	GetTotalAmountByStatus(ctx context.Context, status string) (float64, error)
-	GetTotalAmountByTimeRange(ctx context.Context, startTime, endTime time.Time) (float64, error)
+	GetPaidCountByUserId(ctx context.Context, userId int64, buyTypes []int32) (int64, error)
+	GetTotalAmountByUserId(ctx context.Context, userId uint64) (decimal.Decimal, error)
+	GetTotalAmountByStatus(ctx context.Context, status string) (decimal.Decimal, error)
+	GetTotalAmountByTimeRange(ctx context.Context, startTime, endTime time.Time) (decimal.Decimal, error)
+	GetStatsByPeriod(ctx context.Context, startTime, endTime time.Time, periodFormat string) ([]*PaymentOrderStat, error)
+	GetStatsByBuyType(ctx context.Context, startTime, endTime time.Time) ([]*PaymentOrderStat, error)
 
 	// 批量操作
 	BatchUpdateStatus(ctx context.Context, paymentIds []string, status string) error
-	GetExpiredOrders(ctx context.Context) ([]*model.LxtPaymentOrder, error)
+	GetExpiredOrders(ctx context.Context, createdBefore time.Time, afterId int64, limit int) ([]*model.LxtPaymentOrder, int64, error)
 	GetOrdersByTimeRange(ctx context.Context, startTime, endTime time.Time, page, pageSize int) ([]*model.LxtPaymentOrder, int64, error)
 
 	// 支付通知相关方法
 	FindPaymentOrderByOutTradeNo(ctx context.Context, outTradeNo string) (*model.LxtPaymentOrder, error)
 	FindPaymentNotifyByNotifyId(ctx context.Context, notifyId string) (*model.LxtPaymentNotify, error)
+	FindLatestPaymentNotifyByPaymentId(ctx context.Context, paymentId string) (*model.LxtPaymentNotify, error)
 	UpdatePaymentNotify(ctx context.Context, notify *model.LxtPaymentNotify) error
 	UpdatePaymentNotifyVerifyStatus(ctx context.Context, notifyId string, verifyStatus string) error
 	UpdatePaymentNotifyProcessStatus(ctx context.Context, notifyId string, processStatus string, errorMsg string) error
 	UpdatePaymentOrderStatus(ctx context.Context, paymentId string, status string) error
-	UpdatePaymentOrderTradeInfo(ctx context.Context, paymentId string, tradeNo, tradeStatus, buyerUserId, buyerLogonId string, receiptAmount float64, gmtPayment *time.Time) error
+	UpdatePaymentOrderTradeInfo(ctx context.Context, paymentId string, tradeNo, tradeStatus, buyerUserId, buyerLogonId string, receiptAmount decimal.Decimal, gmtPayment *time.Time) error
+
+	// 状态机相关方法
+	LockByPaymentId(ctx context.Context, paymentId string) (*model.LxtPaymentOrder, error)
+	TransitionStatus(ctx context.Context, paymentId, fromStatus, toStatus string, updates map[string]interface{}) (bool, error)
+
+	// 对账相关方法
+	FindByOutTradeNos(ctx context.Context, outTradeNos []string) ([]*model.LxtPaymentOrder, error)
+	FindPaidByPayTime(ctx context.Context, payType int32, startTime, endTime time.Time) ([]*model.LxtPaymentOrder, error)
+
+	// 商品交付相关方法
+	LockPaidGoodsOrder(ctx context.Context, userId, goodsId int64) (*model.LxtPaymentOrder, error)
+}
+
+// PaymentOrderStat 支付订单统计结果
+type PaymentOrderStat struct {
+	Period       string          `json:"period"`        // 统计周期
+	BuyType      int32           `json:"buy_type"`      // 购买类型
+	OrderCount   int64           `json:"order_count"`   // 订单总数
+	PaidCount    int64           `json:"paid_count"`    // 已支付订单数
+	GrossAmount  decimal.Decimal `json:"gross_amount"`  // 已支付金额
+	RefundAmount decimal.Decimal `json:"refund_amount"` // 已支付订单中退款成功的金额
+	Revenue      decimal.Decimal `json:"revenue"`       // 实收金额（已支付金额扣除退款）
+}
+
+// 统计收入时视为已支付的订单状态（含后续发生退款的订单）
+var paidOrderStatuses = []string{
+	constant.PaymentStatusPaid,
+	constant.PaymentStatusPartialRefunded,
+	constant.PaymentStatusRefunded,
 }
 
 // paymentOrderRepository PaymentOrder表仓储实现
@@ -64,6 +103,11 @@ func NewPaymentOrderRepository(db *gorm.DB) PaymentOrderRepository {
 	}
 }
 
+// CreateOrder 创建支付订单，在事务中调用时与事务内其他写入一同提交
+func (r *paymentOrderRepository) CreateOrder(ctx context.Context, order *model.LxtPaymentOrder) error {
+	return r.GetDB(ctx).Create(order).Error
+}
+
 // GetByPaymentId 根据支付ID获取订单
 func (r *paymentOrderRepository) GetByPaymentId(ctx context.Context, paymentId string) (*model.LxtPaymentOrder, error) {
 	return r.GetByCondition(ctx, map[string]interface{}{
@@ -114,8 +158,19 @@ func (r *paymentOrderRepository) UpdateStatus(ctx context.Context, paymentId str
 	)
 }
 
+// ChangePaymentId 订单重新发起支付时更新支付ID，仅当支付ID仍为 oldPaymentId 时更新，返回是否更新成功
+func (r *paymentOrderRepository) ChangePaymentId(ctx context.Context, oldPaymentId, newPaymentId string) (bool, error) {
+	result := r.GetDB(ctx).Model(&model.LxtPaymentOrder{}).
+		Where("payment_id = ?", oldPaymentId).
+		Update("payment_id", newPaymentId)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
 // UpdateTradeInfo 更新交易信息
-func (r *paymentOrderRepository) UpdateTradeInfo(ctx context.Context, paymentId string, tradeNo, tradeStatus, buyerUserId, buyerLogonId string, receiptAmount float64, gmtPayment interface{}) error {
+func (r *paymentOrderRepository) UpdateTradeInfo(ctx context.Context, paymentId string, tradeNo, tradeStatus, buyerUserId, buyerLogonId string, receiptAmount decimal.Decimal, gmtPayment interface{}) error {
 	updates := map[string]interface{}{
 		"trade_no":       tradeNo,
 		"trade_status":   tradeStatus,
@@ -157,10 +212,19 @@ func (r *paymentOrderRepository) GetCountByStatus(ctx context.Context, status st
 	})
 }
 
+// GetPaidCountByUserId 统计用户指定购买类型的已支付订单数（含后续发生退款的订单），用于判断是否首单
+func (r *paymentOrderRepository) GetPaidCountByUserId(ctx context.Context, userId int64, buyTypes []int32) (int64, error) {
+	var count int64
+	err := r.GetDB(ctx).Model(&model.LxtPaymentOrder{}).
+		Where("user_id = ? AND buy_type IN ? AND status IN ?", userId, buyTypes, paidOrderStatuses).
+		Count(&count).Error
+	return count, err
+}
+
 // GetTotalAmountByUserId 根据用户ID统计总金额
-func (r *paymentOrderRepository) GetTotalAmountByUserId(ctx context.Context, userId uint64) (float64, error) {
+func (r *paymentOrderRepository) GetTotalAmountByUserId(ctx context.Context, userId uint64) (decimal.Decimal, error) {
 	db := r.GetDB(ctx)
-	var total float64
+	var total decimal.Decimal
 
 	err := db.Model(&model.LxtPaymentOrder{}).
 		Where("user_id = ? AND status = ?", userId, constant.PaymentStatusPaid).
@@ -171,9 +235,9 @@ func (r *paymentOrderRepository) GetTotalAmountByUserId(ctx context.Context, use
 }
 
 // GetTotalAmountByStatus 根据状态统计总金额
-func (r *paymentOrderRepository) GetTotalAmountByStatus(ctx context.Context, status string) (float64, error) {
+func (r *paymentOrderRepository) GetTotalAmountByStatus(ctx context.Context, status string) (decimal.Decimal, error) {
 	db := r.GetDB(ctx)
-	var total float64
+	var total decimal.Decimal
 
 	err := db.Model(&model.LxtPaymentOrder{}).
 		Where("status = ?", status).
@@ -184,9 +248,9 @@ func (r *paymentOrderRepository) GetTotalAmountByStatus(ctx context.Context, sta
 }
 
 // GetTotalAmountByTimeRange 根据时间范围统计总金额
-func (r *paymentOrderRepository) GetTotalAmountByTimeRange(ctx context.Context, startTime, endTime time.Time) (float64, error) {
+func (r *paymentOrderRepository) GetTotalAmountByTimeRange(ctx context.Context, startTime, endTime time.Time) (decimal.Decimal, error) {
 	db := r.GetDB(ctx)
-	var total float64
+	var total decimal.Decimal
 
 	err := db.Model(&model.LxtPaymentOrder{}).
 		Where("created_at BETWEEN ? AND ? AND status = ?", startTime, endTime, constant.PaymentStatusPaid).
@@ -196,6 +260,103 @@ func (r *paymentOrderRepository) GetTotalAmountByTimeRange(ctx context.Context,
 	return total, err
 }
 
+// GetStatsByPeriod 按时间周期统计订单数和收入，periodFormat 为 MySQL DATE_FORMAT 格式（如 %Y-%m-%d）
+// 订单数按创建时间统计，收入按支付时间统计并扣除退款成功的金额
+func (r *paymentOrderRepository) GetStatsByPeriod(ctx context.Context, startTime, endTime time.Time, periodFormat string) ([]*PaymentOrderStat, error) {
+	db := r.GetDB(ctx)
+
+	var counts []*PaymentOrderStat
+	err := db.Model(&model.LxtPaymentOrder{}).
+		Select("DATE_FORMAT(created_at, ?) AS period, COUNT(*) AS order_count", periodFormat).
+		Where("created_at BETWEEN ? AND ?", startTime, endTime).
+		Group("period").
+		Scan(&counts).Error
+	if err != nil {
+		return nil, err
+	}
+
+	var income []*PaymentOrderStat
+	err = r.paidOrdersWithRefunds(db, startTime, endTime).
+		Select("DATE_FORMAT(o.pay_time, ?) AS period, "+paidIncomeColumns, periodFormat).
+		Group("period").
+		Scan(&income).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return mergeOrderStats(counts, income,
+		func(stat *PaymentOrderStat) string { return stat.Period },
+		func(a, b *PaymentOrderStat) bool { return a.Period < b.Period }), nil
+}
+
+// GetStatsByBuyType 按购买类型统计订单数和收入，统计口径同 GetStatsByPeriod
+func (r *paymentOrderRepository) GetStatsByBuyType(ctx context.Context, startTime, endTime time.Time) ([]*PaymentOrderStat, error) {
+	db := r.GetDB(ctx)
+
+	var counts []*PaymentOrderStat
+	err := db.Model(&model.LxtPaymentOrder{}).
+		Select("buy_type, COUNT(*) AS order_count").
+		Where("created_at BETWEEN ? AND ?", startTime, endTime).
+		Group("buy_type").
+		Scan(&counts).Error
+	if err != nil {
+		return nil, err
+	}
+
+	var income []*PaymentOrderStat
+	err = r.paidOrdersWithRefunds(db, startTime, endTime).
+		Select("o.buy_type AS buy_type, " + paidIncomeColumns).
+		Group("o.buy_type").
+		Scan(&income).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return mergeOrderStats(counts, income,
+		func(stat *PaymentOrderStat) int32 { return stat.BuyType },
+		func(a, b *PaymentOrderStat) bool { return a.BuyType < b.BuyType }), nil
+}
+
+// 收入统计字段：已支付订单数、已支付金额、退款成功金额
+const paidIncomeColumns = "COUNT(*) AS paid_count, COALESCE(SUM(o.amount), 0) AS gross_amount, " +
+	"COALESCE(SUM(refunded.refund_amount), 0) AS refund_amount"
+
+// paidOrdersWithRefunds 查询支付时间在范围内的已支付订单（含后续发生退款的订单），并关联各订单退款成功的金额
+func (r *paymentOrderRepository) paidOrdersWithRefunds(db *gorm.DB, startTime, endTime time.Time) *gorm.DB {
+	refunded := db.Session(&gorm.Session{NewDB: true}).Model(&model.LxtPaymentRefund{}).
+		Select("payment_id, SUM(refund_amount) AS refund_amount").
+		Where("status = ?", constant.RefundStatusSuccess).
+		Group("payment_id")
+	return db.Table(model.TableNameLxtPaymentOrder+" AS o").
+		Joins("LEFT JOIN (?) AS refunded ON refunded.payment_id = o.payment_id", refunded).
+		Where("o.deleted_at IS NULL AND o.status IN ? AND o.pay_time BETWEEN ? AND ?", paidOrderStatuses, startTime, endTime)
+}
+
+// mergeOrderStats 合并订单数统计和收入统计，计算实收金额并按 less 排序
+func mergeOrderStats[K comparable](counts, income []*PaymentOrderStat, key func(*PaymentOrderStat) K, less func(a, b *PaymentOrderStat) bool) []*PaymentOrderStat {
+	merged := make(map[K]*PaymentOrderStat, len(counts)+len(income))
+	for _, stat := range counts {
+		merged[key(stat)] = stat
+	}
+	for _, stat := range income {
+		if existing, ok := merged[key(stat)]; ok {
+			existing.PaidCount = stat.PaidCount
+			existing.GrossAmount = stat.GrossAmount
+			existing.RefundAmount = stat.RefundAmount
+			continue
+		}
+		merged[key(stat)] = stat
+	}
+
+	stats := make([]*PaymentOrderStat, 0, len(merged))
+	for _, stat := range merged {
+		stat.Revenue = stat.GrossAmount.Sub(stat.RefundAmount)
+		stats = append(stats, stat)
+	}
+	sort.Slice(stats, func(i, j int) bool { return less(stats[i], stats[j]) })
+	return stats
+}
+
 // BatchUpdateStatus 批量更新状态
 func (r *paymentOrderRepository) BatchUpdateStatus(ctx context.Context, paymentIds []string, status string) error {
 	db := r.GetDB(ctx)
@@ -204,17 +365,81 @@ func (r *paymentOrderRepository) BatchUpdateStatus(ctx context.Context, paymentI
 		Update("status", status).Error
 }
 
-// GetExpiredOrders 获取过期订单
-func (r *paymentOrderRepository) GetExpiredOrders(ctx context.Context) ([]*model.LxtPaymentOrder, error) {
+// GetExpiredOrders 按ID分批获取过期订单，afterId 为上一批返回的最后一条订单ID
+// 先查询创建时间早于 createdBefore 的待支付订单，再按订单自身的 timeout（30m、1h、1d 等，为空或格式不正确时按 30m）过滤；
+// 返回本批查询到的最后一条订单ID，没有更多订单时返回0
+func (r *paymentOrderRepository) GetExpiredOrders(ctx context.Context, createdBefore time.Time, afterId int64, limit int) ([]*model.LxtPaymentOrder, int64, error) {
 	db := r.GetDB(ctx)
 	var orders []*model.LxtPaymentOrder
 
-	// 查询创建时间超过30分钟且状态为待支付的订单
-	cutoffTime := time.Now().Add(-30 * time.Minute)
-	err := db.Where("status = ? AND created_at < ?", constant.PaymentStatusPending, cutoffTime).
+	err := db.Where("status = ? AND created_at < ? AND id > ?", constant.PaymentStatusPending, createdBefore, afterId).
+		Order("id ASC").
+		Limit(limit).
 		Find(&orders).Error
+	if err != nil {
+		return nil, 0, err
+	}
 
-	return orders, err
+	var lastId int64
+	if len(orders) == limit {
+		lastId = orders[len(orders)-1].ID
+	}
+
+	now := time.Now()
+	expired := make([]*model.LxtPaymentOrder, 0, len(orders))
+	for _, order := range orders {
+		if order.CreatedAt.Add(utils.OrderTimeoutDuration(order.Timeout)).Before(now) {
+			expired = append(expired, order)
+		}
+	}
+	return expired, lastId, nil
+}
+
+// LockByPaymentId 加行锁查询支付订单，需在事务中调用
+func (r *paymentOrderRepository) LockByPaymentId(ctx context.Context, paymentId string) (*model.LxtPaymentOrder, error) {
+	var order model.LxtPaymentOrder
+	err := r.GetDB(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("payment_id = ?", paymentId).
+		First(&order).Error
+	if err != nil {
+		return nil, err
+	}
+	return &order, nil
+}
+
+// LockPaidGoodsOrder 加行锁查询用户最早的一笔已支付商品订单，需在事务中调用，用于串行化同一用户的商品下载计数
+// 会员订单的 goods_id 记录的是会员类型，只查询商城消费订单；已退款的订单不再视为已购买
+func (r *paymentOrderRepository) LockPaidGoodsOrder(ctx context.Context, userId, goodsId int64) (*model.LxtPaymentOrder, error) {
+	var order model.LxtPaymentOrder
+	err := r.GetDB(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("user_id = ? AND goods_id = ? AND buy_type = ? AND status = ?",
+			userId, goodsId, constant.BuyTypeGoods, constant.PaymentStatusPaid).
+		Order("id ASC").
+		First(&order).Error
+	if err != nil {
+		return nil, err
+	}
+	return &order, nil
+}
+
+// TransitionStatus 仅当订单仍为 fromStatus 时将其更新为 toStatus，updates 为随状态一同更新的字段，返回是否更新成功
+func (r *paymentOrderRepository) TransitionStatus(ctx context.Context, paymentId, fromStatus, toStatus string, updates map[string]interface{}) (bool, error) {
+	values := map[string]interface{}{
+		"status": toStatus,
+	}
+	for k, v := range updates {
+		values[k] = v
+	}
+
+	result := r.GetDB(ctx).Model(&model.LxtPaymentOrder{}).
+		Where("payment_id = ? AND status = ?", paymentId, fromStatus).
+		Updates(values)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
 }
 
 // GetOrdersByTimeRange 根据时间范围获取订单
@@ -261,6 +486,21 @@ func (r *paymentOrderRepository) FindPaymentNotifyByNotifyId(ctx context.Context
 	return &notify, nil
 }
 
+// FindLatestPaymentNotifyByPaymentId 查找订单最近一条支付通知记录
+func (r *paymentOrderRepository) FindLatestPaymentNotifyByPaymentId(ctx context.Context, paymentId string) (*model.LxtPaymentNotify, error) {
+	db := r.GetDB(ctx)
+	var notify model.LxtPaymentNotify
+
+	err := db.Where("payment_id = ? AND notify_type = ?", paymentId, constant.NotifyTypePayment).
+		Order("id DESC").
+		First(&notify).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &notify, nil
+}
+
 // UpdatePaymentNotify 更新支付通知记录
 func (r *paymentOrderRepository) UpdatePaymentNotify(ctx context.Context, notify *model.LxtPaymentNotify) error {
 	db := r.GetDB(ctx)
@@ -286,6 +526,9 @@ func (r *paymentOrderRepository) UpdatePaymentNotifyProcessStatus(ctx context.Co
 
 	if errorMsg != "" {
 		updates["error_message"] = errorMsg
+	} else if processStatus == constant.ProcessStatusSuccess {
+		// 处理成功（如重放通知后恢复）时清除之前的错误信息
+		updates["error_message"] = nil
 	}
 
 	if processStatus == constant.ProcessStatusSuccess || processStatus == constant.ProcessStatusFailed {
@@ -304,7 +547,7 @@ func (r *paymentOrderRepository) UpdatePaymentOrderStatus(ctx context.Context, p
 }
 
 // UpdatePaymentOrderTradeInfo 更新支付订单交易信息
-func (r *paymentOrderRepository) UpdatePaymentOrderTradeInfo(ctx context.Context, paymentId string, tradeNo, tradeStatus, buyerUserId, buyerLogonId string, receiptAmount float64, gmtPayment *time.Time) error {
+func (r *paymentOrderRepository) UpdatePaymentOrderTradeInfo(ctx context.Context, paymentId string, tradeNo, tradeStatus, buyerUserId, buyerLogonId string, receiptAmount decimal.Decimal, gmtPayment *time.Time) error {
 	return r.UpdateTradeInfo(ctx, paymentId, tradeNo, tradeStatus, buyerUserId, buyerLogonId, receiptAmount, gmtPayment)
 }
 
@@ -313,3 +556,24 @@ func (r *paymentOrderRepository) SoftDeleteByOrderSn(ctx context.Context, orderS
 	db := r.GetDB(ctx)
 	return db.Where("order_sn = ?", orderSn).Delete(&model.LxtPaymentOrder{}).Error
 }
+
+// FindByOutTradeNos 根据商户订单号批量查询订单
+func (r *paymentOrderRepository) FindByOutTradeNos(ctx context.Context, outTradeNos []string) ([]*model.LxtPaymentOrder, error) {
+	var orders []*model.LxtPaymentOrder
+	if len(outTradeNos) == 0 {
+		return orders, nil
+	}
+
+	db := r.GetDB(ctx)
+	err := db.Where("out_trade_no IN ?", outTradeNos).Find(&orders).Error
+	return orders, err
+}
+
+// FindPaidByPayTime 查询指定支付方式下支付时间在时间范围内的已支付订单（含后续发生退款的订单），各支付渠道的账单分别对账
+func (r *paymentOrderRepository) FindPaidByPayTime(ctx context.Context, payType int32, startTime, endTime time.Time) ([]*model.LxtPaymentOrder, error) {
+	var orders []*model.LxtPaymentOrder
+	db := r.GetDB(ctx)
+	err := db.Where("status IN ? AND pay_time >= ? AND pay_time < ? AND pay_type = ?", paidOrderStatuses, startTime, endTime, payType).
+		Find(&orders).Error
+	return orders, err
+}
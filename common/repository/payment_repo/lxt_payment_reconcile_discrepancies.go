@@ -0,0 +1,60 @@
+package payment_repo
+
+import (
+	"context"
+
+	"lxtian-blog/common/constant"
+	"lxtian-blog/common/model"
+	"lxtian-blog/common/repository"
+
+	"gorm.io/gorm"
+)
+
+// LxtPaymentReconcileDiscrepanciesRepo 支付对账差异表仓储接口
+type LxtPaymentReconcileDiscrepanciesRepo interface {
+	repository.BaseRepository[model.LxtPaymentReconcileDiscrepancy]
+
+	DeletePendingByBillDate(ctx context.Context, billDate string) error
+	FindClosedByBillDate(ctx context.Context, billDate string) ([]*model.LxtPaymentReconcileDiscrepancy, error)
+	Resolve(ctx context.Context, id int64, updates map[string]interface{}) (bool, error)
+}
+
+// lxtPaymentReconcileDiscrepanciesRepo 支付对账差异表仓储实现
+type lxtPaymentReconcileDiscrepanciesRepo struct {
+	*repository.TransactionalBaseRepository[model.LxtPaymentReconcileDiscrepancy]
+}
+
+// NewLxtPaymentReconcileDiscrepanciesRepo 创建支付对账差异表仓储
+func NewLxtPaymentReconcileDiscrepanciesRepo(db *gorm.DB) LxtPaymentReconcileDiscrepanciesRepo {
+	return &lxtPaymentReconcileDiscrepanciesRepo{
+		TransactionalBaseRepository: repository.NewTransactionalBaseRepository[model.LxtPaymentReconcileDiscrepancy](db),
+	}
+}
+
+// DeletePendingByBillDate 删除账单日期下待处理的差异（重新对账时由新批次结果替换）
+func (r *lxtPaymentReconcileDiscrepanciesRepo) DeletePendingByBillDate(ctx context.Context, billDate string) error {
+	db := r.GetDB(ctx)
+	return db.Where("bill_date = ? AND resolve_status = ?", billDate, constant.DiscrepancyResolvePending).
+		Delete(&model.LxtPaymentReconcileDiscrepancy{}).Error
+}
+
+// FindClosedByBillDate 查询账单日期下已处理或已忽略的差异
+func (r *lxtPaymentReconcileDiscrepanciesRepo) FindClosedByBillDate(ctx context.Context, billDate string) ([]*model.LxtPaymentReconcileDiscrepancy, error) {
+	var discrepancies []*model.LxtPaymentReconcileDiscrepancy
+	db := r.GetDB(ctx)
+	err := db.Where("bill_date = ? AND resolve_status <> ?", billDate, constant.DiscrepancyResolvePending).
+		Find(&discrepancies).Error
+	return discrepancies, err
+}
+
+// Resolve 处理待处理的差异，返回是否更新成功（差异已被处理时返回 false）
+func (r *lxtPaymentReconcileDiscrepanciesRepo) Resolve(ctx context.Context, id int64, updates map[string]interface{}) (bool, error) {
+	db := r.GetDB(ctx)
+	result := db.Model(&model.LxtPaymentReconcileDiscrepancy{}).
+		Where("id = ? AND resolve_status = ?", id, constant.DiscrepancyResolvePending).
+		Updates(updates)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
@@ -0,0 +1,54 @@
+package payment_repo
+
+import (
+	"context"
+
+	"lxtian-blog/common/constant"
+	"lxtian-blog/common/model"
+	"lxtian-blog/common/repository"
+
+	"gorm.io/gorm"
+)
+
+// LxtPaymentReconciliationsRepo 支付对账批次表仓储接口
+type LxtPaymentReconciliationsRepo interface {
+	repository.BaseRepository[model.LxtPaymentReconciliation]
+
+	GetByBatchId(ctx context.Context, batchId string) (*model.LxtPaymentReconciliation, error)
+	ExistsSuccessByBillDate(ctx context.Context, billDate string) (bool, error)
+	UpdateByBatchId(ctx context.Context, batchId string, updates map[string]interface{}) error
+}
+
+// lxtPaymentReconciliationsRepo 支付对账批次表仓储实现
+type lxtPaymentReconciliationsRepo struct {
+	*repository.TransactionalBaseRepository[model.LxtPaymentReconciliation]
+}
+
+// NewLxtPaymentReconciliationsRepo 创建支付对账批次表仓储
+func NewLxtPaymentReconciliationsRepo(db *gorm.DB) LxtPaymentReconciliationsRepo {
+	return &lxtPaymentReconciliationsRepo{
+		TransactionalBaseRepository: repository.NewTransactionalBaseRepository[model.LxtPaymentReconciliation](db),
+	}
+}
+
+// GetByBatchId 根据批次ID获取对账批次
+func (r *lxtPaymentReconciliationsRepo) GetByBatchId(ctx context.Context, batchId string) (*model.LxtPaymentReconciliation, error) {
+	return r.GetByCondition(ctx, map[string]interface{}{
+		"batch_id": batchId,
+	})
+}
+
+// ExistsSuccessByBillDate 账单日期是否已有对账完成的批次
+func (r *lxtPaymentReconciliationsRepo) ExistsSuccessByBillDate(ctx context.Context, billDate string) (bool, error) {
+	return r.Exists(ctx, map[string]interface{}{
+		"bill_date": billDate,
+		"status":    constant.ReconcileStatusSuccess,
+	})
+}
+
+// UpdateByBatchId 根据批次ID更新对账批次
+func (r *lxtPaymentReconciliationsRepo) UpdateByBatchId(ctx context.Context, batchId string, updates map[string]interface{}) error {
+	return r.UpdateByCondition(ctx, map[string]interface{}{
+		"batch_id": batchId,
+	}, updates)
+}
@@ -0,0 +1,65 @@
+package payment_repo
+
+import (
+	"context"
+
+	"lxtian-blog/common/constant"
+	"lxtian-blog/common/model"
+	"lxtian-blog/common/repository"
+
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+// LxtPaymentRefundRequestsRepo 退款申请表仓储接口
+type LxtPaymentRefundRequestsRepo interface {
+	repository.BaseRepository[model.LxtPaymentRefundRequest]
+
+	GetByRequestId(ctx context.Context, requestId string) (*model.LxtPaymentRefundRequest, error)
+	SumPendingAmountByPaymentId(ctx context.Context, paymentId string) (decimal.Decimal, error)
+	UpdateIfStatus(ctx context.Context, requestId string, fromStatus string, updates map[string]interface{}) (bool, error)
+}
+
+// lxtPaymentRefundRequestsRepo 退款申请表仓储实现
+type lxtPaymentRefundRequestsRepo struct {
+	*repository.TransactionalBaseRepository[model.LxtPaymentRefundRequest]
+}
+
+// NewLxtPaymentRefundRequestsRepo 创建退款申请表仓储
+func NewLxtPaymentRefundRequestsRepo(db *gorm.DB) LxtPaymentRefundRequestsRepo {
+	return &lxtPaymentRefundRequestsRepo{
+		TransactionalBaseRepository: repository.NewTransactionalBaseRepository[model.LxtPaymentRefundRequest](db),
+	}
+}
+
+// GetByRequestId 根据申请ID获取退款申请
+func (r *lxtPaymentRefundRequestsRepo) GetByRequestId(ctx context.Context, requestId string) (*model.LxtPaymentRefundRequest, error) {
+	return r.GetByCondition(ctx, map[string]interface{}{
+		"request_id": requestId,
+	})
+}
+
+// SumPendingAmountByPaymentId 统计订单待审批的退款申请总额
+func (r *lxtPaymentRefundRequestsRepo) SumPendingAmountByPaymentId(ctx context.Context, paymentId string) (decimal.Decimal, error) {
+	db := r.GetDB(ctx)
+	var total decimal.Decimal
+
+	err := db.Model(&model.LxtPaymentRefundRequest{}).
+		Where("payment_id = ? AND status = ?", paymentId, constant.RefundRequestStatusPending).
+		Select("COALESCE(SUM(refund_amount), 0)").
+		Scan(&total).Error
+
+	return total, err
+}
+
+// UpdateIfStatus 仅当申请处于 fromStatus 时更新，返回是否更新成功（用于防止重复审批）
+func (r *lxtPaymentRefundRequestsRepo) UpdateIfStatus(ctx context.Context, requestId string, fromStatus string, updates map[string]interface{}) (bool, error) {
+	db := r.GetDB(ctx)
+	result := db.Model(&model.LxtPaymentRefundRequest{}).
+		Where("request_id = ? AND status = ?", requestId, fromStatus).
+		Updates(updates)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
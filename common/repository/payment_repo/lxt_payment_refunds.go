@@ -3,11 +3,15 @@ package payment_repo
 import (
 	"context"
 	"database/sql"
+	"time"
 
+	"lxtian-blog/common/constant"
 	"lxtian-blog/common/model"
 	"lxtian-blog/common/repository"
 
+	"github.com/shopspring/decimal"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type LxtPaymentRefundsRepo interface {
@@ -22,6 +26,15 @@ type LxtPaymentRefundsRepo interface {
 	UpdatePaymentRefund(ctx context.Context, refund *model.LxtPaymentRefund) error
 	UpdatePaymentRefundStatus(ctx context.Context, refundId string, status string) error
 	UpdatePaymentOrderStatus(ctx context.Context, paymentId string, status string) error
+
+	// 部分退款相关方法
+	LockPaymentOrderByPaymentId(ctx context.Context, paymentId string) (*model.LxtPaymentOrder, error)
+	SumRefundAmountByPaymentId(ctx context.Context, paymentId string, statuses []string) (decimal.Decimal, error)
+	FindPendingRefunds(ctx context.Context, before time.Time, limit int) ([]*model.LxtPaymentRefund, error)
+
+	// 对账相关方法
+	FindPaymentRefundsByOutTradeNos(ctx context.Context, outTradeNos []string) ([]*model.LxtPaymentRefund, error)
+	FindSuccessRefundsByRefundTime(ctx context.Context, payType int32, startTime, endTime time.Time) ([]*model.LxtPaymentRefund, error)
 }
 
 type lxtPaymentRefundsRepo struct {
@@ -52,7 +65,7 @@ func (r *lxtPaymentRefundsRepo) FindPaymentOrderByOrderId(ctx context.Context, o
 	db := r.GetDB(ctx)
 	var order model.LxtPaymentOrder
 
-	err := db.Where("order_id = ?", orderId).First(&order).Error
+	err := db.Where("order_sn = ?", orderId).First(&order).Error
 	if err != nil {
 		return nil, err
 	}
@@ -116,3 +129,67 @@ func (r *lxtPaymentRefundsRepo) UpdatePaymentOrderStatus(ctx context.Context, pa
 		Where("payment_id = ?", paymentId).
 		Update("status", status).Error
 }
+
+// LockPaymentOrderByPaymentId 加行锁查询支付订单，需在事务中调用
+func (r *lxtPaymentRefundsRepo) LockPaymentOrderByPaymentId(ctx context.Context, paymentId string) (*model.LxtPaymentOrder, error) {
+	db := r.GetDB(ctx)
+	var order model.LxtPaymentOrder
+
+	err := db.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("payment_id = ?", paymentId).
+		First(&order).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &order, nil
+}
+
+// SumRefundAmountByPaymentId 统计订单指定状态的退款总额
+func (r *lxtPaymentRefundsRepo) SumRefundAmountByPaymentId(ctx context.Context, paymentId string, statuses []string) (decimal.Decimal, error) {
+	db := r.GetDB(ctx)
+	var total decimal.Decimal
+
+	err := db.Model(&model.LxtPaymentRefund{}).
+		Where("payment_id = ? AND status IN ?", paymentId, statuses).
+		Select("COALESCE(SUM(refund_amount), 0)").
+		Scan(&total).Error
+
+	return total, err
+}
+
+// FindPendingRefunds 查询创建时间早于 before 且仍待确认的退款记录
+func (r *lxtPaymentRefundsRepo) FindPendingRefunds(ctx context.Context, before time.Time, limit int) ([]*model.LxtPaymentRefund, error) {
+	db := r.GetDB(ctx)
+	var refunds []*model.LxtPaymentRefund
+
+	err := db.Where("status = ? AND created_at < ?", constant.RefundStatusPending, before).
+		Order("id ASC").
+		Limit(limit).
+		Find(&refunds).Error
+
+	return refunds, err
+}
+
+// FindPaymentRefundsByOutTradeNos 根据商户订单号批量查询退款记录
+func (r *lxtPaymentRefundsRepo) FindPaymentRefundsByOutTradeNos(ctx context.Context, outTradeNos []string) ([]*model.LxtPaymentRefund, error) {
+	var refunds []*model.LxtPaymentRefund
+	if len(outTradeNos) == 0 {
+		return refunds, nil
+	}
+
+	db := r.GetDB(ctx)
+	err := db.Where("out_trade_no IN ?", outTradeNos).Find(&refunds).Error
+	return refunds, err
+}
+
+// FindSuccessRefundsByRefundTime 查询原订单为指定支付方式、退款时间在时间范围内的成功退款
+func (r *lxtPaymentRefundsRepo) FindSuccessRefundsByRefundTime(ctx context.Context, payType int32, startTime, endTime time.Time) ([]*model.LxtPaymentRefund, error) {
+	var refunds []*model.LxtPaymentRefund
+	db := r.GetDB(ctx)
+	paymentIds := db.Session(&gorm.Session{NewDB: true}).Model(&model.LxtPaymentOrder{}).
+		Select("payment_id").Where("pay_type = ?", payType)
+	err := db.Where("status = ? AND gmt_refund >= ? AND gmt_refund < ? AND payment_id IN (?)", constant.RefundStatusSuccess, startTime, endTime, paymentIds).
+		Find(&refunds).Error
+	return refunds, err
+}
@@ -17,9 +17,9 @@ func NewTransactionManager(db *gorm.DB) *TransactionManager {
 	return &TransactionManager{db: db}
 }
 
-// ExecuteInTransaction 在事务中执行操作
+// ExecuteInTransaction 在事务中执行操作，上下文中已有事务时作为嵌套事务（保存点）执行
 func (tm *TransactionManager) ExecuteInTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
-	return tm.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+	return tm.GetDB(ctx).Transaction(func(tx *gorm.DB) error {
 		// 创建包含事务的新上下文
 		txCtx := context.WithValue(ctx, "tx", tx)
 		return fn(txCtx)
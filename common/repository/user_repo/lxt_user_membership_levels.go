@@ -0,0 +1,36 @@
+package user_repo
+
+import (
+	"context"
+
+	"lxtian-blog/common/model"
+	"lxtian-blog/common/repository"
+
+	"gorm.io/gorm"
+)
+
+// MembershipLevelRepository 会员等级表仓储接口
+type MembershipLevelRepository interface {
+	repository.BaseRepository[model.LxtUserMembershipLevel]
+
+	ListOrderByMinDays(ctx context.Context) ([]*model.LxtUserMembershipLevel, error)
+}
+
+// membershipLevelRepository 会员等级表仓储实现
+type membershipLevelRepository struct {
+	*repository.TransactionalBaseRepository[model.LxtUserMembershipLevel]
+}
+
+// NewMembershipLevelRepository 创建MembershipLevel仓储
+func NewMembershipLevelRepository(db *gorm.DB) MembershipLevelRepository {
+	return &membershipLevelRepository{
+		TransactionalBaseRepository: repository.NewTransactionalBaseRepository[model.LxtUserMembershipLevel](db),
+	}
+}
+
+// ListOrderByMinDays 按最少累计天数升序查询全部会员等级
+func (r *membershipLevelRepository) ListOrderByMinDays(ctx context.Context) ([]*model.LxtUserMembershipLevel, error) {
+	var levels []*model.LxtUserMembershipLevel
+	err := r.GetDB(ctx).Order("min_days ASC").Find(&levels).Error
+	return levels, err
+}
@@ -16,6 +16,7 @@ type MembershipPermissionRepository interface {
 	FindByMembershipTypeId(ctx context.Context, membershipTypeId uint64) ([]*model.LxtUserMembershipPermission, error)
 	GetPermissionKeysByTypeId(ctx context.Context, membershipTypeId uint64) ([]string, error)
 	BatchCreateByTypeId(ctx context.Context, membershipTypeId int64, permissions []*model.LxtUserMembershipPermission) error
+	PermissionKeyExists(ctx context.Context, permissionKey string) (bool, error)
 }
 
 // membershipPermissionRepository 会员权限仓储实现
@@ -63,3 +64,12 @@ func (r *membershipPermissionRepository) BatchCreateByTypeId(ctx context.Context
 	db := r.GetDB(ctx)
 	return db.CreateInBatches(permissions, 100).Error
 }
+
+// PermissionKeyExists 判断权限标识是否已被任一会员类型配置
+func (r *membershipPermissionRepository) PermissionKeyExists(ctx context.Context, permissionKey string) (bool, error) {
+	var count int64
+	err := r.GetDB(ctx).Model(&model.LxtUserMembershipPermission{}).
+		Where("permission_key = ?", permissionKey).
+		Count(&count).Error
+	return count > 0, err
+}
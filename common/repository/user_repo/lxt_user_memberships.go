@@ -20,6 +20,11 @@ type UserMembershipRepository interface {
 	GetActiveMembershipByUserId(ctx context.Context, userID int64) (map[string]interface{}, error)
 	GetByUserId(ctx context.Context, userID int64) (*model.LxtUserMembership, error)
 	UpdateIsActive(ctx context.Context, userID int64, isActive int32) error
+
+	// 会员到期任务
+	FindExpiredActive(ctx context.Context, now time.Time, limit int) ([]*model.LxtUserMembership, error)
+	FindActiveExpiringBetween(ctx context.Context, from, to time.Time, afterId int64, limit int) ([]*model.LxtUserMembership, error)
+	Deactivate(ctx context.Context, id int64, level int32, now time.Time) (bool, error)
 }
 
 // userMembershipRepository 用户会员表仓储实现
@@ -51,6 +56,43 @@ func (r *userMembershipRepository) UpdateIsActive(ctx context.Context, userID in
 	)
 }
 
+// FindExpiredActive 查询已到期但仍为激活状态的会员
+func (r *userMembershipRepository) FindExpiredActive(ctx context.Context, now time.Time, limit int) ([]*model.LxtUserMembership, error) {
+	var memberships []*model.LxtUserMembership
+	err := r.GetDB(ctx).
+		Where("is_active = ? AND end_time <= ?", 1, now).
+		Order("id ASC").
+		Limit(limit).
+		Find(&memberships).Error
+	return memberships, err
+}
+
+// FindActiveExpiringBetween 查询到期时间在 (from, to] 内的激活会员，按ID分批查询
+func (r *userMembershipRepository) FindActiveExpiringBetween(ctx context.Context, from, to time.Time, afterId int64, limit int) ([]*model.LxtUserMembership, error) {
+	var memberships []*model.LxtUserMembership
+	err := r.GetDB(ctx).
+		Where("is_active = ? AND end_time > ? AND end_time <= ? AND id > ?", 1, from, to, afterId).
+		Order("id ASC").
+		Limit(limit).
+		Find(&memberships).Error
+	return memberships, err
+}
+
+// Deactivate 仅当会员仍为激活状态且已到期时停用并更新等级，返回是否更新成功
+// 按到期时间条件更新，避免覆盖查询之后刚刚续费的会员
+func (r *userMembershipRepository) Deactivate(ctx context.Context, id int64, level int32, now time.Time) (bool, error) {
+	result := r.GetDB(ctx).Model(&model.LxtUserMembership{}).
+		Where("id = ? AND is_active = ? AND end_time <= ?", id, 1, now).
+		Updates(map[string]interface{}{
+			"is_active": 0,
+			"level":     level,
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
 // GetActiveMembershipByUserId 获取用户会员信息并检查是否过期
 // 返回会员信息的 map，包括：end_time, level, is_valid, is_active, start_time, total_days, type_id
 func (r *userMembershipRepository) GetActiveMembershipByUserId(ctx context.Context, userID int64) (map[string]interface{}, error) {
@@ -17,6 +17,7 @@ type TxyPermissionsRepository interface {
 	GetByModule(ctx context.Context, module string) ([]*mysql.TxyPermissions, error)
 	GetByStatus(ctx context.Context, status int64) ([]*mysql.TxyPermissions, error)
 	GetActivePermissions(ctx context.Context) ([]*mysql.TxyPermissions, error)
+	GetByUserId(ctx context.Context, userId uint64) ([]*mysql.TxyPermissions, error)
 
 	// 更新方法
 	UpdateStatus(ctx context.Context, permId uint64, status int64) error
@@ -70,6 +71,20 @@ func (r *txyPermissionsRepository) GetActivePermissions(ctx context.Context) ([]
 	return r.GetByStatus(ctx, 1) // 1表示启用状态
 }
 
+// GetByUserId 获取用户所属启用角色拥有的全部权限
+func (r *txyPermissionsRepository) GetByUserId(ctx context.Context, userId uint64) ([]*mysql.TxyPermissions, error) {
+	var permissions []*mysql.TxyPermissions
+	err := r.GetDB(ctx).
+		Table("txy_permissions AS p").
+		Select("DISTINCT p.*").
+		Joins("JOIN txy_role_permissions AS rp ON rp.perm_id = p.id").
+		Joins("JOIN txy_user_roles AS ur ON ur.role_id = rp.role_id").
+		Joins("JOIN txy_roles AS r ON r.id = ur.role_id").
+		Where("ur.user_id = ? AND r.status = ? AND r.deleted_at IS NULL AND p.deleted_at IS NULL", userId, 1).
+		Find(&permissions).Error
+	return permissions, err
+}
+
 // UpdateStatus 更新权限状态
 func (r *txyPermissionsRepository) UpdateStatus(ctx context.Context, permId uint64, status int64) error {
 	return r.UpdateByCondition(ctx,
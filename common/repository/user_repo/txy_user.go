@@ -24,6 +24,9 @@ type TxyUserRepository interface {
 	UpdateLastLogin(ctx context.Context, uid uint64, loginTime uint64, loginIp string) error
 	UpdateLoginTimes(ctx context.Context, uid uint64) error
 	UpdateAccessToken(ctx context.Context, uid uint64, accessToken string) error
+	UpdatePassword(ctx context.Context, id uint64, passwordHash string) error
+	UpgradePassword(ctx context.Context, id uint64, oldPassword, passwordHash string) (bool, error)
+	FlagLegacyPasswordReset(ctx context.Context, limit int) (int64, error)
 
 	// 统计方法
 	GetCountByType(ctx context.Context, userType uint64) (int64, error)
@@ -128,6 +131,39 @@ func (r *txyUserRepository) UpdateAccessToken(ctx context.Context, uid uint64, a
 	)
 }
 
+// UpdatePassword 更新密码哈希，同时清除强制重置标记
+func (r *txyUserRepository) UpdatePassword(ctx context.Context, id uint64, passwordHash string) error {
+	db := r.GetDB(ctx)
+	return db.Model(&mysql.TxyUser{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"password":                passwordHash,
+			"password_reset_required": 0,
+		}).Error
+}
+
+// UpgradePassword 登录成功后将旧格式密码替换为新哈希，仅在密码未被并发修改时更新，返回是否更新成功
+func (r *txyUserRepository) UpgradePassword(ctx context.Context, id uint64, oldPassword, passwordHash string) (bool, error) {
+	db := r.GetDB(ctx)
+	result := db.Model(&mysql.TxyUser{}).
+		Where("id = ? AND password = ?", id, oldPassword).
+		Update("password", passwordHash)
+	return result.RowsAffected > 0, result.Error
+}
+
+// FlagLegacyPasswordReset 将仍为旧版 AES 密文的账号标记为强制重置并清空密文，返回本批处理数量
+func (r *txyUserRepository) FlagLegacyPasswordReset(ctx context.Context, limit int) (int64, error) {
+	db := r.GetDB(ctx)
+	result := db.Model(&mysql.TxyUser{}).
+		Where("password <> '' AND password NOT LIKE ? AND password_reset_required = 0", "$argon2id$%").
+		Limit(limit).
+		Updates(map[string]interface{}{
+			"password":                "",
+			"password_reset_required": 1,
+		})
+	return result.RowsAffected, result.Error
+}
+
 // GetCountByType 根据用户类型统计数量
 func (r *txyUserRepository) GetCountByType(ctx context.Context, userType uint64) (int64, error) {
 	return r.Count(ctx, map[string]interface{}{
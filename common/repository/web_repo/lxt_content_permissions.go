@@ -0,0 +1,90 @@
+package web_repo
+
+import (
+	"context"
+	"errors"
+
+	"lxtian-blog/common/model"
+	"lxtian-blog/common/repository"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ContentPermissionRepository 内容访问权限仓储接口
+type ContentPermissionRepository interface {
+	repository.BaseRepository[model.LxtContentPermission]
+
+	GetPermissionKey(ctx context.Context, contentType string, contentId int64) (string, error)
+	GetPermissionKeys(ctx context.Context, contentType string, contentIds []int64) (map[int64]string, error)
+	SetPermissionKey(ctx context.Context, contentType string, contentId int64, permissionKey string) error
+	DeleteByContent(ctx context.Context, contentType string, contentId int64) error
+}
+
+// contentPermissionRepository 内容访问权限仓储实现
+type contentPermissionRepository struct {
+	*repository.TransactionalBaseRepository[model.LxtContentPermission]
+}
+
+// NewContentPermissionRepository 创建内容访问权限仓储
+func NewContentPermissionRepository(db *gorm.DB) ContentPermissionRepository {
+	return &contentPermissionRepository{
+		TransactionalBaseRepository: repository.NewTransactionalBaseRepository[model.LxtContentPermission](db),
+	}
+}
+
+// GetPermissionKey 查询内容阅读全文所需的权限标识，未设置时返回空字符串
+func (r *contentPermissionRepository) GetPermissionKey(ctx context.Context, contentType string, contentId int64) (string, error) {
+	var entity model.LxtContentPermission
+	err := r.GetDB(ctx).
+		Where("content_type = ? AND content_id = ?", contentType, contentId).
+		First(&entity).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return "", nil
+		}
+		return "", err
+	}
+	return entity.PermissionKey, nil
+}
+
+// GetPermissionKeys 批量查询内容所需的权限标识，返回 内容ID => 权限标识，未设置的内容不在结果中
+func (r *contentPermissionRepository) GetPermissionKeys(ctx context.Context, contentType string, contentIds []int64) (map[int64]string, error) {
+	keys := make(map[int64]string)
+	if len(contentIds) == 0 {
+		return keys, nil
+	}
+	var entities []*model.LxtContentPermission
+	err := r.GetDB(ctx).
+		Where("content_type = ? AND content_id IN ?", contentType, contentIds).
+		Find(&entities).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, entity := range entities {
+		keys[entity.ContentID] = entity.PermissionKey
+	}
+	return keys, nil
+}
+
+// SetPermissionKey 设置内容所需的权限标识，权限标识为空时取消限制
+func (r *contentPermissionRepository) SetPermissionKey(ctx context.Context, contentType string, contentId int64, permissionKey string) error {
+	if permissionKey == "" {
+		return r.DeleteByContent(ctx, contentType, contentId)
+	}
+	return r.GetDB(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "content_type"}, {Name: "content_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"permission_key", "updated_at"}),
+	}).Create(&model.LxtContentPermission{
+		ContentType:   contentType,
+		ContentID:     contentId,
+		PermissionKey: permissionKey,
+	}).Error
+}
+
+// DeleteByContent 删除内容的权限设置
+func (r *contentPermissionRepository) DeleteByContent(ctx context.Context, contentType string, contentId int64) error {
+	return r.GetDB(ctx).
+		Where("content_type = ? AND content_id = ?", contentType, contentId).
+		Delete(&model.LxtContentPermission{}).Error
+}
@@ -6,6 +6,7 @@ import (
 	"lxtian-blog/common/repository"
 	"time"
 
+	"github.com/shopspring/decimal"
 	"gorm.io/gorm"
 )
 
@@ -23,16 +24,16 @@ type TxyOrderRepository interface {
 
 	// 更新方法
 	UpdateStatus(ctx context.Context, paymentId string, status string) error
-	UpdatePayInfo(ctx context.Context, orderId uint64, payMoney float64, payTime int64) error
+	UpdatePayInfo(ctx context.Context, orderId uint64, payMoney decimal.Decimal, payTime int64) error
 	UpdateRemark(ctx context.Context, orderId uint64, remark string) error
 
 	// 统计方法
 	GetCountByUserId(ctx context.Context, userId uint64) (int64, error)
 	GetCountByPayType(ctx context.Context, payType int64) (int64, error)
 	GetCountByStatus(ctx context.Context, status int64) (int64, error)
-	GetTotalAmountByUserId(ctx context.Context, userId uint64) (float64, error)
-	GetTotalAmountByPayType(ctx context.Context, payType int64) (float64, error)
-	GetTotalAmountByTimeRange(ctx context.Context, startTime, endTime int64) (float64, error)
+	GetTotalAmountByUserId(ctx context.Context, userId uint64) (decimal.Decimal, error)
+	GetTotalAmountByPayType(ctx context.Context, payType int64) (decimal.Decimal, error)
+	GetTotalAmountByTimeRange(ctx context.Context, startTime, endTime int64) (decimal.Decimal, error)
 
 	// 批量操作
 	BatchUpdateStatus(ctx context.Context, orderIds []uint64, status int64) error
@@ -134,7 +135,7 @@ func (r *txyOrderRepository) UpdateStatus(ctx context.Context, paymentId string,
 }
 
 // UpdatePayInfo 更新支付信息
-func (r *txyOrderRepository) UpdatePayInfo(ctx context.Context, orderId uint64, payMoney float64, payTime int64) error {
+func (r *txyOrderRepository) UpdatePayInfo(ctx context.Context, orderId uint64, payMoney decimal.Decimal, payTime int64) error {
 	return r.UpdateByCondition(ctx,
 		map[string]interface{}{"id": orderId},
 		map[string]interface{}{
@@ -175,9 +176,9 @@ func (r *txyOrderRepository) GetCountByStatus(ctx context.Context, status int64)
 }
 
 // GetTotalAmountByUserId 根据用户ID统计总金额
-func (r *txyOrderRepository) GetTotalAmountByUserId(ctx context.Context, userId uint64) (float64, error) {
+func (r *txyOrderRepository) GetTotalAmountByUserId(ctx context.Context, userId uint64) (decimal.Decimal, error) {
 	db := r.GetDB(ctx)
-	var total float64
+	var total decimal.Decimal
 
 	err := db.Model(&mysql.TxyOrder{}).
 		Where("user_id = ? AND status = ?", userId, 1). // 1表示已支付
@@ -188,9 +189,9 @@ func (r *txyOrderRepository) GetTotalAmountByUserId(ctx context.Context, userId
 }
 
 // GetTotalAmountByPayType 根据支付类型统计总金额
-func (r *txyOrderRepository) GetTotalAmountByPayType(ctx context.Context, payType int64) (float64, error) {
+func (r *txyOrderRepository) GetTotalAmountByPayType(ctx context.Context, payType int64) (decimal.Decimal, error) {
 	db := r.GetDB(ctx)
-	var total float64
+	var total decimal.Decimal
 
 	err := db.Model(&mysql.TxyOrder{}).
 		Where("pay_type = ? AND status = ?", payType, 1). // 1表示已支付
@@ -201,9 +202,9 @@ func (r *txyOrderRepository) GetTotalAmountByPayType(ctx context.Context, payTyp
 }
 
 // GetTotalAmountByTimeRange 根据时间范围统计总金额
-func (r *txyOrderRepository) GetTotalAmountByTimeRange(ctx context.Context, startTime, endTime int64) (float64, error) {
+func (r *txyOrderRepository) GetTotalAmountByTimeRange(ctx context.Context, startTime, endTime int64) (decimal.Decimal, error) {
 	db := r.GetDB(ctx)
-	var total float64
+	var total decimal.Decimal
 
 	err := db.Model(&mysql.TxyOrder{}).
 		Where("ctime BETWEEN ? AND ? AND status = ?", startTime, endTime, 1). // 1表示已支付
@@ -1,6 +1,8 @@
 package config
 
 import (
+	"lxtian-blog/common/pkg/jwts"
+
 	"github.com/zeromicro/go-zero/rest"
 	"github.com/zeromicro/go-zero/zrpc"
 )
@@ -8,8 +10,10 @@ import (
 type Config struct {
 	rest.RestConf
 	Auth struct { // JWT 认证需要的密钥和过期时间配置
-		AccessSecret string `json:",env=ACCESS_SECRET"`
-		AccessExpire int64
+		AccessSecret  string          `json:",optional,env=ACCESS_SECRET"` // HS256 密钥，未配置签名密钥时用于签发，并用于验证不带 kid 的旧 token
+		AccessExpire  int64           // 访问令牌有效期（秒）
+		RefreshExpire int64           `json:",default=1209600"` // 刷新令牌有效期（秒）
+		KeySet        jwts.KeySetConf `json:",optional"`        // 非对称签名密钥集，支持按 kid 轮换
 	}
 	RedisConfig struct {
 		Host string `json:",env=REDIS_HOST"`
@@ -25,6 +29,11 @@ type Config struct {
 		Host string `json:",env=WS_HOST"`
 		Port int
 	}
+	// 本地商品文件下发，下载链接由支付服务签发，密钥需与支付服务一致
+	GoodsDelivery struct {
+		LocalRoot   string `json:",optional"`                           // 本地商品文件根目录
+		LocalSecret string `json:",optional,env=GOODS_DOWNLOAD_SECRET"` // 下载链接签名密钥
+	} `json:",optional"`
 	OAuth struct {
 		QQConf struct {
 			ClientID     string `json:",env=QQ_CLIENT_ID"`
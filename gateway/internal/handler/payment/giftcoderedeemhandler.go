@@ -0,0 +1,28 @@
+package payment
+
+import (
+	"github.com/zeromicro/go-zero/core/logc"
+	"lxtian-blog/common/restful/response"
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+	"lxtian-blog/gateway/internal/logic/payment"
+	"lxtian-blog/gateway/internal/svc"
+	"lxtian-blog/gateway/internal/types"
+)
+
+// 兑换礼品码
+func GiftCodeRedeemHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.GiftCodeRedeemReq
+		if err := httpx.Parse(r, &req); err != nil {
+			logc.Errorf(r.Context(), "GiftCodeRedeemHandler error message: %s", err)
+			response.Response(r, w, nil, err)
+			return
+		}
+
+		l := payment.NewGiftCodeRedeemLogic(r.Context(), svcCtx)
+		resp, err := l.GiftCodeRedeem(&req, r)
+		response.Response(r, w, resp, err)
+	}
+}
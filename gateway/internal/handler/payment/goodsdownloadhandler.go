@@ -0,0 +1,28 @@
+package payment
+
+import (
+	"github.com/zeromicro/go-zero/core/logc"
+	"lxtian-blog/common/restful/response"
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+	"lxtian-blog/gateway/internal/logic/payment"
+	"lxtian-blog/gateway/internal/svc"
+	"lxtian-blog/gateway/internal/types"
+)
+
+// 获取已购商品下载地址
+func GoodsDownloadHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.GoodsDownloadReq
+		if err := httpx.Parse(r, &req); err != nil {
+			logc.Errorf(r.Context(), "GoodsDownloadHandler error message: %s", err)
+			response.Response(r, w, nil, err)
+			return
+		}
+
+		l := payment.NewGoodsDownloadLogic(r.Context(), svcCtx)
+		resp, err := l.GoodsDownload(&req, r)
+		response.Response(r, w, resp, err)
+	}
+}
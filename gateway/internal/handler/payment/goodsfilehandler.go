@@ -0,0 +1,35 @@
+package payment
+
+import (
+	"fmt"
+	"github.com/zeromicro/go-zero/core/logc"
+	"lxtian-blog/common/restful/response"
+	"net/http"
+	"path/filepath"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+	"lxtian-blog/gateway/internal/logic/payment"
+	"lxtian-blog/gateway/internal/svc"
+	"lxtian-blog/gateway/internal/types"
+)
+
+// 下发本地商品文件（校验下载签名），校验通过时直接输出文件
+func GoodsFileHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.GoodsFileReq
+		if err := httpx.Parse(r, &req); err != nil {
+			logc.Errorf(r.Context(), "GoodsFileHandler error message: %s", err)
+			response.Response(r, w, nil, err)
+			return
+		}
+
+		l := payment.NewGoodsFileLogic(r.Context(), svcCtx)
+		filePath, err := l.GoodsFile(&req)
+		if err != nil {
+			response.Response(r, w, nil, err)
+			return
+		}
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filepath.Base(filePath)))
+		http.ServeFile(w, r, filePath)
+	}
+}
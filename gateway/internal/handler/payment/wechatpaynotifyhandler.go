@@ -0,0 +1,69 @@
+package payment
+
+import (
+	"encoding/json"
+	"io"
+	"lxtian-blog/common/pkg/wechatpay"
+	"lxtian-blog/gateway/internal/logic/payment"
+	"lxtian-blog/gateway/internal/svc"
+	"lxtian-blog/gateway/internal/types"
+	"net/http"
+
+	"github.com/zeromicro/go-zero/core/logc"
+)
+
+// 微信支付结果异步通知
+func WechatPayNotifyHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// 验签需要原始body和签名请求头，不能按 json 解析
+		bodyBytes, err := io.ReadAll(r.Body)
+		if err != nil {
+			logc.Errorf(r.Context(), "WechatPayNotifyHandler read body error: %v", err)
+			writeWechatPayNotifyResp(w, http.StatusBadRequest, &types.WechatPayNotifyResp{
+				Code:    "FAIL",
+				Message: "读取通知数据失败",
+			})
+			return
+		}
+
+		rawBody := string(bodyBytes)
+		logc.Infof(r.Context(), "Wechat pay notify raw body: %s", rawBody)
+
+		headers := make(map[string]string)
+		for _, key := range []string{wechatpay.HeaderTimestamp, wechatpay.HeaderNonce, wechatpay.HeaderSignature, wechatpay.HeaderSerial} {
+			if value := r.Header.Get(key); value != "" {
+				headers[key] = value
+			}
+		}
+
+		req := types.WechatPayNotifyReq{
+			NotifyData: rawBody,
+			Headers:    headers,
+		}
+
+		l := payment.NewWechatPayNotifyLogic(r.Context(), svcCtx)
+		resp, err := l.WechatPayNotify(&req)
+		if err != nil {
+			logc.Errorf(r.Context(), "WechatPayNotifyHandler error: %v", err)
+			// 微信支付收到非 2xx 应答会按策略重新通知
+			writeWechatPayNotifyResp(w, http.StatusInternalServerError, &types.WechatPayNotifyResp{
+				Code:    "FAIL",
+				Message: "处理失败",
+			})
+			return
+		}
+
+		status := http.StatusOK
+		if resp.Code != "SUCCESS" {
+			status = http.StatusInternalServerError
+		}
+		writeWechatPayNotifyResp(w, status, resp)
+	}
+}
+
+// 微信支付回调需要返回 {"code":"...","message":"..."}，而不是统一响应格式
+func writeWechatPayNotifyResp(w http.ResponseWriter, status int, resp *types.WechatPayNotifyResp) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(resp)
+}
@@ -19,18 +19,48 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 		rest.WithMiddlewares(
 			[]rest.Middleware{serverCtx.JwtMiddleware, serverCtx.AntiSpamMiddleware, serverCtx.RateLimitMiddleware},
 			[]rest.Route{
+				{
+					// 校验优惠码
+					Method:  http.MethodPost,
+					Path:    "/coupon/validate",
+					Handler: payment.ValidateCouponHandler(serverCtx),
+				},
 				{
 					// 创建支付订单
 					Method:  http.MethodPost,
 					Path:    "/create",
 					Handler: payment.CreatePaymentHandler(serverCtx),
 				},
+				{
+					// 兑换礼品码
+					Method:  http.MethodPost,
+					Path:    "/gift-code/redeem",
+					Handler: payment.GiftCodeRedeemHandler(serverCtx),
+				},
+				{
+					// 获取已购商品下载地址
+					Method:  http.MethodPost,
+					Path:    "/goods/download",
+					Handler: payment.GoodsDownloadHandler(serverCtx),
+				},
+				{
+					// 发表商品评价
+					Method:  http.MethodPost,
+					Path:    "/goods/review",
+					Handler: payment.GoodsReviewHandler(serverCtx),
+				},
 				{
 					// 支付记录查询
 					Method:  http.MethodGet,
 					Path:    "/history",
 					Handler: payment.PaymentHistoryHandler(serverCtx),
 				},
+				{
+					// 会员开通/续费/升级报价
+					Method:  http.MethodPost,
+					Path:    "/membership/quote",
+					Handler: payment.MembershipQuoteHandler(serverCtx),
+				},
 				{
 					// 取消订单
 					Method:  http.MethodGet,
@@ -98,6 +128,12 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 				Path:    "/goods/:id",
 				Handler: payment.GoodsHandler(serverCtx),
 			},
+			{
+				// 下发本地商品文件（校验下载签名）
+				Method:  http.MethodGet,
+				Path:    "/goods/file",
+				Handler: payment.GoodsFileHandler(serverCtx),
+			},
 			{
 				// 商品列表
 				Method:  http.MethodPost,
@@ -110,6 +146,12 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 				Path:    "/notify",
 				Handler: payment.PaymentNotifyHandler(serverCtx),
 			},
+			{
+				// 微信支付结果异步通知
+				Method:  http.MethodPost,
+				Path:    "/notify/wechat",
+				Handler: payment.WechatPayNotifyHandler(serverCtx),
+			},
 		},
 		rest.WithPrefix("/api/payment"),
 	)
@@ -154,6 +196,12 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 					Path:    "/register",
 					Handler: user.RegisterHandler(serverCtx),
 				},
+				{
+					// 刷新令牌
+					Method:  http.MethodPost,
+					Path:    "/token/refresh",
+					Handler: user.TokenRefreshHandler(serverCtx),
+				},
 			}...,
 		),
 		rest.WithPrefix("/user"),
@@ -169,6 +217,12 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 					Path:    "/info",
 					Handler: user.InfoHandler(serverCtx),
 				},
+				{
+					// 退出登录
+					Method:  http.MethodPost,
+					Path:    "/logout",
+					Handler: user.LogoutHandler(serverCtx),
+				},
 				{
 					// 获取会员列表
 					Method:  http.MethodGet,
@@ -192,6 +246,18 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 		rest.WithPrefix("/user"),
 	)
 
+	server.AddRoutes(
+		[]rest.Route{
+			{
+				// JWKS公钥集
+				Method:  http.MethodGet,
+				Path:    "/jwks",
+				Handler: user.JwksHandler(serverCtx),
+			},
+		},
+		rest.WithPrefix("/auth"),
+	)
+
 	server.AddRoutes(
 		rest.WithMiddlewares(
 			[]rest.Middleware{serverCtx.AntiSpamMiddleware, serverCtx.RateLimitMiddleware, serverCtx.JwtMiddleware},
@@ -209,7 +275,7 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 
 	server.AddRoutes(
 		rest.WithMiddlewares(
-			[]rest.Middleware{serverCtx.AntiSpamMiddleware, serverCtx.RateLimitMiddleware},
+			[]rest.Middleware{serverCtx.AntiSpamMiddleware, serverCtx.RateLimitMiddleware, serverCtx.OptionalJwtMiddleware},
 			[]rest.Route{
 				{
 					// 文章详情
@@ -248,7 +314,7 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 
 	server.AddRoutes(
 		rest.WithMiddlewares(
-			[]rest.Middleware{serverCtx.AntiSpamMiddleware, serverCtx.RateLimitMiddleware},
+			[]rest.Middleware{serverCtx.AntiSpamMiddleware, serverCtx.RateLimitMiddleware, serverCtx.OptionalJwtMiddleware},
 			[]rest.Route{
 				{
 					// 书单详情
@@ -328,6 +394,12 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 					Path:    "/docs/tags",
 					Handler: web.DocsTagsHandler(serverCtx),
 				},
+				{
+					// 捐赠墙
+					Method:  http.MethodGet,
+					Path:    "/donors",
+					Handler: web.DonorsHandler(serverCtx),
+				},
 				{
 					// 订单列表
 					Method:  http.MethodGet,
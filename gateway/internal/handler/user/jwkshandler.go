@@ -0,0 +1,19 @@
+package user
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+	"lxtian-blog/gateway/internal/logic/user"
+	"lxtian-blog/gateway/internal/svc"
+)
+
+// JwksHandler 按 JWKS 标准格式返回公钥集，不使用统一响应包装，便于其他服务直接读取
+func JwksHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		l := user.NewJwksLogic(r.Context(), svcCtx)
+		jwks := l.Jwks()
+		w.Header().Set("Cache-Control", "public, max-age=300")
+		httpx.OkJsonCtx(r.Context(), w, jwks)
+	}
+}
@@ -0,0 +1,21 @@
+package user
+
+import (
+	"lxtian-blog/common/restful/response"
+	"net/http"
+
+	"lxtian-blog/gateway/internal/logic/user"
+	"lxtian-blog/gateway/internal/svc"
+)
+
+func LogoutHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		l := user.NewLogoutLogic(r.Context(), svcCtx)
+		resp, err := l.Logout()
+		if err != nil {
+			response.Response(r, w, nil, err)
+		} else {
+			response.Response(r, w, resp, err)
+		}
+	}
+}
@@ -0,0 +1,27 @@
+package user
+
+import (
+	"github.com/zeromicro/go-zero/core/logc"
+	"lxtian-blog/common/restful/response"
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+	"lxtian-blog/gateway/internal/logic/user"
+	"lxtian-blog/gateway/internal/svc"
+	"lxtian-blog/gateway/internal/types"
+)
+
+func TokenRefreshHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.TokenRefreshReq
+		if err := httpx.Parse(r, &req); err != nil {
+			logc.Errorf(r.Context(), "TokenRefreshHandler error message: %s", err)
+			response.Response(r, w, nil, err)
+			return
+		}
+
+		l := user.NewTokenRefreshLogic(r.Context(), svcCtx)
+		resp, err := l.TokenRefresh(&req)
+		response.Response(r, w, resp, err)
+	}
+}
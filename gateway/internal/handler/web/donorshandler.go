@@ -0,0 +1,28 @@
+package web
+
+import (
+	"github.com/zeromicro/go-zero/core/logc"
+	"lxtian-blog/common/restful/response"
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+	"lxtian-blog/gateway/internal/logic/web"
+	"lxtian-blog/gateway/internal/svc"
+	"lxtian-blog/gateway/internal/types"
+)
+
+// 捐赠墙
+func DonorsHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.DonorsReq
+		if err := httpx.Parse(r, &req); err != nil {
+			logc.Errorf(r.Context(), "DonorsHandler error message: %s", err)
+			response.Response(r, w, nil, err)
+			return
+		}
+
+		l := web.NewDonorsLogic(r.Context(), svcCtx)
+		resp, err := l.Donors(&req)
+		response.Response(r, w, resp, err)
+	}
+}
@@ -40,7 +40,7 @@ func (l *CreatePaymentLogic) CreatePayment(req *types.CreatePaymentReq, r *http.
 		Quantity:  req.Quantity,
 		VipId:     int64(req.VipId),
 		UserId:    uint64(userId),
-		Amount:    req.Amount,
+		Amount:    utils.FormatAmount(utils.AmountFromFloat(req.Amount)),
 		Subject:   req.Subject,
 		ClientIp:  utils.GetClientIp(r),
 		PayType:   int64(req.PayType),
@@ -50,6 +50,12 @@ func (l *CreatePaymentLogic) CreatePayment(req *types.CreatePaymentReq, r *http.
 		ReturnUrl: req.ReturnUrl,
 		Remark:    req.Remark,
 		Timeout:   req.Timeout,
+		// 优惠码由支付服务校验，下单金额须为优惠后金额
+		CouponCode: req.CouponCode,
+		// 购买会员时携带用户确认过的报价
+		QuoteId: req.QuoteId,
+		// 前端重试时携带相同的 Idempotency-Key，支付服务返回首次创建的订单
+		IdempotencyKey: req.IdempotencyKey,
 	})
 	if err != nil {
 		return nil, err
@@ -32,7 +32,7 @@ func (l *DonateLogic) Donate(req *types.DonateReq, r *http.Request) (resp *types
 	res, err := l.svcCtx.PaymentRpc.Donate(l.ctx, &payment.DonateReq{
 		UserId:    uint64(req.UserId),
 		Nickname:  req.Nickname,
-		Amount:    req.Amount,
+		Amount:    utils.FormatAmount(utils.AmountFromFloat(req.Amount)),
 		Subject:   req.Subject,
 		ClientIp:  utils.GetClientIp(r),
 		PayType:   int64(req.PayType),
@@ -42,6 +42,7 @@ func (l *DonateLogic) Donate(req *types.DonateReq, r *http.Request) (resp *types
 		ReturnUrl: req.ReturnUrl,
 		Remark:    req.Remark,
 		Timeout:   req.Timeout,
+		Anonymous: req.Anonymous,
 	})
 	if err != nil {
 		return nil, err
@@ -0,0 +1,59 @@
+package payment
+
+import (
+	"context"
+	"errors"
+	"lxtian-blog/common/pkg/utils"
+	"net/http"
+
+	"lxtian-blog/gateway/internal/svc"
+	"lxtian-blog/gateway/internal/types"
+	"lxtian-blog/rpc/payment/pb/payment"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GiftCodeRedeemLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 兑换礼品码
+func NewGiftCodeRedeemLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GiftCodeRedeemLogic {
+	return &GiftCodeRedeemLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GiftCodeRedeemLogic) GiftCodeRedeem(req *types.GiftCodeRedeemReq, r *http.Request) (resp *types.GiftCodeRedeemResp, err error) {
+	// 从中间件获取用户信息
+	userId, ok := l.ctx.Value("user_id").(uint)
+	if !ok {
+		return nil, errors.New("user_id not found in context")
+	}
+	if req.Code == "" {
+		return nil, errors.New("礼品码不能为空")
+	}
+
+	res, err := l.svcCtx.PaymentRpc.RedeemGiftCode(l.ctx, &payment.RedeemGiftCodeReq{
+		UserId:   uint64(userId),
+		Code:     req.Code,
+		ClientIp: utils.GetClientIp(r),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.GiftCodeRedeemResp{
+		PaymentId:         res.PaymentId,
+		OrderSn:           res.OrderSn,
+		BuyType:           res.BuyType,
+		MembershipTypeId:  res.MembershipTypeId,
+		GoodsId:           res.GoodsId,
+		Subject:           res.Subject,
+		MembershipEndTime: res.MembershipEndTime,
+	}, nil
+}
@@ -0,0 +1,56 @@
+package payment
+
+import (
+	"context"
+	"errors"
+	"lxtian-blog/common/pkg/utils"
+	"net/http"
+
+	"lxtian-blog/gateway/internal/svc"
+	"lxtian-blog/gateway/internal/types"
+	"lxtian-blog/rpc/payment/pb/payment"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GoodsDownloadLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 获取已购商品下载地址
+func NewGoodsDownloadLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GoodsDownloadLogic {
+	return &GoodsDownloadLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GoodsDownloadLogic) GoodsDownload(req *types.GoodsDownloadReq, r *http.Request) (resp *types.GoodsDownloadResp, err error) {
+	// 从中间件获取用户信息
+	userId, ok := l.ctx.Value("user_id").(uint)
+	if !ok {
+		return nil, errors.New("user_id not found in context")
+	}
+	if req.GoodsId <= 0 {
+		return nil, errors.New("商品ID不能为空")
+	}
+
+	res, err := l.svcCtx.PaymentRpc.GoodsDownload(l.ctx, &payment.GoodsDownloadReq{
+		UserId:   uint64(userId),
+		GoodsId:  req.GoodsId,
+		ClientIp: utils.GetClientIp(r),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.GoodsDownloadResp{
+		Url:           res.Url,
+		ExpireAt:      res.ExpireAt,
+		DownloadCount: res.DownloadCount,
+		DownloadLimit: res.DownloadLimit,
+	}, nil
+}
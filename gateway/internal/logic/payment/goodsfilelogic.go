@@ -0,0 +1,48 @@
+package payment
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+
+	"lxtian-blog/gateway/internal/svc"
+	"lxtian-blog/gateway/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GoodsFileLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 下发本地商品文件（校验下载签名）
+func NewGoodsFileLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GoodsFileLogic {
+	return &GoodsFileLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// GoodsFile 校验支付服务签发的下载签名，返回商品文件在本地的完整路径
+func (l *GoodsFileLogic) GoodsFile(req *types.GoodsFileReq) (string, error) {
+	root := l.svcCtx.Config.GoodsDelivery.LocalRoot
+	if l.svcCtx.DownloadSigner == nil || root == "" {
+		return "", errors.New("商品文件下载未配置")
+	}
+	if !l.svcCtx.DownloadSigner.Verify(req.Key, req.Expires, req.Sign) {
+		return "", errors.New("下载链接无效或已过期")
+	}
+
+	// key 按根目录下的相对路径处理，避免通过 ../ 访问根目录以外的文件
+	filePath := filepath.Join(root, filepath.Clean("/"+req.Key))
+	info, err := os.Stat(filePath)
+	if err != nil || info.IsDir() {
+		l.Errorf("Goods file not found: key=%s, err=%v", req.Key, err)
+		return "", errors.New("文件不存在")
+	}
+	return filePath, nil
+}
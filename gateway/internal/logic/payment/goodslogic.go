@@ -29,7 +29,9 @@ func NewGoodsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GoodsLogic
 
 func (l *GoodsLogic) Goods(req *types.GoodsReq) (resp *types.GoodsResp, err error) {
 	res, err := l.svcCtx.PaymentRpc.Goods(l.ctx, &payment.GoodsReq{
-		Id: uint64(req.Id),
+		Id:             uint64(req.Id),
+		ReviewPage:     req.ReviewPage,
+		ReviewPageSize: req.ReviewPageSize,
 	})
 	if err != nil {
 		logc.Errorf(l.ctx, "Goods error: %s", err)
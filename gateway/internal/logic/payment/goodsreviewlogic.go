@@ -0,0 +1,49 @@
+package payment
+
+import (
+	"context"
+	"errors"
+
+	"lxtian-blog/gateway/internal/svc"
+	"lxtian-blog/gateway/internal/types"
+	"lxtian-blog/rpc/payment/pb/payment"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GoodsReviewLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 发表商品评价
+func NewGoodsReviewLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GoodsReviewLogic {
+	return &GoodsReviewLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GoodsReviewLogic) GoodsReview(req *types.GoodsReviewReq) (resp *types.GoodsReviewResp, err error) {
+	userId, ok := l.ctx.Value("user_id").(uint)
+	if !ok {
+		return nil, errors.New("user_id not found in context")
+	}
+	res, err := l.svcCtx.PaymentRpc.GoodsReviewCreate(l.ctx, &payment.GoodsReviewCreateReq{
+		UserId:  uint64(userId),
+		GoodsId: req.GoodsId,
+		Rating:  req.Rating,
+		Content: req.Content,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.GoodsReviewResp{
+		Id:          res.Id,
+		Rating:      res.Rating,
+		ReviewCount: res.ReviewCount,
+	}, nil
+}
@@ -0,0 +1,62 @@
+package payment
+
+import (
+	"context"
+	"errors"
+
+	"lxtian-blog/common/pkg/utils"
+	"lxtian-blog/gateway/internal/svc"
+	"lxtian-blog/gateway/internal/types"
+	"lxtian-blog/rpc/payment/pb/payment"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type MembershipQuoteLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 会员开通/续费/升级报价
+func NewMembershipQuoteLogic(ctx context.Context, svcCtx *svc.ServiceContext) *MembershipQuoteLogic {
+	return &MembershipQuoteLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *MembershipQuoteLogic) MembershipQuote(req *types.MembershipQuoteReq) (resp *types.MembershipQuoteResp, err error) {
+	userId, ok := l.ctx.Value("user_id").(uint)
+	if !ok {
+		return nil, errors.New("user_id not found in context")
+	}
+	res, err := l.svcCtx.PaymentRpc.QuoteMembership(l.ctx, &payment.QuoteMembershipReq{
+		UserId:     uint64(userId),
+		VipId:      int64(req.VipId),
+		CouponCode: req.CouponCode,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MembershipQuoteResp{
+		QuoteId:          res.QuoteId,
+		ExpireAt:         res.ExpireAt,
+		MembershipTypeId: res.MembershipTypeId,
+		MembershipName:   res.MembershipName,
+		RenewalType:      res.RenewalType,
+		FromTypeId:       res.FromTypeId,
+		RemainingDays:    res.RemainingDays,
+		OriginalAmount:   utils.AmountToFloat(res.OriginalAmount),
+		Credit:           utils.AmountToFloat(res.Credit),
+		CouponCode:       res.CouponCode,
+		CouponDiscount:   utils.AmountToFloat(res.CouponDiscount),
+		Amount:           utils.AmountToFloat(res.Amount),
+		StartTime:        res.StartTime,
+		EndTime:          res.EndTime,
+		TotalMonths:      res.TotalMonths,
+		Level:            res.Level,
+	}, nil
+}
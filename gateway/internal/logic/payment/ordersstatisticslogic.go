@@ -3,12 +3,12 @@ package payment
 import (
 	"context"
 	"errors"
+	"lxtian-blog/common/pkg/utils"
 	"lxtian-blog/rpc/payment/pb/payment"
 
 	"lxtian-blog/gateway/internal/svc"
 	"lxtian-blog/gateway/internal/types"
 
-	"github.com/shopspring/decimal"
 	"github.com/zeromicro/go-zero/core/logx"
 )
 
@@ -39,15 +39,10 @@ func (l *OrdersStatisticsLogic) OrdersStatistics() (resp *types.OrdersStatistics
 	if err != nil {
 		return nil, err
 	}
-	// 使用 decimal 库进行精确转换，避免 float32 转 float64 时的精度问题
-	// 先将 float32 转换为 float64，然后转换为 decimal，保留2位小数，再转换回 float64
-	payAmountDecimal := decimal.NewFromFloat(float64(res.PayAmount)).Round(2)
-	payAmountFloat64, _ := payAmountDecimal.Float64()
-
 	return &types.OrdersStatisticsResp{
 		Total:       res.Total,
 		Paid:        res.Paid,
 		Pending:     res.Pending,
-		TotalAmount: payAmountFloat64,
+		TotalAmount: utils.AmountToFloat(res.PayAmount),
 	}, nil
 }
@@ -48,6 +48,8 @@ func (l *RepayOrderLogic) RepayOrder(req *types.RepayOrderReq, r *http.Request)
 		ReturnUrl:  req.ReturnUrl,
 		NotifyUrl:  req.NotifyUrl,
 		ClientIp:   utils.GetClientIp(r),
+		// 前端重试时携带相同的 Idempotency-Key，支付服务返回首次生成的支付链接
+		IdempotencyKey: req.IdempotencyKey,
 	})
 	if err != nil {
 		return nil, err
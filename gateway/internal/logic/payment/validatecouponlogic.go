@@ -0,0 +1,56 @@
+package payment
+
+import (
+	"context"
+	"errors"
+
+	"lxtian-blog/common/pkg/utils"
+	"lxtian-blog/gateway/internal/svc"
+	"lxtian-blog/gateway/internal/types"
+	"lxtian-blog/rpc/payment/pb/payment"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ValidateCouponLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 校验优惠码
+func NewValidateCouponLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ValidateCouponLogic {
+	return &ValidateCouponLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ValidateCouponLogic) ValidateCoupon(req *types.ValidateCouponReq) (resp *types.ValidateCouponResp, err error) {
+	userId, ok := l.ctx.Value("user_id").(uint)
+	if !ok {
+		return nil, errors.New("user_id not found in context")
+	}
+	res, err := l.svcCtx.PaymentRpc.ValidateCoupon(l.ctx, &payment.ValidateCouponReq{
+		UserId:     uint64(userId),
+		CouponCode: req.CouponCode,
+		BuyType:    int64(req.BuyType),
+		GoodsId:    int64(req.GoodsId),
+		VipId:      int64(req.VipId),
+		Quantity:   req.Quantity,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.ValidateCouponResp{
+		Valid:          res.Valid,
+		Message:        res.Message,
+		CouponCode:     res.CouponCode,
+		OriginalAmount: utils.AmountToFloat(res.OriginalAmount),
+		Discount:       utils.AmountToFloat(res.Discount),
+		CouponDiscount: utils.AmountToFloat(res.CouponDiscount),
+		Amount:         utils.AmountToFloat(res.Amount),
+	}, nil
+}
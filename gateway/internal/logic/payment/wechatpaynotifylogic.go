@@ -0,0 +1,70 @@
+package payment
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"lxtian-blog/common/constant"
+	"lxtian-blog/common/pkg/wechatpay"
+	"lxtian-blog/gateway/internal/svc"
+	"lxtian-blog/gateway/internal/types"
+	"lxtian-blog/rpc/payment/pb/payment"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type WechatPayNotifyLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 微信支付结果异步通知
+func NewWechatPayNotifyLogic(ctx context.Context, svcCtx *svc.ServiceContext) *WechatPayNotifyLogic {
+	return &WechatPayNotifyLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *WechatPayNotifyLogic) WechatPayNotify(req *types.WechatPayNotifyReq) (resp *types.WechatPayNotifyResp, err error) {
+	if req == nil {
+		return nil, errors.New("request can not be nil")
+	}
+
+	notifyData := strings.TrimSpace(req.NotifyData)
+	if notifyData == "" {
+		return nil, errors.New("notify_data can not be empty")
+	}
+
+	clientIP := ""
+	if v, ok := l.ctx.Value("client_ip").(string); ok && v != "" {
+		clientIP = v
+	}
+
+	rpcResp, err := l.svcCtx.PaymentRpc.PaymentNotify(l.ctx, &payment.PaymentNotifyReq{
+		NotifyData: notifyData,
+		Sign:       req.Headers[wechatpay.HeaderSignature],
+		SignType:   "WECHATPAY2-SHA256-RSA2048",
+		ClientIp:   clientIP,
+		PayType:    constant.PayTypeWechat,
+		Headers:    req.Headers,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if rpcResp.Success {
+		return &types.WechatPayNotifyResp{
+			Code:    "SUCCESS",
+			Message: "成功",
+		}, nil
+	}
+
+	return &types.WechatPayNotifyResp{
+		Code:    "FAIL",
+		Message: rpcResp.Message,
+	}, nil
+}
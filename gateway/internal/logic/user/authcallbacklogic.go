@@ -102,26 +102,28 @@ func (l *AuthCallbackLogic) AuthCallback(w http.ResponseWriter, r *http.Request)
 
 	// 生成JWT token
 	auth := l.svcCtx.Config.Auth
-	token, err := jwts.GenToken(jwts.JwtPayLoad{
+	tokens, err := l.svcCtx.Sessions.IssueTokens(l.ctx, jwts.JwtPayLoad{
 		UserID:   uint(result["id"].(float64)),
 		Username: result["username"].(string),
-	}, auth.AccessSecret, auth.AccessExpire)
+	})
 	if err != nil {
 		logx.Errorf("生成token失败: type=%s, err=%v", oauthType, err)
 		return l.redirectToFrontendWithError(w, r, "登录失败")
 	}
 
 	// 将token存储到Redis
-	err = l.svcCtx.Rds.Setex(redis.ReturnRedisKey(redis.UserTokenString, result["id"]), token, int(auth.AccessExpire)*3600)
+	token := tokens.AccessToken
+	err = l.svcCtx.Rds.Setex(redis.ReturnRedisKey(redis.UserTokenString, result["id"]), token, int(auth.AccessExpire))
 	if err != nil {
 		logx.Errorf("存储token失败: type=%s, err=%v", oauthType, err)
 	}
 
 	logx.Infof("OAuth登录成功 - 类型: %s, 用户: %v, OpenID: %s", oauthType, result["username"], userInfo.OpenID)
 
-	// 重定向到前端，携带token
+	// 重定向到前端，携带token；刷新令牌放在 URL 片段中，不会发送到服务端，也不会出现在 Referer 和代理日志里
 	frontendURL := l.svcCtx.Config.OAuth.FrontendURL
-	redirectURL := fmt.Sprintf("%s?token=%s&expires_in=%d", frontendURL, token, auth.AccessExpire*3600)
+	redirectURL := fmt.Sprintf("%s?token=%s&expires_in=%d#refresh_token=%s&refresh_expires_in=%d",
+		frontendURL, token, tokens.ExpiresIn, tokens.RefreshToken, tokens.RefreshExpiresIn)
 	http.Redirect(w, r, redirectURL, http.StatusFound)
 	return nil
 }
@@ -2,6 +2,7 @@ package user
 
 import (
 	"context"
+	"lxtian-blog/common/pkg/utils"
 	"lxtian-blog/rpc/user/user"
 
 	"github.com/zeromicro/go-zero/core/logc"
@@ -43,9 +44,9 @@ func (l *GetMembershipListLogic) GetMembershipList() (resp *types.GetMembershipL
 		gatewayMt := &types.MembershipType{
 			Id:            mt.Id,
 			Name:          mt.Name,
-			Price:         mt.Price,
-			OriginalPrice: mt.OriginalPrice,
-			Discount:      mt.Discount,
+			Price:         utils.AmountToFloat(mt.Price),
+			OriginalPrice: utils.AmountToFloat(mt.OriginalPrice),
+			Discount:      utils.AmountToFloat(mt.Discount),
 			Period:        mt.Period,
 			Popular:       mt.Popular,
 			Permissions:   mt.Permissions,
@@ -0,0 +1,29 @@
+package user
+
+import (
+	"context"
+
+	"lxtian-blog/common/pkg/jwts"
+	"lxtian-blog/gateway/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type JwksLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewJwksLogic(ctx context.Context, svcCtx *svc.ServiceContext) *JwksLogic {
+	return &JwksLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// Jwks 返回当前全部验证公钥，轮换期间新旧密钥同时返回
+func (l *JwksLogic) Jwks() jwts.JWKS {
+	return l.svcCtx.KeySet.JWKS()
+}
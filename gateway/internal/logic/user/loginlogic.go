@@ -33,6 +33,7 @@ func NewLoginLogic(ctx context.Context, svcCtx *svc.ServiceContext) *LoginLogic
 
 func (l *LoginLogic) Login(req *types.LoginReq) (resp *types.LoginResp, err error) {
 	var res *user.LoginResp
+	var tokens *jwts.TokenPair
 	var token string
 	var message string
 	switch req.LoginType {
@@ -69,15 +70,16 @@ func (l *LoginLogic) Login(req *types.LoginReq) (resp *types.LoginResp, err erro
 		fmt.Println("result:", result)
 		// 获取token
 		auth := l.svcCtx.Config.Auth
-		token, err = jwts.GenToken(jwts.JwtPayLoad{
+		tokens, err = l.svcCtx.Sessions.IssueTokens(l.ctx, jwts.JwtPayLoad{
 			UserID:   uint(result["id"].(float64)),
 			Username: result["username"].(string),
-		}, auth.AccessSecret, auth.AccessExpire)
+		})
 		if err != nil {
 			return nil, err
 		}
+		token = tokens.AccessToken
 
-		err = l.svcCtx.Rds.Setex(redis.ReturnRedisKey(redis.UserTokenString, result["id"]), token, int(auth.AccessExpire)*3600)
+		err = l.svcCtx.Rds.Setex(redis.ReturnRedisKey(redis.UserTokenString, result["id"]), token, int(auth.AccessExpire))
 		if err != nil {
 			return nil, err
 		}
@@ -109,7 +111,9 @@ func (l *LoginLogic) Login(req *types.LoginReq) (resp *types.LoginResp, err erro
 		resp = new(types.LoginResp)
 		resp.User = result
 		resp.AccessToken = token
-		resp.ExpiresIn = uint64(auth.AccessExpire) * 3600
+		resp.ExpiresIn = uint64(tokens.ExpiresIn)
+		resp.RefreshToken = tokens.RefreshToken
+		resp.RefreshExpiresIn = uint64(tokens.RefreshExpiresIn)
 		return
 	default: //账号登录
 		res, err = l.svcCtx.UserRpc.Login(l.ctx, &user.LoginReq{
@@ -125,18 +129,19 @@ func (l *LoginLogic) Login(req *types.LoginReq) (resp *types.LoginResp, err erro
 			return nil, err
 		}
 		// 获取token
-		auth := l.svcCtx.Config.Auth
-		token, err = jwts.GenToken(jwts.JwtPayLoad{
+		tokens, err = l.svcCtx.Sessions.IssueTokens(l.ctx, jwts.JwtPayLoad{
 			UserID:   uint(result["id"].(float64)),
 			Username: result["username"].(string),
-		}, auth.AccessSecret, auth.AccessExpire)
+		})
 		if err != nil {
 			return nil, err
 		}
 		resp = new(types.LoginResp)
 		resp.User = result
-		resp.AccessToken = token
-		resp.ExpiresIn = uint64(auth.AccessExpire) * 3600
+		resp.AccessToken = tokens.AccessToken
+		resp.ExpiresIn = uint64(tokens.ExpiresIn)
+		resp.RefreshToken = tokens.RefreshToken
+		resp.RefreshExpiresIn = uint64(tokens.RefreshExpiresIn)
 		return
 	}
 	return &types.LoginResp{}, nil
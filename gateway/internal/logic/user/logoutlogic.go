@@ -0,0 +1,46 @@
+package user
+
+import (
+	"context"
+	"errors"
+
+	"lxtian-blog/common/pkg/jwts"
+	"lxtian-blog/gateway/internal/svc"
+	"lxtian-blog/gateway/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type LogoutLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewLogoutLogic(ctx context.Context, svcCtx *svc.ServiceContext) *LogoutLogic {
+	return &LogoutLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// Logout 退出登录：吊销当前访问令牌并结束会话
+func (l *LogoutLogic) Logout() (resp *types.LogoutResp, err error) {
+	//从中间件获取令牌信息
+	claims, ok := l.ctx.Value("jwt_claims").(*jwts.CustomClaims)
+	if !ok {
+		logx.Errorf("Logout jwt_claims not found in context")
+		return nil, errors.New("jwt_claims not found in context")
+	}
+
+	if err = l.svcCtx.Sessions.Logout(l.ctx, claims); err != nil {
+		l.Errorf("Failed to logout: userId=%d, err=%v", claims.UserID, err)
+		return nil, err
+	}
+
+	l.Infof("User logged out: userId=%d, sid=%s", claims.UserID, claims.SessionID)
+	return &types.LogoutResp{
+		Data: true,
+	}, nil
+}
@@ -34,9 +34,12 @@ func (l *ArticleLogic) Article(req *types.ArticleReq, r *http.Request) (resp *ty
 	clientIP := utils.GetClientIP(r)
 	logc.Infof(l.ctx, "文章 %d 被IP %s 访问", req.Id, clientIP)
 
+	// 可选登录，未登录时 userId 为0，用于会员专享内容校验
+	userId, _ := l.ctx.Value("user_id").(uint)
 	res, err := l.svcCtx.WebRpc.Article(l.ctx, &web.ArticleReq{
 		Id:       req.Id,
 		ClientIp: clientIP,
+		UserId:   int64(userId),
 	})
 	if err != nil {
 		logc.Errorf(l.ctx, "Article error: %s", err)
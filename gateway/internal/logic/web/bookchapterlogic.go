@@ -28,8 +28,11 @@ func NewBookChapterLogic(ctx context.Context, svcCtx *svc.ServiceContext) *BookC
 }
 
 func (l *BookChapterLogic) BookChapter(req *types.BookChapterReq) (resp *types.BookChapterResp, err error) {
+	// 可选登录，未登录时 userId 为0，用于会员专享内容校验
+	userId, _ := l.ctx.Value("user_id").(uint)
 	res, err := l.svcCtx.WebRpc.BookChapter(l.ctx, &web.BookChapterReq{
-		Id: req.Id,
+		Id:     req.Id,
+		UserId: int64(userId),
 	})
 	if err != nil {
 		logc.Errorf(l.ctx, "BookChapter error: %s", err)
@@ -35,9 +35,12 @@ func (l *DocsLogic) Docs(req *types.DocsReq, r *http.Request) (resp *types.DocsR
 	clientIP = utils.GetClientIP(r)
 	logc.Infof(l.ctx, "文档 %d 被IP %s 访问", req.Id, clientIP)
 
+	// 可选登录，未登录时 userId 为0，用于会员专享内容校验
+	userId, _ := l.ctx.Value("user_id").(uint)
 	res, err := l.svcCtx.WebRpc.Docs(l.ctx, &web.DocsReq{
 		Id:       req.Id,
 		ClientIp: clientIP,
+		UserId:   int64(userId),
 	})
 	if err != nil {
 		logc.Errorf(l.ctx, "Docs error: %s", err)
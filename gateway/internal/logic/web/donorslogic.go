@@ -0,0 +1,94 @@
+package web
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"lxtian-blog/gateway/internal/svc"
+	"lxtian-blog/gateway/internal/types"
+	"lxtian-blog/rpc/web/web"
+
+	"github.com/zeromicro/go-zero/core/logc"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DonorsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 捐赠墙
+func NewDonorsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DonorsLogic {
+	return &DonorsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// Donors 已确认的捐赠列表及捐赠统计，匿名捐赠已在服务端隐藏用户信息
+func (l *DonorsLogic) Donors(req *types.DonorsReq) (resp *types.DonorsResp, err error) {
+	listRes, err := l.svcCtx.WebRpc.OrderList(l.ctx, &web.OrderListReq{
+		Page:     req.Page,
+		PageSize: req.PageSize,
+	})
+	if err != nil {
+		logc.Errorf(l.ctx, "Donors OrderList error message: %s", err)
+		return nil, err
+	}
+	statRes, err := l.svcCtx.WebRpc.OrderStat(l.ctx, &web.OrderStatReq{
+		Months: req.Months,
+	})
+	if err != nil {
+		logc.Errorf(l.ctx, "Donors OrderStat error message: %s", err)
+		return nil, err
+	}
+
+	var orders []map[string]interface{}
+	if err := json.Unmarshal([]byte(listRes.List), &orders); err != nil {
+		return nil, err
+	}
+	list := make([]map[string]interface{}, 0, len(orders))
+	for _, order := range orders {
+		donor := map[string]interface{}{
+			"id":        order["id"],
+			"user_id":   order["user_id"],
+			"nickname":  order["nickname"],
+			"head_img":  order["head_img"],
+			"amount":    order["amount"],
+			"message":   order["remark"],
+			"anonymous": fmt.Sprintf("%v", order["anonymous"]) == "1",
+		}
+		if createdAtStr, ok := order["created_at"].(string); ok {
+			if t, err := time.Parse(time.RFC3339, createdAtStr); err == nil {
+				donor["date"] = t.Format("2006-01-02")
+			} else {
+				l.Errorf("Failed to parse created_at '%s': %v", createdAtStr, err)
+			}
+		}
+		list = append(list, donor)
+	}
+
+	months := make([]map[string]interface{}, 0)
+	if statRes.GetMonths() != "" {
+		if err := json.Unmarshal([]byte(statRes.GetMonths()), &months); err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.DonorsResp{
+		Page:     listRes.GetPage(),
+		PageSize: listRes.GetPageSize(),
+		List:     list,
+		Total:    uint64(listRes.GetTotal()),
+		Stat: map[string]interface{}{
+			"total_amount": statRes.GetTotalAmount(),
+			"count":        statRes.GetCount(),
+			"month_amount": statRes.GetMonthAmount(),
+		},
+		Months: months,
+	}, nil
+}
@@ -2,7 +2,6 @@ package web
 
 import (
 	"context"
-	"fmt"
 	"lxtian-blog/rpc/web/web"
 
 	"github.com/zeromicro/go-zero/core/logc"
@@ -37,9 +36,9 @@ func (l *OrderStatLogic) OrderStat() (resp *types.OrderStatResp, err error) {
 
 	resp = &types.OrderStatResp{
 		Data: map[string]interface{}{
-			"total_amount": fmt.Sprintf("%.2f", res.GetTotalAmount()),
+			"total_amount": res.GetTotalAmount(),
 			"count":        res.GetCount(),
-			"month_amount": fmt.Sprintf("%.2f", res.GetMonthAmount()),
+			"month_amount": res.GetMonthAmount(),
 		},
 	}
 
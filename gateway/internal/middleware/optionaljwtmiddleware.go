@@ -0,0 +1,50 @@
+package middleware
+
+import (
+	"context"
+	"lxtian-blog/common/pkg/jwts"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/logc"
+)
+
+// OptionalJwtMiddleware 可选登录：携带有效 token 时写入用户信息，未登录或 token 无效时按游客继续处理
+// 用于公开内容接口识别会员身份
+type OptionalJwtMiddleware struct {
+	keySet   *jwts.KeySet
+	sessions *jwts.SessionManager
+}
+
+func NewOptionalJwtMiddleware(keySet *jwts.KeySet, sessions *jwts.SessionManager) *OptionalJwtMiddleware {
+	return &OptionalJwtMiddleware{
+		keySet:   keySet,
+		sessions: sessions,
+	}
+}
+
+func (m *OptionalJwtMiddleware) Handle(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		authorization := r.Header.Get("Authorization")
+		parts := strings.Split(authorization, " ")
+		if !(len(parts) == 2 && parts[0] == "Bearer") {
+			next(w, r)
+			return
+		}
+		claims, err := m.keySet.ParseToken(parts[1])
+		if err != nil || claims.ExpiresAt.Before(time.Now()) {
+			logc.Infof(r.Context(), "OptionalJwtMiddleware: token无效，按未登录处理")
+			next(w, r)
+			return
+		}
+		if revoked, err := m.sessions.IsRevoked(r.Context(), claims); err != nil || revoked {
+			logc.Infof(r.Context(), "OptionalJwtMiddleware: token已吊销或校验失败，按未登录处理")
+			next(w, r)
+			return
+		}
+		r = r.WithContext(context.WithValue(r.Context(), "user_id", claims.UserID))
+		r = r.WithContext(context.WithValue(r.Context(), "username", claims.Username))
+		next(w, r)
+	}
+}
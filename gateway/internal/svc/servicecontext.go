@@ -2,6 +2,8 @@ package svc
 
 import (
 	"lxtian-blog/common/pkg/initdb"
+	"lxtian-blog/common/pkg/jwts"
+	"lxtian-blog/common/pkg/security"
 	"lxtian-blog/gateway/internal/config"
 	"lxtian-blog/gateway/internal/middleware"
 	"lxtian-blog/rpc/message/messageclient"
@@ -10,35 +12,53 @@ import (
 	"lxtian-blog/rpc/web/client/web"
 
 	"github.com/prometheus/client_golang/prometheus"
+	"github.com/zeromicro/go-zero/core/logx"
 	"github.com/zeromicro/go-zero/core/stores/redis"
 	"github.com/zeromicro/go-zero/rest"
 	"github.com/zeromicro/go-zero/zrpc"
 )
 
 type ServiceContext struct {
-	Config              config.Config
-	Rds                 *redis.Redis
-	WebRpc              web.Web
-	UserRpc             user.User
-	PaymentRpc          paymentclient.Payment
-	MessageRpc          messageclient.Message
-	JwtMiddleware       rest.Middleware
-	AntiSpamMiddleware  rest.Middleware
-	RateLimitMiddleware rest.Middleware
+	Config                config.Config
+	Rds                   *redis.Redis
+	WebRpc                web.Web
+	UserRpc               user.User
+	PaymentRpc            paymentclient.Payment
+	MessageRpc            messageclient.Message
+	JwtMiddleware         rest.Middleware
+	AntiSpamMiddleware    rest.Middleware
+	RateLimitMiddleware   rest.Middleware
+	OptionalJwtMiddleware rest.Middleware
+	DownloadSigner        *security.DownloadSigner // 本地商品文件下载签名校验，未配置密钥时为空
+	KeySet                *jwts.KeySet             // JWT 签名密钥集
+	Sessions              *jwts.SessionManager     // 登录会话：令牌签发、刷新与吊销
 }
 
 func NewServiceContext(c config.Config) *ServiceContext {
 	rds := initdb.InitRedis(c.RedisConfig.Host, c.RedisConfig.Type, c.RedisConfig.Pass, c.RedisConfig.Tls)
+	var downloadSigner *security.DownloadSigner
+	if c.GoodsDelivery.LocalSecret != "" {
+		downloadSigner = security.NewDownloadSigner(c.GoodsDelivery.LocalSecret)
+	}
+	keySet := jwts.MustNewKeySet(c.Auth.KeySet, c.Auth.AccessSecret)
+	if c.Auth.KeySet.EtcdKey != "" {
+		logx.Must(keySet.WatchEtcd(c.WebRpc.Etcd.Hosts, c.Auth.KeySet.EtcdKey))
+	}
+	sessions := jwts.NewSessionManager(rds, keySet, jwts.AudienceGateway, c.Auth.AccessExpire, c.Auth.RefreshExpire)
 	return &ServiceContext{
-		Config:              c,
-		Rds:                 rds,
-		WebRpc:              web.NewWeb(zrpc.MustNewClient(c.WebRpc)),
-		UserRpc:             user.NewUser(zrpc.MustNewClient(c.UserRpc)),
-		PaymentRpc:          paymentclient.NewPayment(zrpc.MustNewClient(c.PaymentRpc)),
-		MessageRpc:          messageclient.NewMessage(zrpc.MustNewClient(c.MessageRpc)),
-		JwtMiddleware:       middleware.NewJwtMiddleware(c.Auth.AccessSecret, c.Auth.AccessExpire).Handle,
-		AntiSpamMiddleware:  middleware.NewAntiSpamMiddleware(rds).Handle,
-		RateLimitMiddleware: middleware.NewRateLimitMiddleware(rds).Handle,
+		Config:                c,
+		Rds:                   rds,
+		WebRpc:                web.NewWeb(zrpc.MustNewClient(c.WebRpc)),
+		UserRpc:               user.NewUser(zrpc.MustNewClient(c.UserRpc)),
+		PaymentRpc:            paymentclient.NewPayment(zrpc.MustNewClient(c.PaymentRpc)),
+		MessageRpc:            messageclient.NewMessage(zrpc.MustNewClient(c.MessageRpc)),
+		JwtMiddleware:         middleware.NewJwtMiddleware(keySet, sessions).Handle,
+		AntiSpamMiddleware:    middleware.NewAntiSpamMiddleware(rds).Handle,
+		RateLimitMiddleware:   middleware.NewRateLimitMiddleware(rds).Handle,
+		OptionalJwtMiddleware: middleware.NewOptionalJwtMiddleware(keySet, sessions).Handle,
+		DownloadSigner:        downloadSigner,
+		KeySet:                keySet,
+		Sessions:              sessions,
 	}
 }
 
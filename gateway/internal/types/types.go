@@ -138,19 +138,22 @@ type CommentListResp struct {
 }
 
 type CreatePaymentReq struct {
-	GoodsId     uint64  `json:"goods_id,optional"`     // 商品ID
-	Quantity    uint32  `json:"quantity,optional"`     // 商品数量
-	VipId       int     `json:"vip_id,optional"`       // 会员ID
-	Amount      float64 `json:"amount"`                // 支付金额
-	Subject     string  `json:"subject"`               // 订单标题
-	Body        string  `json:"body,optional"`         // 订单描述
-	ReturnUrl   string  `json:"return_url,optional"`   // 支付成功跳转地址
-	NotifyUrl   string  `json:"notify_url,optional"`   // 支付结果异步通知地址
-	Timeout     string  `json:"timeout,optional"`      // 订单超时时间
-	ProductCode string  `json:"product_code,optional"` // 产品码，默认FAST_INSTANT_TRADE_PAY
-	PayType     int     `json:"pay_type,optional"`     // 支付类型：1:支付宝2:微信3:银行卡
-	BuyType     int     `json:"buy_type,optional"`     // 购买类型：1:商品消费2:购买会员
-	Remark      string  `json:"remark,optional"`       // 备注
+	GoodsId        uint64  `json:"goods_id,optional"`          // 商品ID
+	Quantity       uint32  `json:"quantity,optional"`          // 商品数量
+	VipId          int     `json:"vip_id,optional"`            // 会员ID
+	Amount         float64 `json:"amount"`                     // 支付金额
+	Subject        string  `json:"subject"`                    // 订单标题
+	Body           string  `json:"body,optional"`              // 订单描述
+	ReturnUrl      string  `json:"return_url,optional"`        // 支付成功跳转地址
+	NotifyUrl      string  `json:"notify_url,optional"`        // 支付结果异步通知地址
+	Timeout        string  `json:"timeout,optional"`           // 订单超时时间
+	ProductCode    string  `json:"product_code,optional"`      // 产品码，默认FAST_INSTANT_TRADE_PAY
+	PayType        int     `json:"pay_type,optional"`          // 支付类型：1:支付宝2:微信3:银行卡
+	BuyType        int     `json:"buy_type,optional"`          // 购买类型：1:商品消费2:购买会员
+	Remark         string  `json:"remark,optional"`            // 备注
+	IdempotencyKey string  `header:"Idempotency-Key,optional"` // 幂等键，重试时返回首次请求的响应
+	CouponCode     string  `json:"coupon_code,optional"`       // 优惠码
+	QuoteId        string  `json:"quote_id,optional"`          // 会员报价ID，购买会员时必填
 }
 
 type CreatePaymentResp struct {
@@ -266,6 +269,7 @@ type DonateReq struct {
 	UserId      int     `json:"user_id,optional"`      // 用户id
 	Nickname    string  `json:"nickname,optional"`     // 用户昵称
 	Remark      string  `json:"remark,optional"`       // 备注
+	Anonymous   bool    `json:"anonymous,optional"`    // 是否匿名展示在捐赠墙
 }
 
 type DonateResp struct {
@@ -275,6 +279,21 @@ type DonateResp struct {
 	PayUrl     string `json:"pay_url"`      // 支付链接
 }
 
+type DonorsReq struct {
+	Page     uint32 `form:"page,optional"`      // 页码
+	PageSize uint32 `form:"page_size,optional"` // 每页数量
+	Months   int32  `form:"months,optional"`    // 按月统计的月数，默认12
+}
+
+type DonorsResp struct {
+	Page     uint32                   `json:"page"`
+	PageSize uint32                   `json:"page_size"`
+	List     []map[string]interface{} `json:"list"` // 捐赠者：昵称/头像/金额/留言/日期
+	Total    uint64                   `json:"total"`
+	Stat     map[string]interface{}   `json:"stat"`   // 捐赠总额/笔数/本月金额
+	Months   []map[string]interface{} `json:"months"` // 按月统计
+}
+
 type GetMembershipListResp struct {
 	List []*MembershipType `json:"list"`
 }
@@ -288,6 +307,37 @@ type GetqrResp struct {
 	QrImg string `json:"qr_img"`
 }
 
+type GiftCodeRedeemReq struct {
+	Code string `json:"code"` // 礼品码
+}
+
+type GiftCodeRedeemResp struct {
+	PaymentId         string `json:"payment_id"`          // 兑换生成的订单支付ID
+	OrderSn           string `json:"order_sn"`            // 订单编号
+	BuyType           int64  `json:"buy_type"`            // 兑换类型：2:会员3:商品
+	MembershipTypeId  int64  `json:"membership_type_id"`  // 会员类型ID
+	GoodsId           int64  `json:"goods_id"`            // 商品ID
+	Subject           string `json:"subject"`             // 兑换内容名称
+	MembershipEndTime string `json:"membership_end_time"` // 兑换会员后的到期时间
+}
+
+type GoodsDownloadReq struct {
+	GoodsId int64 `json:"goods_id"` // 商品ID
+}
+
+type GoodsDownloadResp struct {
+	Url           string `json:"url"`            // 限时下载地址
+	ExpireAt      string `json:"expire_at"`      // 下载地址过期时间
+	DownloadCount int32  `json:"download_count"` // 已下载次数（含本次）
+	DownloadLimit int32  `json:"download_limit"` // 可下载次数，0不限制
+}
+
+type GoodsFileReq struct {
+	Key     string `form:"key"`     // 文件相对路径
+	Expires string `form:"expires"` // 过期时间戳
+	Sign    string `form:"sign"`    // 签名
+}
+
 type GoodsListReq struct {
 	ClassifyId int     `json:"classify_id"`
 	PriceMin   float32 `json:"price_min,optional"`
@@ -300,13 +350,27 @@ type GoodsListResp struct {
 }
 
 type GoodsReq struct {
-	Id uint32 `path:"id"`
+	Id             uint32 `path:"id"`
+	ReviewPage     uint32 `form:"review_page,optional"`      // 评价页码
+	ReviewPageSize uint32 `form:"review_page_size,optional"` // 评价每页数量
 }
 
 type GoodsResp struct {
 	Data map[string]interface{} `json:"data"`
 }
 
+type GoodsReviewReq struct {
+	GoodsId int64  `json:"goods_id"` // 商品ID
+	Rating  int32  `json:"rating"`   // 评分：1-5
+	Content string `json:"content"`  // 评价内容
+}
+
+type GoodsReviewResp struct {
+	Id          int64   `json:"id"`           // 评价ID
+	Rating      float64 `json:"rating"`       // 商品最新平均评分
+	ReviewCount int32   `json:"review_count"` // 商品最新评价数
+}
+
 type InfoResp struct {
 	Id       int64       `json:"id"`
 	Uid      int64       `json:"uid"`
@@ -334,9 +398,15 @@ type LoginReq struct {
 }
 
 type LoginResp struct {
-	AccessToken string                 `json:"access_token"`
-	ExpiresIn   uint64                 `json:"expires_in"`
-	User        map[string]interface{} `json:"user"`
+	AccessToken      string                 `json:"access_token"`
+	ExpiresIn        uint64                 `json:"expires_in"`
+	RefreshToken     string                 `json:"refresh_token"`
+	RefreshExpiresIn uint64                 `json:"refresh_expires_in"`
+	User             map[string]interface{} `json:"user"`
+}
+
+type LogoutResp struct {
+	Data bool `json:"data"`
 }
 
 type MemberShip struct {
@@ -348,6 +418,30 @@ type MemberShip struct {
 	Type_id    int    `json:"type_id"`
 }
 
+type MembershipQuoteReq struct {
+	VipId      int    `json:"vip_id"`               // 目标会员类型ID
+	CouponCode string `json:"coupon_code,optional"` // 优惠码
+}
+
+type MembershipQuoteResp struct {
+	QuoteId          string  `json:"quote_id"`           // 报价ID，下单时携带
+	ExpireAt         string  `json:"expire_at"`          // 报价有效期至
+	MembershipTypeId int64   `json:"membership_type_id"` // 目标会员类型ID
+	MembershipName   string  `json:"membership_name"`    // 目标会员名称
+	RenewalType      int32   `json:"renewal_type"`       // 续费类型：1:开通/同级续费2:升级
+	FromTypeId       int64   `json:"from_type_id"`       // 当前会员类型ID，无有效会员时为0
+	RemainingDays    int64   `json:"remaining_days"`     // 当前会员剩余天数（升级折算）
+	OriginalAmount   float64 `json:"original_amount"`    // 原价
+	Credit           float64 `json:"credit"`             // 剩余时长折算抵扣金额
+	CouponCode       string  `json:"coupon_code"`        // 使用的优惠码
+	CouponDiscount   float64 `json:"coupon_discount"`    // 优惠券减免金额
+	Amount           float64 `json:"amount"`             // 应付金额
+	StartTime        string  `json:"start_time"`         // 支付后会员开始时间
+	EndTime          string  `json:"end_time"`           // 支付后会员到期时间
+	TotalMonths      int32   `json:"total_months"`       // 支付后累计月数
+	Level            int32   `json:"level"`              // 支付后会员等级
+}
+
 type MembershipType struct {
 	Id            int64    `json:"id"`
 	Name          string   `json:"name"`
@@ -462,12 +556,13 @@ type QueryPaymentResp struct {
 }
 
 type RefundPaymentReq struct {
-	PaymentId    string  `json:"payment_id"`              // 支付ID
-	OrderId      string  `json:"order_id"`                // 订单ID
-	OutTradeNo   string  `json:"out_trade_no"`            // 商户订单号
-	RefundAmount float64 `json:"refund_amount"`           // 退款金额
-	RefundReason string  `json:"refund_reason,optional"`  // 退款原因
-	OutRequestNo string  `json:"out_request_no,optional"` // 退款单号
+	PaymentId      string  `json:"payment_id"`                 // 支付ID
+	OrderId        string  `json:"order_id"`                   // 订单ID
+	OutTradeNo     string  `json:"out_trade_no"`               // 商户订单号
+	RefundAmount   float64 `json:"refund_amount"`              // 退款金额
+	RefundReason   string  `json:"refund_reason,optional"`     // 退款原因
+	OutRequestNo   string  `json:"out_request_no,optional"`    // 退款单号
+	IdempotencyKey string  `header:"Idempotency-Key,optional"` // 幂等键，重试时返回首次请求的响应
 }
 
 type RefundPaymentResp struct {
@@ -491,10 +586,11 @@ type RegisterResp struct {
 }
 
 type RepayOrderReq struct {
-	OrderId    string `json:"order_id,optional"`     // 订单ID
-	OutTradeNo string `json:"out_trade_no,optional"` // 商户订单号
-	ReturnUrl  string `json:"return_url,optional"`   // 支付成功跳转地址
-	NotifyUrl  string `json:"notify_url,optional"`   // 支付结果异步通知地址
+	OrderId        string `json:"order_id,optional"`          // 订单ID
+	OutTradeNo     string `json:"out_trade_no,optional"`      // 商户订单号
+	ReturnUrl      string `json:"return_url,optional"`        // 支付成功跳转地址
+	NotifyUrl      string `json:"notify_url,optional"`        // 支付结果异步通知地址
+	IdempotencyKey string `header:"Idempotency-Key,optional"` // 幂等键，重试时返回首次请求的响应
 }
 
 type RepayOrderResp struct {
@@ -513,6 +609,17 @@ type TagsListResp struct {
 	Data []map[string]interface{} `json:"list"`
 }
 
+type TokenRefreshReq struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
+type TokenRefreshResp struct {
+	AccessToken      string `json:"access_token"`
+	ExpiresIn        uint64 `json:"expires_in"`
+	RefreshToken     string `json:"refresh_token"`
+	RefreshExpiresIn uint64 `json:"refresh_expires_in"`
+}
+
 type UpdateInfoReq struct {
 	Nickname string `json:"nickname,optional"`
 	HeadImg  string `json:"head_img,optional"`
@@ -534,3 +641,31 @@ type UpgradeMembershipResp struct {
 	EndTime string `json:"end_time"`
 	Level   int    `json:"level"`
 }
+
+type ValidateCouponReq struct {
+	CouponCode string `json:"coupon_code"`       // 优惠码
+	BuyType    int    `json:"buy_type"`          // 购买类型：2:购买会员3:商城消费
+	GoodsId    uint64 `json:"goods_id,optional"` // 商品ID
+	VipId      int    `json:"vip_id,optional"`   // 会员ID
+	Quantity   uint32 `json:"quantity,optional"` // 商品数量
+}
+
+type ValidateCouponResp struct {
+	Valid          bool    `json:"valid"`           // 优惠码是否可用
+	Message        string  `json:"message"`         // 不可用原因
+	CouponCode     string  `json:"coupon_code"`     // 优惠码
+	OriginalAmount float64 `json:"original_amount"` // 抵扣前金额
+	Discount       float64 `json:"discount"`        // 会员升级抵扣金额
+	CouponDiscount float64 `json:"coupon_discount"` // 优惠券减免金额
+	Amount         float64 `json:"amount"`          // 使用优惠码后的应付金额
+}
+
+type WechatPayNotifyReq struct {
+	NotifyData string            `json:"notify_data,optional"` // notify原数据
+	Headers    map[string]string `json:"headers,optional"`     // Wechatpay-* 签名请求头
+}
+
+type WechatPayNotifyResp struct {
+	Code    string `json:"code"`    // 返回状态码，成功返回"SUCCESS"
+	Message string `json:"message"` // 返回信息
+}
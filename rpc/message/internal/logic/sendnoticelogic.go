@@ -0,0 +1,64 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+
+	"lxtian-blog/common/model"
+	"lxtian-blog/common/repository/message_repo"
+	"lxtian-blog/rpc/message/internal/svc"
+	"lxtian-blog/rpc/message/message"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type SendNoticeLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewSendNoticeLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SendNoticeLogic {
+	return &SendNoticeLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// SendNotice 写入站内通知，相同 biz_key 的通知只写入一次，重复发送返回已有通知
+func (l *SendNoticeLogic) SendNotice(in *message.SendNoticeReq) (*message.SendNoticeResp, error) {
+	if in.UserId <= 0 {
+		return nil, fmt.Errorf("接收用户ID不能为空")
+	}
+	if in.Type == "" || in.Title == "" {
+		return nil, fmt.Errorf("通知类型和标题不能为空")
+	}
+	if in.BizKey == "" {
+		return nil, fmt.Errorf("业务去重键不能为空")
+	}
+
+	repo := message_repo.NewLxtMessageNoticesRepo(l.svcCtx.DB)
+	notice := &model.LxtMessageNotice{
+		UserID:  in.UserId,
+		Type:    in.Type,
+		Title:   in.Title,
+		Content: in.Content,
+		BizKey:  in.BizKey,
+	}
+	created, err := repo.CreateIfNotExists(l.ctx, notice)
+	if err != nil {
+		l.Errorf("Failed to send notice: userId=%d, bizKey=%s, err=%v", in.UserId, in.BizKey, err)
+		return nil, err
+	}
+	if !created {
+		existing, err := repo.GetByBizKey(l.ctx, in.BizKey)
+		if err != nil {
+			return nil, fmt.Errorf("查询通知失败: %w", err)
+		}
+		return &message.SendNoticeResp{Id: existing.ID, Duplicated: true}, nil
+	}
+
+	l.Infof("Sent notice: id=%d, userId=%d, type=%s, bizKey=%s", notice.ID, in.UserId, in.Type, in.BizKey)
+	return &message.SendNoticeResp{Id: notice.ID}, nil
+}
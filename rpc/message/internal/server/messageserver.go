@@ -27,3 +27,9 @@ func (s *MessageServer) Ping(ctx context.Context, in *message.Request) (*message
 	l := logic.NewPingLogic(ctx, s.svcCtx)
 	return l.Ping(in)
 }
+
+// 发送站内通知
+func (s *MessageServer) SendNotice(ctx context.Context, in *message.SendNoticeReq) (*message.SendNoticeResp, error) {
+	l := logic.NewSendNoticeLogic(ctx, s.svcCtx)
+	return l.SendNotice(in)
+}
@@ -1,13 +1,30 @@
 package svc
 
-import "lxtian-blog/rpc/message/internal/config"
+import (
+	"fmt"
+
+	"lxtian-blog/common/pkg/initdb"
+	"lxtian-blog/rpc/message/internal/config"
+
+	"gorm.io/gorm"
+)
 
 type ServiceContext struct {
 	Config config.Config
+	DB     *gorm.DB
 }
 
 func NewServiceContext(c config.Config) *ServiceContext {
+	dataSource := fmt.Sprintf("%s:%s@tcp(%s:%s)/%s?charset=utf8mb4&parseTime=True&loc=Local",
+		c.Mysql.USERNAME,
+		c.Mysql.PASSWORD,
+		c.Mysql.HOST,
+		c.Mysql.PORT,
+		c.Mysql.DATABASE,
+	)
+
 	return &ServiceContext{
 		Config: c,
+		DB:     initdb.InitDB(dataSource),
 	}
 }
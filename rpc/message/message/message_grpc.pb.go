@@ -1,6 +1,6 @@
 // Code generated by protoc-gen-go-grpc. DO NOT EDIT.
 // versions:
-// - protoc-gen-go-grpc v1.5.1
+// - protoc-gen-go-grpc v1.3.0
 // - protoc             v3.19.4
 // source: message.proto
 
@@ -15,11 +15,12 @@ import (
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the grpc package it is being compiled against.
-// Requires gRPC-Go v1.64.0 or later.
-const _ = grpc.SupportPackageIsVersion9
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Message_Ping_FullMethodName = "/message.Message/Ping"
+	Message_Ping_FullMethodName       = "/message.Message/Ping"
+	Message_SendNotice_FullMethodName = "/message.Message/SendNotice"
 )
 
 // MessageClient is the client API for Message service.
@@ -27,6 +28,8 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type MessageClient interface {
 	Ping(ctx context.Context, in *Request, opts ...grpc.CallOption) (*Response, error)
+	// 发送站内通知
+	SendNotice(ctx context.Context, in *SendNoticeReq, opts ...grpc.CallOption) (*SendNoticeResp, error)
 }
 
 type messageClient struct {
@@ -38,9 +41,17 @@ func NewMessageClient(cc grpc.ClientConnInterface) MessageClient {
 }
 
 func (c *messageClient) Ping(ctx context.Context, in *Request, opts ...grpc.CallOption) (*Response, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Response)
-	err := c.cc.Invoke(ctx, Message_Ping_FullMethodName, in, out, cOpts...)
+	err := c.cc.Invoke(ctx, Message_Ping_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageClient) SendNotice(ctx context.Context, in *SendNoticeReq, opts ...grpc.CallOption) (*SendNoticeResp, error) {
+	out := new(SendNoticeResp)
+	err := c.cc.Invoke(ctx, Message_SendNotice_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -49,24 +60,25 @@ func (c *messageClient) Ping(ctx context.Context, in *Request, opts ...grpc.Call
 
 // MessageServer is the server API for Message service.
 // All implementations must embed UnimplementedMessageServer
-// for forward compatibility.
+// for forward compatibility
 type MessageServer interface {
 	Ping(context.Context, *Request) (*Response, error)
+	// 发送站内通知
+	SendNotice(context.Context, *SendNoticeReq) (*SendNoticeResp, error)
 	mustEmbedUnimplementedMessageServer()
 }
 
-// UnimplementedMessageServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedMessageServer struct{}
+// UnimplementedMessageServer must be embedded to have forward compatible implementations.
+type UnimplementedMessageServer struct {
+}
 
 func (UnimplementedMessageServer) Ping(context.Context, *Request) (*Response, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Ping not implemented")
 }
+func (UnimplementedMessageServer) SendNotice(context.Context, *SendNoticeReq) (*SendNoticeResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendNotice not implemented")
+}
 func (UnimplementedMessageServer) mustEmbedUnimplementedMessageServer() {}
-func (UnimplementedMessageServer) testEmbeddedByValue()                 {}
 
 // UnsafeMessageServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to MessageServer will
@@ -76,13 +88,6 @@ type UnsafeMessageServer interface {
 }
 
 func RegisterMessageServer(s grpc.ServiceRegistrar, srv MessageServer) {
-	// If the following call pancis, it indicates UnimplementedMessageServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
-	}
 	s.RegisterService(&Message_ServiceDesc, srv)
 }
 
@@ -104,6 +109,24 @@ func _Message_Ping_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Message_SendNotice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendNoticeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServer).SendNotice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Message_SendNotice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServer).SendNotice(ctx, req.(*SendNoticeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Message_ServiceDesc is the grpc.ServiceDesc for Message service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -115,6 +138,10 @@ var Message_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Ping",
 			Handler:    _Message_Ping_Handler,
 		},
+		{
+			MethodName: "SendNotice",
+			Handler:    _Message_SendNotice_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "message.proto",
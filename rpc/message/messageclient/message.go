@@ -14,11 +14,15 @@ import (
 )
 
 type (
-	Request  = message.Request
-	Response = message.Response
+	Request        = message.Request
+	Response       = message.Response
+	SendNoticeReq  = message.SendNoticeReq
+	SendNoticeResp = message.SendNoticeResp
 
 	Message interface {
 		Ping(ctx context.Context, in *Request, opts ...grpc.CallOption) (*Response, error)
+		// 发送站内通知
+		SendNotice(ctx context.Context, in *SendNoticeReq, opts ...grpc.CallOption) (*SendNoticeResp, error)
 	}
 
 	defaultMessage struct {
@@ -36,3 +40,9 @@ func (m *defaultMessage) Ping(ctx context.Context, in *Request, opts ...grpc.Cal
 	client := message.NewMessageClient(m.cli.Conn())
 	return client.Ping(ctx, in, opts...)
 }
+
+// 发送站内通知
+func (m *defaultMessage) SendNotice(ctx context.Context, in *SendNoticeReq, opts ...grpc.CallOption) (*SendNoticeResp, error) {
+	client := message.NewMessageClient(m.cli.Conn())
+	return client.SendNotice(ctx, in, opts...)
+}
@@ -19,7 +19,53 @@ type Config struct {
 		Pass string `json:",env=REDIS_PASS"`
 		Tls  bool   `json:",env=REDIS_TLS"`
 	}
-	Alipay AlipayConfig
+	Alipay      AlipayConfig
+	WechatPay   WechatPayConfig `json:",optional"`
+	OrderExpiry JobConfig       // 超时订单关闭任务
+	RefundSync  JobConfig       // 退款状态同步任务
+	Reconcile   JobConfig       // 账单对账任务
+
+	ReconcileBillDir string `json:",optional"` // 本地账单文件目录，后台指定账单文件对账时只读取该目录下的文件，未配置时不允许读取本地账单
+
+	MembershipExpiry JobConfig          // 会员到期处理任务（到期停用、到期提醒）
+	MessageRpc       zrpc.RpcClientConf // 消息服务，用于发送会员到期提醒
+
+	PendingLimit       PendingLimitConfig // 会员/商城待支付订单数量限制
+	DonatePendingLimit PendingLimitConfig // 捐赠待支付订单数量限制
+
+	MembershipQuoteTTL int `json:",default=600"` // 会员报价有效期（秒）
+
+	QiniuOss      QiniuOssConfig      `json:",optional"` // 七牛云存储，用于签发商品文件下载链接
+	GoodsDelivery GoodsDeliveryConfig // 商品文件交付配置
+}
+
+// QiniuOssConfig 七牛云存储配置
+type QiniuOssConfig struct {
+	AccessKey string `json:",optional,env=AccessKey"`
+	SecretKey string `json:",optional,env=SecretKey"`
+	Bucket    string `json:",optional,env=Bucket"`
+	Domain    string `json:",optional,env=Domain"`
+	Region    string `json:",optional,env=Region"`
+}
+
+// GoodsDeliveryConfig 商品文件交付配置，本地文件的下载链接由网关校验签名后下发
+type GoodsDeliveryConfig struct {
+	DownloadLimit int32  `json:",default=5"`                          // 每个用户对同一商品的默认下载次数，<=0 表示不限制
+	UrlExpire     int    `json:",default=300"`                        // 下载链接有效期（秒）
+	LocalBaseUrl  string `json:",optional"`                           // 网关本地文件下发地址
+	LocalSecret   string `json:",optional,env=GOODS_DOWNLOAD_SECRET"` // 本地文件下载链接签名密钥，需与网关一致
+}
+
+// PendingLimitConfig 待支付订单数量限制，统计窗口内创建且仍未支付的订单
+type PendingLimitConfig struct {
+	Limit  int64 `json:",default=3"`     // 窗口内最多允许的待支付订单数，<=0 表示不限制
+	Window int   `json:",default=86400"` // 统计窗口（秒）
+}
+
+// JobConfig 后台定时任务配置
+type JobConfig struct {
+	Disabled bool `json:",optional"`
+	Interval int  `json:",default=60"` // 扫描间隔（秒）
 }
 
 // AlipayConfig 支付宝配置
@@ -37,3 +83,16 @@ type AlipayConfig struct {
 	Version         string `json:",optional"`
 	Timeout         string `json:",optional"`
 }
+
+// WechatPayConfig 微信支付配置，未配置商户号时不启用微信支付
+type WechatPayConfig struct {
+	AppId               string `json:",optional,env=WechatPayAppId"`
+	MchId               string `json:",optional,env=WechatPayMchId"`
+	MchSerialNo         string `json:",optional,env=WechatPayMchSerialNo"`
+	MchPrivateKey       string `json:",optional,env=WechatPayMchPrivateKey"`
+	ApiV3Key            string `json:",optional,env=WechatPayApiV3Key"`
+	PlatformPublicKey   string `json:",optional,env=WechatPayPlatformPublicKey"`
+	PlatformPublicKeyId string `json:",optional,env=WechatPayPlatformPublicKeyId"`
+	NotifyUrl           string `json:",optional"`
+	GatewayUrl          string `json:",optional"`
+}
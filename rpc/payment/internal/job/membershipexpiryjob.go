@@ -0,0 +1,19 @@
+package job
+
+import (
+	"context"
+
+	"lxtian-blog/common/pkg/job"
+	"lxtian-blog/rpc/payment/internal/logic"
+	"lxtian-blog/rpc/payment/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/service"
+)
+
+// NewMembershipExpiryJob 定时停用已到期的会员，并在到期前发送提醒
+func NewMembershipExpiryJob(svcCtx *svc.ServiceContext) service.Service {
+	return job.NewTickerJob("membership expiry", svcCtx.Config.MembershipExpiry.Interval, func(ctx context.Context) error {
+		_, err := logic.NewMembershipExpiryLogic(ctx, svcCtx).ProcessMembershipExpiry()
+		return err
+	})
+}
@@ -0,0 +1,19 @@
+package job
+
+import (
+	"context"
+
+	"lxtian-blog/common/pkg/job"
+	"lxtian-blog/rpc/payment/internal/logic"
+	"lxtian-blog/rpc/payment/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/service"
+)
+
+// NewOrderExpiryJob 定时关闭超时未支付订单
+func NewOrderExpiryJob(svcCtx *svc.ServiceContext) service.Service {
+	return job.NewTickerJob("order expiry", svcCtx.Config.OrderExpiry.Interval, func(ctx context.Context) error {
+		_, err := logic.NewCloseExpiredOrdersLogic(ctx, svcCtx).CloseExpiredOrders()
+		return err
+	})
+}
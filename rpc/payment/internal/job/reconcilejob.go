@@ -0,0 +1,18 @@
+package job
+
+import (
+	"context"
+
+	"lxtian-blog/common/pkg/job"
+	"lxtian-blog/rpc/payment/internal/logic"
+	"lxtian-blog/rpc/payment/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/service"
+)
+
+// NewReconcileJob 定时下载前一天的支付宝账单并对账，已对账完成的日期不会重复执行
+func NewReconcileJob(svcCtx *svc.ServiceContext) service.Service {
+	return job.NewTickerJob("bill reconcile", svcCtx.Config.Reconcile.Interval, func(ctx context.Context) error {
+		return logic.NewReconcileBillLogic(ctx, svcCtx).ReconcileYesterday()
+	})
+}
@@ -0,0 +1,19 @@
+package job
+
+import (
+	"context"
+
+	"lxtian-blog/common/pkg/job"
+	"lxtian-blog/rpc/payment/internal/logic"
+	"lxtian-blog/rpc/payment/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/service"
+)
+
+// NewRefundSyncJob 定时查询支付宝退款结果，确认待处理的退款
+func NewRefundSyncJob(svcCtx *svc.ServiceContext) service.Service {
+	return job.NewTickerJob("refund sync", svcCtx.Config.RefundSync.Interval, func(ctx context.Context) error {
+		_, err := logic.NewSyncRefundStatusLogic(ctx, svcCtx).SyncRefundStatus()
+		return err
+	})
+}
@@ -2,11 +2,15 @@ package logic
 
 import (
 	"context"
+	"lxtian-blog/common/constant"
+	"lxtian-blog/common/pkg/payprovider"
 	"lxtian-blog/common/pkg/utils"
 	"time"
 
+	"lxtian-blog/rpc/payment/internal/orderstate"
 	"lxtian-blog/rpc/payment/internal/svc"
 
+	"github.com/shopspring/decimal"
 	"github.com/zeromicro/go-zero/core/logx"
 )
 
@@ -38,3 +42,42 @@ func (l *BaseLogic) generateRefundId() string {
 func (l *BaseLogic) generateNotifyId() string {
 	return "NOTIFY_" + time.Now().Format("20060102150405") + "_" + utils.GenerateRandomString(8)
 }
+
+// 生成对账批次ID
+func (l *BaseLogic) generateReconcileBatchId() string {
+	return "RECON_" + time.Now().Format("20060102150405") + "_" + utils.GenerateRandomString(8)
+}
+
+// 按支付方式获取支付渠道，未指定支付方式的历史订单使用支付宝
+func (l *BaseLogic) paymentProvider(payType int32) (payprovider.PaymentProvider, error) {
+	if payType == 0 {
+		payType = constant.PayTypeAlipay
+	}
+	return l.svcCtx.Providers.Get(payType)
+}
+
+// transitionOrder 通过订单状态机变更支付订单状态，返回状态是否发生变更
+func (l *BaseLogic) transitionOrder(t *orderstate.Transition) (bool, error) {
+	return orderstate.NewMachine(l.svcCtx.DB).Fire(l.ctx, t)
+}
+
+// markOrderPaid 通过订单状态机将订单置为已支付，同时保存支付渠道返回的交易信息
+func (l *BaseLogic) markOrderPaid(paymentId, tradeNo, tradeStatus, buyerUserId, buyerLogonId string, receiptAmount decimal.Decimal, payTime *time.Time) (bool, error) {
+	updates := map[string]interface{}{
+		"trade_no":       tradeNo,
+		"trade_status":   tradeStatus,
+		"buyer_user_id":  buyerUserId,
+		"buyer_logon_id": buyerLogonId,
+		"receipt_amount": receiptAmount,
+	}
+	if payTime != nil {
+		updates["pay_time"] = payTime
+	}
+
+	return l.transitionOrder(&orderstate.Transition{
+		PaymentId: paymentId,
+		To:        constant.PaymentStatusPaid,
+		Event:     constant.OrderEventPaySuccess,
+		Updates:   updates,
+	})
+}
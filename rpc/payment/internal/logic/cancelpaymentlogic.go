@@ -7,6 +7,7 @@ import (
 	"lxtian-blog/common/repository/payment_repo"
 
 	"lxtian-blog/common/model"
+	"lxtian-blog/rpc/payment/internal/orderstate"
 	"lxtian-blog/rpc/payment/internal/svc"
 	"lxtian-blog/rpc/payment/pb/payment"
 )
@@ -82,15 +83,22 @@ func (l *CancelPaymentLogic) CancelPayment(in *payment.CancelPaymentReq) (*payme
 	//	}, fmt.Errorf("failed to cancel alipay payment: %w", err)
 	//}
 
-	// 更新本地订单状态
-	err = paymentService.UpdateStatus(l.ctx, paymentOrder.PaymentID, constant.PaymentStatusCancelled)
+	// 更新本地订单状态，期间订单已支付时状态机拒绝取消
+	_, err = l.transitionOrder(&orderstate.Transition{
+		PaymentId: paymentOrder.PaymentID,
+		To:        constant.PaymentStatusCancelled,
+		Event:     constant.OrderEventUserCancel,
+	})
 	if err != nil {
 		l.Errorf("Failed to update payment status: %v", err)
-		// 即使本地更新失败，支付宝那边已经取消了，所以仍然返回成功
+		return &payment.CancelPaymentResp{
+			Success: false,
+			Message: "订单状态不允许取消",
+		}, fmt.Errorf("failed to cancel payment order: %w", err)
 	}
 
-	// 用户主动取消订单，计数减 1（不再视为待支付）
-	adjustUserDailyPendingCount(l.ctx, l.svcCtx.Rds, paymentOrder.UserID, paymentOrder.CreatedAt, -1)
+	// 用户主动取消订单，释放待支付名额
+	l.releaseUserPendingOrder(paymentOrder.UserID, paymentOrder.OutTradeNo)
 
 	// 记录日志
 	l.Infof("Cancelled payment order: paymentId=%s, orderSn=%s, outTradeNo=%s",
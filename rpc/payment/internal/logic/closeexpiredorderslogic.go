@@ -0,0 +1,150 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"lxtian-blog/common/constant"
+	"lxtian-blog/common/model"
+	"lxtian-blog/common/pkg/payprovider"
+	redisutil "lxtian-blog/common/pkg/redis"
+	"lxtian-blog/common/repository/payment_repo"
+	"lxtian-blog/rpc/payment/internal/orderstate"
+	"lxtian-blog/rpc/payment/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/stores/redis"
+)
+
+const (
+	// 单个订单关闭锁的过期时间（秒）
+	orderCloseLockSeconds = 60
+	// 捐赠订单创建后超过该时间仍未支付则关闭（支付宝侧交易超时默认30分钟）
+	donateOrderCloseAfter = 2 * time.Hour
+	// 每批查询的待支付订单数量
+	expiredOrderBatchSize = 200
+	// 订单最短超时时间，创建时间在此之内的订单不会超时，无需查询
+	minOrderTimeout = time.Minute
+)
+
+// CloseExpiredOrdersLogic 超时未支付订单关闭
+type CloseExpiredOrdersLogic struct {
+	*BaseLogic
+	paymentService payment_repo.PaymentOrderRepository
+}
+
+func NewCloseExpiredOrdersLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CloseExpiredOrdersLogic {
+	return &CloseExpiredOrdersLogic{
+		BaseLogic:      NewBaseLogic(ctx, svcCtx),
+		paymentService: payment_repo.NewPaymentOrderRepository(svcCtx.DB),
+	}
+}
+
+// CloseExpiredOrders 查询已超过 timeout 的待支付订单并逐个关闭，返回成功关闭的订单数（不含捐赠订单）
+func (l *CloseExpiredOrdersLogic) CloseExpiredOrders() (int, error) {
+	createdBefore := time.Now().Add(-minOrderTimeout)
+	closed := 0
+	var afterId int64
+	for {
+		orders, lastId, err := l.paymentService.GetExpiredOrders(l.ctx, createdBefore, afterId, expiredOrderBatchSize)
+		if err != nil {
+			l.Errorf("Failed to get expired orders: %v", err)
+			return closed, err
+		}
+
+		for _, order := range orders {
+			if l.closeExpiredOrder(order) {
+				closed++
+			}
+		}
+		if lastId == 0 {
+			break
+		}
+		afterId = lastId
+	}
+	if closed > 0 {
+		l.Infof("Closed %d expired payment orders", closed)
+	}
+
+	l.closeExpiredDonateOrders()
+	return closed, nil
+}
+
+// closeExpiredDonateOrders 关闭超时未支付的捐赠订单，之后到达的支付成功通知仍会将订单更新为已支付
+func (l *CloseExpiredOrdersLogic) closeExpiredDonateOrders() {
+	result := l.svcCtx.DB.WithContext(l.ctx).Model(&model.TxyOrder{}).
+		Where("status = ? AND created_at < ?", constant.PaymentStatusPending, time.Now().Add(-donateOrderCloseAfter)).
+		Updates(map[string]interface{}{
+			"status":     constant.PaymentStatusClosed,
+			"updated_at": time.Now(),
+		})
+	if result.Error != nil {
+		l.Errorf("Failed to close expired donate orders: %v", result.Error)
+		return
+	}
+	if result.RowsAffected > 0 {
+		l.Infof("Closed %d expired donate orders", result.RowsAffected)
+	}
+}
+
+// closeExpiredOrder 关闭单个超时订单，通过 Redis 锁避免多个实例重复关闭同一订单
+func (l *CloseExpiredOrdersLogic) closeExpiredOrder(order *model.LxtPaymentOrder) bool {
+	if l.svcCtx.Rds != nil {
+		lock := redis.NewRedisLock(l.svcCtx.Rds, redisutil.ReturnRedisKey(redisutil.PaymentOrderCloseLock, order.PaymentID))
+		lock.SetExpire(orderCloseLockSeconds)
+		ok, err := lock.AcquireCtx(l.ctx)
+		if err != nil {
+			l.Errorf("Failed to acquire close lock: paymentId=%s, err=%v", order.PaymentID, err)
+			return false
+		}
+		if !ok {
+			// 其他实例正在处理该订单
+			return false
+		}
+		defer func() {
+			if _, err := lock.ReleaseCtx(l.ctx); err != nil {
+				l.Errorf("Failed to release close lock: paymentId=%s, err=%v", order.PaymentID, err)
+			}
+		}()
+	}
+
+	// 调用支付渠道关闭交易；用户未扫码时渠道侧不存在交易，直接关闭本地订单
+	provider, err := l.paymentProvider(order.PayType)
+	if err != nil {
+		l.Errorf("Unsupported pay type for expired order: paymentId=%s, payType=%d", order.PaymentID, order.PayType)
+		return false
+	}
+	err = provider.ClosePayment(l.ctx, order.OutTradeNo)
+	if err != nil && !errors.Is(err, payprovider.ErrTradeNotExist) {
+		// 交易可能已支付或支付渠道暂时不可用，等待异步通知或下一轮重试
+		l.Errorf("Failed to close %s trade: paymentId=%s, outTradeNo=%s, err=%v", provider.Name(), order.PaymentID, order.OutTradeNo, err)
+		return false
+	}
+
+	// 状态机仅允许关闭待支付订单，不会覆盖期间到达的支付成功通知
+	ok, err := l.transitionOrder(&orderstate.Transition{
+		PaymentId: order.PaymentID,
+		To:        constant.PaymentStatusClosed,
+		Event:     constant.OrderEventExpired,
+		Remark:    "超时未支付",
+		Updates:   map[string]interface{}{"close_time": time.Now()},
+	})
+	if errors.Is(err, orderstate.ErrInvalidTransition) {
+		l.Infof("Skip closing expired order: paymentId=%s, err=%v", order.PaymentID, err)
+		return false
+	}
+	if err != nil {
+		l.Errorf("Failed to close expired order: paymentId=%s, err=%v", order.PaymentID, err)
+		return false
+	}
+	if !ok {
+		return false
+	}
+
+	// 订单已超时关闭，释放待支付名额
+	l.releaseUserPendingOrder(order.UserID, order.OutTradeNo)
+
+	l.Infof("Closed expired payment order: paymentId=%s, orderSn=%s, outTradeNo=%s, timeout=%s",
+		order.PaymentID, order.OrderSn, order.OutTradeNo, order.Timeout)
+	return true
+}
@@ -2,12 +2,15 @@ package logic
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"lxtian-blog/common/constant"
 	"lxtian-blog/common/repository/payment_repo"
+	"time"
 
 	"lxtian-blog/common/model"
-	"lxtian-blog/common/pkg/alipay"
+	"lxtian-blog/common/pkg/payprovider"
+	"lxtian-blog/rpc/payment/internal/orderstate"
 	"lxtian-blog/rpc/payment/internal/svc"
 	"lxtian-blog/rpc/payment/pb/payment"
 )
@@ -69,33 +72,47 @@ func (l *ClosePaymentLogic) ClosePayment(in *payment.ClosePaymentReq) (*payment.
 			Message: "订单状态不允许关闭",
 		}, fmt.Errorf("order status does not allow close")
 	}
-	// 调用支付宝API关闭订单
-	alipayReq := &alipay.TradeCloseRequest{
-		OutTradeNo: paymentOrder.OutTradeNo,
-	}
-
-	alipayResp, err := l.svcCtx.AlipayClient.ClosePayment(alipayReq)
+	// 按订单的支付方式调用支付渠道关闭交易；用户未扫码时渠道侧不存在交易，直接关闭本地订单
+	provider, err := l.paymentProvider(paymentOrder.PayType)
 	if err != nil {
-		l.Errorf("Failed to close alipay payment: %v", err)
+		return &payment.ClosePaymentResp{
+			Success: false,
+			Message: "不支持的支付方式",
+		}, err
+	}
+	err = provider.ClosePayment(l.ctx, paymentOrder.OutTradeNo)
+	if err != nil && !errors.Is(err, payprovider.ErrTradeNotExist) {
+		l.Errorf("Failed to close %s payment: %v", provider.Name(), err)
 		return &payment.ClosePaymentResp{
 			Success: false,
 			Message: "关闭支付订单失败",
-		}, fmt.Errorf("failed to close alipay payment: %w", err)
+		}, fmt.Errorf("failed to close %s payment: %w", provider.Name(), err)
 	}
 
-	// 更新本地订单状态
-	err = l.paymentService.UpdateStatus(l.ctx, paymentOrder.PaymentID, constant.PaymentStatusClosed)
+	// 更新本地订单状态，期间订单已支付时状态机拒绝关闭
+	_, err = l.transitionOrder(&orderstate.Transition{
+		PaymentId: paymentOrder.PaymentID,
+		To:        constant.PaymentStatusClosed,
+		Event:     constant.OrderEventUserClose,
+		Updates:   map[string]interface{}{"close_time": time.Now()},
+	})
+	if errors.Is(err, orderstate.ErrInvalidTransition) {
+		return &payment.ClosePaymentResp{
+			Success: false,
+			Message: "订单状态不允许关闭",
+		}, err
+	}
 	if err != nil {
 		l.Errorf("Failed to update payment status: %v", err)
-		// 即使本地更新失败，支付宝那边已经关闭了，所以仍然返回成功
+		// 即使本地更新失败，支付渠道那边已经关闭了，所以仍然返回成功
 	}
 
-	// 用户主动关闭订单，计数减 1（不再视为待支付）
-	adjustUserDailyPendingCount(l.ctx, l.svcCtx.Rds, paymentOrder.UserID, paymentOrder.CreatedAt, -1)
+	// 用户主动关闭订单，释放待支付名额
+	l.releaseUserPendingOrder(paymentOrder.UserID, paymentOrder.OutTradeNo)
 
 	// 记录日志
 	l.Infof("Closed payment order: paymentId=%s, orderSn=%s, outTradeNo=%s",
-		paymentOrder.PaymentID, paymentOrder.OrderSn, alipayResp.OutTradeNo)
+		paymentOrder.PaymentID, paymentOrder.OrderSn, paymentOrder.OutTradeNo)
 
 	return &payment.ClosePaymentResp{
 		Success: true,
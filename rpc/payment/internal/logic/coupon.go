@@ -0,0 +1,133 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"lxtian-blog/common/constant"
+	"lxtian-blog/common/model"
+	"lxtian-blog/common/repository/payment_repo"
+	"lxtian-blog/rpc/payment/internal/svc"
+
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+// normalizeCouponCode 优惠码不区分大小写，统一按大写保存和查询
+func normalizeCouponCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// applyCoupon 校验优惠码并在价格上扣减优惠金额，在会员升级抵扣之后计算
+func applyCoupon(ctx context.Context, svcCtx *svc.ServiceContext, code string, userId, buyType int64, price *orderPrice) (*model.LxtPaymentCoupon, error) {
+	coupon, err := payment_repo.NewLxtPaymentCouponsRepo(svcCtx.DB).GetByCode(ctx, normalizeCouponCode(code))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("优惠码不存在")
+		}
+		return nil, fmt.Errorf("查询优惠码失败: %w", err)
+	}
+	if err := checkCouponUsable(ctx, svcCtx, coupon, userId); err != nil {
+		return nil, err
+	}
+	discount, err := couponDiscount(coupon, buyType, price)
+	if err != nil {
+		return nil, err
+	}
+
+	price.CouponCode = coupon.Code
+	price.CouponDiscount = discount
+	price.Amount = price.Amount.Sub(discount)
+	return coupon, nil
+}
+
+// checkCouponUsable 校验优惠券状态、有效期、首单限制和使用次数上限
+// 锁定中的使用记录同样占用次数，避免并发下单超出上限；在事务中锁定优惠券后需再次校验
+func checkCouponUsable(ctx context.Context, svcCtx *svc.ServiceContext, coupon *model.LxtPaymentCoupon, userId int64) error {
+	if coupon.Status != constant.CouponStatusEnabled {
+		return fmt.Errorf("优惠码已停用")
+	}
+	now := time.Now()
+	if coupon.StartTime != nil && now.Before(*coupon.StartTime) {
+		return fmt.Errorf("优惠码尚未生效")
+	}
+	if coupon.EndTime != nil && now.After(*coupon.EndTime) {
+		return fmt.Errorf("优惠码已过期")
+	}
+
+	if coupon.FirstPurchaseOnly == 1 {
+		paid, err := payment_repo.NewPaymentOrderRepository(svcCtx.DB).GetPaidCountByUserId(ctx, userId,
+			[]int32{constant.BuyTypeMembership, constant.BuyTypeGoods})
+		if err != nil {
+			return fmt.Errorf("查询用户订单失败: %w", err)
+		}
+		if paid > 0 {
+			return fmt.Errorf("该优惠码仅限首次购买使用")
+		}
+	}
+
+	usageRepo := payment_repo.NewLxtPaymentCouponUsagesRepo(svcCtx.DB)
+	if coupon.TotalLimit > 0 {
+		used, err := usageRepo.CountActive(ctx, coupon.ID, 0)
+		if err != nil {
+			return err
+		}
+		if used >= int64(coupon.TotalLimit) {
+			return fmt.Errorf("优惠码已被领完")
+		}
+	}
+	if coupon.PerUserLimit > 0 {
+		used, err := usageRepo.CountActive(ctx, coupon.ID, userId)
+		if err != nil {
+			return err
+		}
+		if used >= int64(coupon.PerUserLimit) {
+			return fmt.Errorf("优惠码使用次数已达上限")
+		}
+	}
+	return nil
+}
+
+// couponDiscount 校验优惠券适用范围和最低金额，计算优惠金额，优惠后至少支付 0.01 元
+func couponDiscount(coupon *model.LxtPaymentCoupon, buyType int64, price *orderPrice) (decimal.Decimal, error) {
+	if buyType != constant.BuyTypeMembership && buyType != constant.BuyTypeGoods {
+		return decimal.Zero, fmt.Errorf("该订单不支持使用优惠码")
+	}
+	if coupon.BuyType != 0 && int64(coupon.BuyType) != buyType {
+		return decimal.Zero, fmt.Errorf("优惠码不适用于该订单")
+	}
+	if buyType == constant.BuyTypeMembership && coupon.MembershipTypeID > 0 && coupon.MembershipTypeID != price.ItemId {
+		return decimal.Zero, fmt.Errorf("优惠码不适用于该会员类型")
+	}
+	if buyType == constant.BuyTypeGoods && coupon.ClassifyID > 0 && coupon.ClassifyID != price.ClassifyId {
+		return decimal.Zero, fmt.Errorf("优惠码不适用于该商品")
+	}
+	if coupon.MinAmount.IsPositive() && price.Amount.LessThan(coupon.MinAmount) {
+		return decimal.Zero, fmt.Errorf("订单满%s元可用", coupon.MinAmount.StringFixed(2))
+	}
+
+	var discount decimal.Decimal
+	switch coupon.Type {
+	case constant.CouponTypePercent:
+		discount = price.Amount.Mul(coupon.Value).Div(decimal.NewFromInt(100)).Round(2)
+		if coupon.MaxDiscount.IsPositive() && discount.GreaterThan(coupon.MaxDiscount) {
+			discount = coupon.MaxDiscount
+		}
+	case constant.CouponTypeFixed:
+		discount = coupon.Value
+	default:
+		return decimal.Zero, fmt.Errorf("不支持的优惠类型: %s", coupon.Type)
+	}
+
+	minAmount := decimal.New(1, -2)
+	if discount.GreaterThan(price.Amount.Sub(minAmount)) {
+		discount = price.Amount.Sub(minAmount)
+	}
+	if !discount.IsPositive() {
+		return decimal.Zero, fmt.Errorf("优惠码不适用于该订单")
+	}
+	return discount, nil
+}
@@ -3,17 +3,15 @@ package logic
 import (
 	"context"
 	"fmt"
-	"github.com/leiphp/unit-go-sdk/pkg/gconv"
 	"lxtian-blog/common/constant"
 	"lxtian-blog/common/model"
-	"lxtian-blog/common/pkg/alipay"
-	redisutil "lxtian-blog/common/pkg/redis"
+	"lxtian-blog/common/pkg/payprovider"
 	"lxtian-blog/common/pkg/utils"
-	"lxtian-blog/common/repository/user_repo"
+	"lxtian-blog/common/repository/payment_repo"
+	"lxtian-blog/rpc/payment/internal/orderstate"
 	"lxtian-blog/rpc/payment/internal/svc"
 	"lxtian-blog/rpc/payment/pb/payment"
 	"strconv"
-	"time"
 )
 
 type CreatePaymentLogic struct {
@@ -27,10 +25,18 @@ func NewCreatePaymentLogic(ctx context.Context, svcCtx *svc.ServiceContext) *Cre
 }
 
 func (l *CreatePaymentLogic) CreatePayment(in *payment.CreatePaymentReq) (*payment.CreatePaymentResp, error) {
+	// 携带幂等键的重试直接返回首次创建的订单，避免重复下单
+	return runIdempotent(l.BaseLogic, "CreatePayment", in.IdempotencyKey, in, func() (*payment.CreatePaymentResp, error) {
+		return l.createPayment(in)
+	})
+}
+
+func (l *CreatePaymentLogic) createPayment(in *payment.CreatePaymentReq) (*payment.CreatePaymentResp, error) {
 	var err error
 	// 参数验证
-	if in.Amount <= 0 {
-		return nil, fmt.Errorf("支付金额必须大于0")
+	requestAmount, err := utils.ParseAmount(in.Amount)
+	if err != nil {
+		return nil, fmt.Errorf("支付金额不正确: %w", err)
 	}
 
 	if in.Subject == "" {
@@ -41,42 +47,6 @@ func (l *CreatePaymentLogic) CreatePayment(in *payment.CreatePaymentReq) (*payme
 		return nil, fmt.Errorf("用户ID不能为空")
 	}
 
-	// 使用 Redis 控制每日「待支付订单」上限：
-	// 每个用户每天最多允许 3 个“仍处于待支付状态”的订单。
-	// 这里只是预检查当前计数，真正的 +1 放在订单创建成功之后。
-	if l.svcCtx.Rds != nil {
-		today := time.Now()
-		dateStr := today.Format("2006-01-02")
-		dailyKey := fmt.Sprintf("%spayment:pending:count:%d:%s", redisutil.KeyPrefix, in.UserId, dateStr)
-
-		var current int64
-		if val, err := l.svcCtx.Rds.GetCtx(l.ctx, dailyKey); err == nil && val != "" {
-			if parsed, perr := strconv.ParseInt(val, 10, 64); perr == nil {
-				current = parsed
-			}
-		}
-		if current >= 3 {
-			return nil, fmt.Errorf("今日待支付订单数量已达上限（3个），请处理现有订单后再创建")
-		}
-	}
-
-	// 检查用户是否购买会员
-	if in.VipId > 0 {
-		// 查询并处理会员信息（优先从 Redis 获取，未命中再查 DB）
-		membershipRepo := user_repo.NewUserMembershipRepository(l.svcCtx.DB, l.svcCtx.Rds)
-		membershipInfo, err := membershipRepo.GetActiveMembershipByUserId(l.ctx, int64(in.UserId))
-		if err != nil {
-			l.Errorf("获取用户会员信息失败: %v", err)
-			// 会员信息查询失败不影响用户信息返回，继续执行
-			membershipInfo = nil
-		}
-		if membershipInfo != nil && gconv.Bool(membershipInfo["is_valid"]) {
-			if in.VipId < gconv.Int64(membershipInfo["type_id"]) {
-				return nil, fmt.Errorf("会员只允许升级和续费，不能降级")
-			}
-		}
-	}
-
 	// 生成订单ID、支付ID和商户订单号
 	paymentId := l.generatePaymentId()
 	outTradeNo := fmt.Sprintf("NO%d", utils.Snowflake())
@@ -90,33 +60,69 @@ func (l *CreatePaymentLogic) CreatePayment(in *payment.CreatePaymentReq) (*payme
 	if in.Timeout == "" {
 		in.Timeout = "30m"
 	}
-	// 设置默认值（需要重新生成protobuf后启用）
 	if in.PayType == 0 {
-		in.PayType = 1 // 默认直接消费
+		in.PayType = constant.PayTypeAlipay // 默认支付宝
+	}
+	// 按支付方式选择支付渠道，未启用的支付方式不创建订单
+	provider, err := l.paymentProvider(int32(in.PayType))
+	if err != nil {
+		return nil, fmt.Errorf("不支持的支付方式: %d", in.PayType)
 	}
 	// 设置默认值（需要重新生成protobuf后启用）
 	if in.BuyType == 0 {
-		in.PayType = 3 // 默认直接消费
+		in.BuyType = constant.BuyTypeGoods // 默认商城消费
 	}
 	if in.Quantity == 0 {
 		in.Quantity = 1 // 默认数量为1
 	}
 
+	// 1. 服务端根据商品/会员类型计算应付金额，不信任客户端传入的金额
+	price, err := calculateOrderPrice(l.ctx, l.svcCtx, in)
+	if err != nil {
+		return nil, err
+	}
+	// 优惠码在升级抵扣之后计算，客户端金额须与优惠后金额一致
+	var coupon *model.LxtPaymentCoupon
+	if in.CouponCode != "" {
+		if coupon, err = applyCoupon(l.ctx, l.svcCtx, in.CouponCode, int64(in.UserId), in.BuyType, price); err != nil {
+			return nil, err
+		}
+	}
+	if !requestAmount.Equal(price.Amount) {
+		l.Errorf("支付金额不一致: userId=%d, buyType=%d, requestAmount=%s, expectedAmount=%s",
+			in.UserId, in.BuyType, utils.FormatAmount(requestAmount), utils.FormatAmount(price.Amount))
+		return nil, fmt.Errorf("支付金额不正确，应付金额为%s元", utils.FormatAmount(price.Amount))
+	}
+	if in.BuyType == constant.BuyTypeMembership {
+		// 会员订单须与用户确认过的报价一致
+		if err := l.verifyMembershipQuote(in.QuoteId, int64(in.UserId), price); err != nil {
+			return nil, err
+		}
+		// 会员订单统一以 vip_id 记录会员类型
+		in.VipId = price.ItemId
+		in.GoodsId = price.ItemId
+	}
+
 	// 2. 创建支付订单记录（lxt_payment_orders表）
 	paymentOrder := &model.LxtPaymentOrder{
-		GoodsID:     int32(in.GoodsId),
-		Quantity:    int32(in.Quantity),
-		VipID:       int32(in.VipId),
-		PaymentID:   paymentId,
-		OrderSn:     orderSn,
-		OutTradeNo:  outTradeNo,
-		UserID:      int64(in.UserId),
-		Amount:      in.Amount,
-		Subject:     in.Subject,
-		Status:      constant.PaymentStatusPending,
-		ProductCode: &in.ProductCode,
-		PayType:     int32(in.PayType),
-		BuyType:     int32(in.BuyType),
+		GoodsID:        int32(in.GoodsId),
+		Quantity:       int32(in.Quantity),
+		VipID:          int32(in.VipId),
+		PaymentID:      paymentId,
+		OrderSn:        orderSn,
+		OutTradeNo:     outTradeNo,
+		UserID:         int64(in.UserId),
+		Amount:         price.Amount,
+		UnitPrice:      price.UnitPrice,
+		DiscountAmount: price.Discount,
+		CouponCode:     price.CouponCode,
+		CouponDiscount: price.CouponDiscount,
+		PriceSnapshot:  price.toSnapshot(in.BuyType),
+		Subject:        in.Subject,
+		Status:         constant.PaymentStatusPending,
+		ProductCode:    &in.ProductCode,
+		PayType:        int32(in.PayType),
+		BuyType:        int32(in.BuyType),
 		// 处理可空字段
 		Body:      &in.Body,
 		ReturnURL: in.ReturnUrl,
@@ -126,37 +132,26 @@ func (l *CreatePaymentLogic) CreatePayment(in *payment.CreatePaymentReq) (*payme
 		Remark:    in.Remark,
 	}
 
-	// 使用GORM保存支付订单到数据库
-	err = l.svcCtx.DB.WithContext(l.ctx).Create(paymentOrder).Error
+	// 预占待支付订单名额，检查与占用在 Redis 中原子完成，避免并发请求突破上限
+	if !l.reserveUserPendingOrder(paymentOrder.UserID, outTradeNo) {
+		return nil, fmt.Errorf("待支付订单数量已达上限（%d个），请处理现有订单后再创建", l.svcCtx.Config.PendingLimit.Limit)
+	}
+
+	// 保存支付订单，使用优惠码时在同一事务中锁定优惠券并写入使用记录
+	if coupon != nil {
+		err = l.createOrderWithCoupon(paymentOrder, coupon)
+	} else {
+		err = l.svcCtx.DB.WithContext(l.ctx).Create(paymentOrder).Error
+	}
 	if err != nil {
 		l.Errorf("Failed to insert payment_repo order: %v", err)
+		l.releaseUserPendingOrder(paymentOrder.UserID, outTradeNo)
 		return nil, fmt.Errorf("创建支付订单失败: %w", err)
 	}
 
-	// 订单已成功写入数据库，此时将「待支付订单计数」+1
-	if l.svcCtx.Rds != nil {
-		today := paymentOrder.CreatedAt
-		dateStr := today.Format("2006-01-02")
-		dailyKey := fmt.Sprintf("%spayment:pending:count:%d:%s", redisutil.KeyPrefix, paymentOrder.UserID, dateStr)
-
-		dailyCount, rerr := l.svcCtx.Rds.IncrCtx(l.ctx, dailyKey)
-		if rerr != nil {
-			l.Errorf("failed to incr daily pending order count after create, key=%s, err=%v", dailyKey, rerr)
-		} else if dailyCount == 1 {
-			// 首次创建时，设置 key 在当天 23:59:59 过期
-			endOfDay := time.Date(today.Year(), today.Month(), today.Day(), 23, 59, 59, 0, today.Location())
-			ttl := int(endOfDay.Sub(time.Now()).Seconds())
-			if ttl > 0 {
-				if err := l.svcCtx.Rds.ExpireCtx(l.ctx, dailyKey, ttl); err != nil {
-					l.Errorf("failed to set expire for key=%s, err=%v", dailyKey, err)
-				}
-			}
-		}
-	}
-
-	// 3. 调用支付宝API创建支付订单
+	// 3. 调用支付渠道创建支付
 	// 金额转换为字符串，保留2位小数
-	amountStr := strconv.FormatFloat(in.Amount, 'f', 2, 64)
+	amountStr := utils.FormatAmount(price.Amount)
 
 	// 超时时间格式：30m, 1h, 1d 等，默认30m
 	timeout := in.Timeout
@@ -164,30 +159,39 @@ func (l *CreatePaymentLogic) CreatePayment(in *payment.CreatePaymentReq) (*payme
 		timeout = "30m"
 	}
 
-	alipayReq := &alipay.TradeCreateRequest{
+	// 支付宝返回收银台地址，微信支付返回二维码链接
+	payUrl, err := provider.CreatePayment(l.ctx, &payprovider.CreateRequest{
 		OutTradeNo:  outTradeNo,
-		TotalAmount: amountStr,
+		Amount:      amountStr,
 		Subject:     in.Subject,
 		Body:        in.Body,
 		ProductCode: in.ProductCode,
 		Timeout:     timeout,
 		ReturnUrl:   in.ReturnUrl,
-	}
-
-	// 调用支付宝API创建支付URL
-	payUrl, err := l.svcCtx.AlipayClient.CreatePayment(alipayReq)
+		ClientIp:    in.ClientIp,
+	})
 	if err != nil {
-		l.Errorf("Failed to create alipay payment_repo: %v", err)
-		// 使用GORM更新订单状态为失败
-		l.svcCtx.DB.WithContext(l.ctx).Model(&model.LxtPaymentOrder{}).
-			Where("payment_id = ?", paymentId).
-			Update("status", constant.VerifyStatusFailed)
+		l.Errorf("Failed to create %s payment: %v", provider.Name(), err)
+		// 支付渠道下单失败，订单置为失败
+		if _, terr := l.transitionOrder(&orderstate.Transition{
+			PaymentId: paymentId,
+			To:        constant.PaymentStatusFAILED,
+			Event:     constant.OrderEventCreateFailed,
+			Remark:    err.Error(),
+		}); terr != nil {
+			l.Errorf("Failed to mark payment order failed: paymentId=%s, err=%v", paymentId, terr)
+		}
+		l.releaseUserPendingOrder(paymentOrder.UserID, outTradeNo)
 		return nil, fmt.Errorf("创建支付订单失败: %w", err)
 	}
 
+	if in.BuyType == constant.BuyTypeMembership {
+		l.deleteMembershipQuote(in.QuoteId)
+	}
+
 	// 记录日志
-	l.Infof("Created payment_repo order: paymentId=%s, orderSn=%s, outTradeNo=%s, amount=%.2f, payUrl=%s",
-		paymentId, orderSn, outTradeNo, in.Amount, payUrl)
+	l.Infof("Created payment_repo order: paymentId=%s, orderSn=%s, outTradeNo=%s, amount=%s, payUrl=%s",
+		paymentId, orderSn, outTradeNo, amountStr, payUrl)
 
 	return &payment.CreatePaymentResp{
 		PaymentId:  paymentId,
@@ -197,6 +201,34 @@ func (l *CreatePaymentLogic) CreatePayment(in *payment.CreatePaymentReq) (*payme
 	}, nil
 }
 
+// createOrderWithCoupon 锁定优惠券后再次校验使用次数，与订单一同写入锁定状态的使用记录
+// 同一优惠券的下单在行锁上串行执行，并发请求不会超出使用上限
+func (l *CreatePaymentLogic) createOrderWithCoupon(order *model.LxtPaymentOrder, coupon *model.LxtPaymentCoupon) error {
+	couponRepo := payment_repo.NewLxtPaymentCouponsRepo(l.svcCtx.DB)
+	usageRepo := payment_repo.NewLxtPaymentCouponUsagesRepo(l.svcCtx.DB)
+	orderRepo := payment_repo.NewPaymentOrderRepository(l.svcCtx.DB)
+	return couponRepo.WithTransaction(l.ctx, func(txCtx context.Context) error {
+		locked, err := couponRepo.LockByCode(txCtx, coupon.Code)
+		if err != nil {
+			return fmt.Errorf("lock coupon failed: %w", err)
+		}
+		if err := checkCouponUsable(txCtx, l.svcCtx, locked, order.UserID); err != nil {
+			return err
+		}
+		if err := orderRepo.CreateOrder(txCtx, order); err != nil {
+			return err
+		}
+		return usageRepo.CreateUsage(txCtx, &model.LxtPaymentCouponUsage{
+			CouponID:       locked.ID,
+			Code:           locked.Code,
+			UserID:         order.UserID,
+			PaymentID:      order.PaymentID,
+			DiscountAmount: order.CouponDiscount,
+			Status:         constant.CouponUsageLocked,
+		})
+	})
+}
+
 // 验证超时时间格式是否有效
 func isValidTimeout(timeout string) bool {
 	// 支持的格式：30m, 1h, 1d, 1c（c表示天）
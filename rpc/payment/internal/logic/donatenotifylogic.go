@@ -3,6 +3,7 @@ package logic
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"lxtian-blog/common/constant"
 	"lxtian-blog/common/model"
@@ -14,6 +15,8 @@ import (
 	"net/url"
 	"strings"
 	"time"
+
+	"gorm.io/gorm"
 )
 
 type DonateNotifyLogic struct {
@@ -120,21 +123,8 @@ func (l *DonateNotifyLogic) DonateNotify(in *payment.DonateNotifyReq) (*payment.
 
 // 验证签名
 func (l *DonateNotifyLogic) verifySign(data, sign string) error {
-	if strings.TrimSpace(sign) == "" {
-		return fmt.Errorf("sign is empty")
-	}
-
-	// 构建待验签内容
-	signContent, err := buildSignContent(data)
-	if err != nil {
-		return fmt.Errorf("failed to build sign content: %w", err)
-	}
-	if signContent == "" {
-		return fmt.Errorf("sign content is empty")
-	}
-
 	// 使用支付宝客户端验证签名
-	err = l.svcCtx.AlipayClient.VerifySign(signContent, sign)
+	err := l.svcCtx.AlipayClient.VerifyNotify(data, sign)
 	if err != nil {
 		l.Errorf("Alipay sign verification failed: %v", err)
 		return fmt.Errorf("alipay sign verification failed: %w", err)
@@ -191,8 +181,8 @@ func (l *DonateNotifyLogic) processNotify(notifyData map[string]string, notifyId
 	outTradeNo := notifyData["out_trade_no"]
 	tradeStatus := notifyData["trade_status"]
 
-	// 从Redis获取支付订单
-	txyOrder, err := l.getTxyOrderFromRedis(outTradeNo)
+	// 查询捐赠订单
+	txyOrder, err := l.findTxyOrder(outTradeNo)
 	if err != nil {
 		return fmt.Errorf("payment order not found: %w", err)
 	}
@@ -209,26 +199,46 @@ func (l *DonateNotifyLogic) processNotify(notifyData map[string]string, notifyId
 	// 根据交易状态处理
 	switch tradeStatus {
 	case constant.TradeStatusSuccess, constant.TradeStatusFinished:
-		// 支付成功，插入订单状态为已支付
-		txyOrder.Status = constant.PaymentStatusPaid
-		txyOrder.UpdatedAt = time.Now()
+		// 支付成功，已关闭的订单也以支付结果为准；重复通知时订单已是已支付，不会重复更新
+		if txyOrder.Status == constant.PaymentStatusPaid {
+			return nil
+		}
 
-		// 使用GORM保存支付订单到数据库
-		err = l.svcCtx.DB.WithContext(l.ctx).Save(txyOrder).Error
+		updates := map[string]interface{}{
+			"status":     constant.PaymentStatusPaid,
+			"trade_no":   notifyData["trade_no"],
+			"updated_at": time.Now(),
+		}
+		if notifyData["gmt_payment"] != "" {
+			if t, err := time.ParseInLocation("2006-01-02 15:04:05", notifyData["gmt_payment"], time.Local); err == nil {
+				updates["pay_time"] = t
+			} else {
+				l.Errorf("Failed to parse gmt_payment '%s': %v", notifyData["gmt_payment"], err)
+			}
+		}
+		err = l.svcCtx.DB.WithContext(l.ctx).Model(&model.TxyOrder{}).
+			Where("payment_id = ? AND status <> ?", txyOrder.PaymentID, constant.PaymentStatusPaid).
+			Updates(updates).Error
 		if err != nil {
 			l.Errorf("Failed to update web_repo order: %v", err)
 			return fmt.Errorf("更新捐赠支付订单失败: %w", err)
 		}
+
+		// 订单已支付，不再计入待支付订单数量
+		l.removeTxyOrderFromRedis(outTradeNo)
+		l.releaseDonatePendingOrder(int64(txyOrder.UserID), txyOrder.IP, outTradeNo)
 		return nil
 
 	case constant.TradeStatusClosed:
 		// 交易关闭
-		if txyOrder.Status != constant.PaymentStatusClosed {
+		if txyOrder.Status == constant.PaymentStatusPending {
 			err = l.webService.UpdateStatus(l.ctx, txyOrder.PaymentID, constant.PaymentStatusClosed)
 			if err != nil {
 				return fmt.Errorf("failed to update status to closed: %w", err)
 			}
 		}
+		l.removeTxyOrderFromRedis(outTradeNo)
+		l.releaseDonatePendingOrder(int64(txyOrder.UserID), txyOrder.IP, outTradeNo)
 
 	case constant.TradeStatusWaitBuyerPay:
 		// 等待买家付款，不需要特殊处理
@@ -241,6 +251,39 @@ func (l *DonateNotifyLogic) processNotify(notifyData map[string]string, notifyId
 	return nil
 }
 
+// findTxyOrder 查询捐赠订单，数据库中不存在时从Redis读取待支付订单并补录入库
+func (l *DonateNotifyLogic) findTxyOrder(outTradeNo string) (*model.TxyOrder, error) {
+	var txyOrder model.TxyOrder
+	err := l.svcCtx.DB.WithContext(l.ctx).Where("out_trade_no = ?", outTradeNo).First(&txyOrder).Error
+	if err == nil {
+		return &txyOrder, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	redisOrder, err := l.getTxyOrderFromRedis(outTradeNo)
+	if err != nil {
+		return nil, err
+	}
+	redisOrder.ID = 0
+	if err := l.svcCtx.DB.WithContext(l.ctx).Create(redisOrder).Error; err != nil {
+		return nil, fmt.Errorf("failed to insert donate order: %w", err)
+	}
+	return redisOrder, nil
+}
+
+// removeTxyOrderFromRedis 删除Redis中的待支付订单
+func (l *DonateNotifyLogic) removeTxyOrderFromRedis(outTradeNo string) {
+	if l.svcCtx.Rds == nil {
+		return
+	}
+	redisKey := redisutil.ReturnRedisKey(redisutil.DonatePendingOrderString, outTradeNo)
+	if _, err := l.svcCtx.Rds.DelCtx(l.ctx, redisKey); err != nil {
+		l.Errorf("Failed to delete donate order from redis: outTradeNo=%s, err=%v", outTradeNo, err)
+	}
+}
+
 // getTxyOrderFromRedis 从Redis获取支付订单
 func (l *DonateNotifyLogic) getTxyOrderFromRedis(outTradeNo string) (*model.TxyOrder, error) {
 	if l.svcCtx.Rds == nil {
@@ -0,0 +1,140 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"lxtian-blog/common/constant"
+	"lxtian-blog/common/model"
+	"lxtian-blog/common/repository/payment_repo"
+	"lxtian-blog/rpc/payment/internal/svc"
+	"lxtian-blog/rpc/payment/pb/payment"
+
+	"gorm.io/gorm"
+)
+
+type GoodsDownloadLogic struct {
+	*BaseLogic
+	goodsRepo    payment_repo.LxtPaymentGoodsRepo
+	orderRepo    payment_repo.PaymentOrderRepository
+	downloadRepo payment_repo.LxtPaymentGoodsDownloadsRepo
+}
+
+func NewGoodsDownloadLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GoodsDownloadLogic {
+	return &GoodsDownloadLogic{
+		BaseLogic:    NewBaseLogic(ctx, svcCtx),
+		goodsRepo:    payment_repo.NewLxtPaymentGoodsRepo(svcCtx.DB),
+		orderRepo:    payment_repo.NewPaymentOrderRepository(svcCtx.DB),
+		downloadRepo: payment_repo.NewLxtPaymentGoodsDownloadsRepo(svcCtx.DB),
+	}
+}
+
+// GoodsDownload 为已购买商品的用户签发限时下载地址
+// 校验已支付订单、扣减下载次数和累加商品下载量在同一事务中完成，同一用户的并发下载在订单行锁上串行执行
+func (l *GoodsDownloadLogic) GoodsDownload(in *payment.GoodsDownloadReq) (*payment.GoodsDownloadResp, error) {
+	if in.UserId == 0 {
+		return nil, fmt.Errorf("用户ID不能为空")
+	}
+	if in.GoodsId <= 0 {
+		return nil, fmt.Errorf("商品ID不能为空")
+	}
+
+	goods, err := l.goodsRepo.GetById(l.ctx, in.GoodsId)
+	if err != nil {
+		l.Errorf("Failed to get goods: goodsId=%d, err=%v", in.GoodsId, err)
+		return nil, fmt.Errorf("商品不存在")
+	}
+	if goods.FileKey == nil || *goods.FileKey == "" {
+		return nil, fmt.Errorf("该商品暂无可下载的文件")
+	}
+	// 先确认存储已配置，避免扣减了下载次数却签发不了链接
+	if err := l.checkStorage(goods.FileStorage); err != nil {
+		l.Errorf("Goods file storage unavailable: goodsId=%d, storage=%s, err=%v", goods.ID, goods.FileStorage, err)
+		return nil, err
+	}
+
+	userId := int64(in.UserId)
+	limit := goods.DownloadLimit
+	if limit == 0 {
+		limit = l.svcCtx.Config.GoodsDelivery.DownloadLimit
+	}
+	if limit < 0 {
+		limit = 0
+	}
+
+	var count int64
+	err = l.goodsRepo.WithTransaction(l.ctx, func(txCtx context.Context) error {
+		order, err := l.orderRepo.LockPaidGoodsOrder(txCtx, userId, goods.ID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return fmt.Errorf("您尚未购买该商品")
+			}
+			return fmt.Errorf("lock paid goods order failed: %w", err)
+		}
+
+		if count, err = l.downloadRepo.CountByUserGoods(txCtx, userId, goods.ID); err != nil {
+			return fmt.Errorf("count goods downloads failed: %w", err)
+		}
+		if limit > 0 && count >= int64(limit) {
+			return fmt.Errorf("下载次数已用完（共%d次）", limit)
+		}
+
+		if err := l.downloadRepo.CreateDownload(txCtx, &model.LxtPaymentGoodsDownload{
+			UserID:    userId,
+			GoodsID:   goods.ID,
+			PaymentID: order.PaymentID,
+			ClientIP:  in.ClientIp,
+		}); err != nil {
+			return fmt.Errorf("create goods download failed: %w", err)
+		}
+		count++
+		return l.goodsRepo.IncrDownloads(txCtx, goods.ID)
+	})
+	if err != nil {
+		l.Errorf("Failed to download goods: userId=%d, goodsId=%d, err=%v", userId, goods.ID, err)
+		return nil, err
+	}
+
+	expire := l.svcCtx.Config.GoodsDelivery.UrlExpire
+	if expire <= 0 {
+		expire = 300
+	}
+	expireAt := time.Now().Add(time.Duration(expire) * time.Second)
+
+	l.Infof("Signed goods download url: userId=%d, goodsId=%d, storage=%s, count=%d, limit=%d",
+		userId, goods.ID, goods.FileStorage, count, limit)
+
+	return &payment.GoodsDownloadResp{
+		Url:           l.signURL(goods.FileStorage, *goods.FileKey, expire, expireAt),
+		ExpireAt:      expireAt.Format("2006-01-02 15:04:05"),
+		DownloadCount: int32(count),
+		DownloadLimit: limit,
+	}, nil
+}
+
+// checkStorage 校验商品文件存储方式已配置
+func (l *GoodsDownloadLogic) checkStorage(storage string) error {
+	switch storage {
+	case constant.GoodsStorageQiniu:
+		if l.svcCtx.QiniuClient == nil {
+			return fmt.Errorf("商品文件存储未配置")
+		}
+	case constant.GoodsStorageLocal:
+		if l.svcCtx.DownloadSigner == nil || l.svcCtx.Config.GoodsDelivery.LocalBaseUrl == "" {
+			return fmt.Errorf("商品文件存储未配置")
+		}
+	default:
+		return fmt.Errorf("不支持的商品文件存储方式: %s", storage)
+	}
+	return nil
+}
+
+// signURL 按存储方式签发下载地址：七牛文件使用私有空间签名链接，本地文件由网关校验签名后下发
+func (l *GoodsDownloadLogic) signURL(storage, key string, expire int, expireAt time.Time) string {
+	if storage == constant.GoodsStorageQiniu {
+		return l.svcCtx.QiniuClient.PrivateURL(key, int64(expire))
+	}
+	return l.svcCtx.DownloadSigner.SignURL(l.svcCtx.Config.GoodsDelivery.LocalBaseUrl, key, expireAt)
+}
@@ -9,6 +9,7 @@ import (
 	"lxtian-blog/rpc/payment/internal/svc"
 	"lxtian-blog/rpc/payment/pb/payment"
 
+	"github.com/shopspring/decimal"
 	"github.com/zeromicro/go-zero/core/logx"
 )
 
@@ -72,10 +73,10 @@ func (l *GoodsListLogic) GoodsList(in *payment.GoodsListReq) (*payment.GoodsList
 	var filteredGoods []*model.LxtPaymentGood
 	for _, good := range goodsList {
 		// 价格过滤
-		if in.PriceMin > 0 && good.Price < float64(in.PriceMin) {
+		if in.PriceMin > 0 && good.Price.LessThan(decimal.NewFromFloat32(in.PriceMin)) {
 			continue
 		}
-		if in.PriceMax > 0 && good.Price > float64(in.PriceMax) {
+		if in.PriceMax > 0 && good.Price.GreaterThan(decimal.NewFromFloat32(in.PriceMax)) {
 			continue
 		}
 		filteredGoods = append(filteredGoods, good)
@@ -88,10 +89,10 @@ func (l *GoodsListLogic) GoodsList(in *payment.GoodsListReq) (*payment.GoodsList
 		if err == nil {
 			var count int64
 			for _, good := range allGoods {
-				if in.PriceMin > 0 && good.Price < float64(in.PriceMin) {
+				if in.PriceMin > 0 && good.Price.LessThan(decimal.NewFromFloat32(in.PriceMin)) {
 					continue
 				}
-				if in.PriceMax > 0 && good.Price > float64(in.PriceMax) {
+				if in.PriceMax > 0 && good.Price.GreaterThan(decimal.NewFromFloat32(in.PriceMax)) {
 					continue
 				}
 				count++
@@ -132,6 +133,7 @@ func (l *GoodsListLogic) buildGoodsItem(good *model.LxtPaymentGood) map[string]i
 		"price":          good.Price,
 		"original_price": good.OriginalPrice,
 		"rating":         good.Rating,
+		"review_count":   good.ReviewCount,
 		"sales":          good.Sales,
 		"downloads":      good.Downloads,
 		"size":           good.Size,
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"lxtian-blog/common/constant"
 	"lxtian-blog/common/model"
 	"lxtian-blog/common/repository/payment_repo"
 
@@ -17,15 +18,17 @@ type GoodsLogic struct {
 	ctx    context.Context
 	svcCtx *svc.ServiceContext
 	logx.Logger
-	goodsService payment_repo.LxtPaymentGoodsRepo
+	goodsService  payment_repo.LxtPaymentGoodsRepo
+	reviewService payment_repo.LxtPaymentGoodsReviewsRepo
 }
 
 func NewGoodsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GoodsLogic {
 	return &GoodsLogic{
-		ctx:          ctx,
-		svcCtx:       svcCtx,
-		Logger:       logx.WithContext(ctx),
-		goodsService: payment_repo.NewLxtPaymentGoodsRepo(svcCtx.DB),
+		ctx:           ctx,
+		svcCtx:        svcCtx,
+		Logger:        logx.WithContext(ctx),
+		goodsService:  payment_repo.NewLxtPaymentGoodsRepo(svcCtx.DB),
+		reviewService: payment_repo.NewLxtPaymentGoodsReviewsRepo(svcCtx.DB),
 	}
 }
 
@@ -47,6 +50,14 @@ func (l *GoodsLogic) Goods(in *payment.GoodsReq) (*payment.GoodsResp, error) {
 	// 构建响应数据
 	goodItem := l.buildGoodsItem(goods)
 
+	// 附带展示中的评价列表
+	reviews, err := l.buildReviews(goods.ID, int(in.ReviewPage), int(in.ReviewPageSize))
+	if err != nil {
+		l.Errorf("Failed to get goods reviews: goodsId=%d, err=%v", goods.ID, err)
+		return nil, fmt.Errorf("failed to get goods reviews: %w", err)
+	}
+	goodItem["reviews"] = reviews
+
 	// 转换为JSON字符串
 	jsonData, err := json.Marshal(goodItem)
 	if err != nil {
@@ -69,6 +80,7 @@ func (l *GoodsLogic) buildGoodsItem(good *model.LxtPaymentGood) map[string]inter
 		"price":          good.Price,
 		"original_price": good.OriginalPrice,
 		"rating":         good.Rating,
+		"review_count":   good.ReviewCount,
 		"sales":          good.Sales,
 		"downloads":      good.Downloads,
 		"size":           good.Size,
@@ -102,3 +114,41 @@ func (l *GoodsLogic) buildGoodsItem(good *model.LxtPaymentGood) map[string]inter
 
 	return item
 }
+
+// buildReviews 分页查询商品展示中的评价
+func (l *GoodsLogic) buildReviews(goodsId int64, page, pageSize int) (map[string]interface{}, error) {
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = 10
+	}
+	if pageSize > 50 {
+		pageSize = 50 // 限制最大每页数量
+	}
+
+	reviews, total, err := l.reviewService.FindByGoods(l.ctx, goodsId, constant.GoodsReviewStatusVisible, page, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]map[string]interface{}, 0, len(reviews))
+	for _, review := range reviews {
+		list = append(list, map[string]interface{}{
+			"id":         review.ID,
+			"user_id":    review.UserID,
+			"nickname":   review.Nickname,
+			"head_img":   review.HeadImg,
+			"rating":     review.Rating,
+			"content":    review.Content,
+			"created_at": review.CreatedAt.Format("2006-01-02 15:04:05"),
+		})
+	}
+
+	return map[string]interface{}{
+		"page":      page,
+		"page_size": pageSize,
+		"total":     total,
+		"list":      list,
+	}, nil
+}
@@ -0,0 +1,102 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"lxtian-blog/common/constant"
+	"lxtian-blog/common/model"
+	"lxtian-blog/common/repository/payment_repo"
+	"lxtian-blog/rpc/payment/internal/svc"
+	"lxtian-blog/rpc/payment/pb/payment"
+
+	"gorm.io/gorm"
+)
+
+// goodsReviewMaxRunes 评价内容最大字数
+const goodsReviewMaxRunes = 500
+
+type GoodsReviewCreateLogic struct {
+	*BaseLogic
+	goodsRepo  payment_repo.LxtPaymentGoodsRepo
+	orderRepo  payment_repo.PaymentOrderRepository
+	reviewRepo payment_repo.LxtPaymentGoodsReviewsRepo
+}
+
+func NewGoodsReviewCreateLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GoodsReviewCreateLogic {
+	return &GoodsReviewCreateLogic{
+		BaseLogic:  NewBaseLogic(ctx, svcCtx),
+		goodsRepo:  payment_repo.NewLxtPaymentGoodsRepo(svcCtx.DB),
+		orderRepo:  payment_repo.NewPaymentOrderRepository(svcCtx.DB),
+		reviewRepo: payment_repo.NewLxtPaymentGoodsReviewsRepo(svcCtx.DB),
+	}
+}
+
+// GoodsReviewCreate 已购买商品的用户发表评价，每个用户对同一商品只能评价一次
+// 评价写入与商品评分调整在同一事务中完成，同一用户的并发提交在订单行锁上串行执行
+func (l *GoodsReviewCreateLogic) GoodsReviewCreate(in *payment.GoodsReviewCreateReq) (*payment.GoodsReviewCreateResp, error) {
+	if in.UserId == 0 {
+		return nil, fmt.Errorf("用户ID不能为空")
+	}
+	if in.GoodsId <= 0 {
+		return nil, fmt.Errorf("商品ID不能为空")
+	}
+	if in.Rating < 1 || in.Rating > 5 {
+		return nil, fmt.Errorf("评分必须在1到5之间")
+	}
+	content := strings.TrimSpace(in.Content)
+	if content == "" {
+		return nil, fmt.Errorf("评价内容不能为空")
+	}
+	if utf8.RuneCountInString(content) > goodsReviewMaxRunes {
+		return nil, fmt.Errorf("评价内容不能超过%d字", goodsReviewMaxRunes)
+	}
+
+	userId := int64(in.UserId)
+	review := &model.LxtPaymentGoodsReview{
+		GoodsID: in.GoodsId,
+		UserID:  userId,
+		Rating:  in.Rating,
+		Content: content,
+		Status:  constant.GoodsReviewStatusVisible,
+	}
+	err := l.reviewRepo.WithTransaction(l.ctx, func(txCtx context.Context) error {
+		order, err := l.orderRepo.LockPaidGoodsOrder(txCtx, userId, in.GoodsId)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return fmt.Errorf("购买该商品后才能评价")
+			}
+			return fmt.Errorf("lock paid goods order failed: %w", err)
+		}
+
+		exists, err := l.reviewRepo.ExistsByUserGoods(txCtx, userId, in.GoodsId)
+		if err != nil {
+			return fmt.Errorf("query goods review failed: %w", err)
+		}
+		if exists {
+			return fmt.Errorf("您已评价过该商品")
+		}
+
+		review.PaymentID = order.PaymentID
+		if err := l.reviewRepo.CreateReview(txCtx, review); err != nil {
+			return fmt.Errorf("create goods review failed: %w", err)
+		}
+		return l.goodsRepo.AdjustRating(txCtx, in.GoodsId, int64(in.Rating), 1)
+	})
+	if err != nil {
+		l.Errorf("Failed to create goods review: userId=%d, goodsId=%d, err=%v", userId, in.GoodsId, err)
+		return nil, err
+	}
+
+	resp := &payment.GoodsReviewCreateResp{Id: review.ID}
+	if goods, err := l.goodsRepo.GetById(l.ctx, in.GoodsId); err == nil {
+		resp.Rating = goods.Rating
+		resp.ReviewCount = goods.ReviewCount
+	}
+
+	l.Infof("Created goods review: id=%d, userId=%d, goodsId=%d, rating=%d", review.ID, userId, in.GoodsId, in.Rating)
+	return resp, nil
+}
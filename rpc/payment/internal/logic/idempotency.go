@@ -0,0 +1,121 @@
+package logic
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"lxtian-blog/common/constant"
+	"lxtian-blog/common/model"
+	"lxtian-blog/common/repository/payment_repo"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	// idempotencyKeyMaxLen 幂等键最大长度
+	idempotencyKeyMaxLen = 64
+	// idempotencyStaleAfter 处理中的记录超过该时间未完成时允许重试接管（如服务在处理过程中重启）
+	idempotencyStaleAfter = 5 * time.Minute
+)
+
+// 计算请求摘要时忽略的字段：幂等键本身，以及重试时可能变化的客户端IP
+var idempotencyIgnoredFields = []protoreflect.Name{"idempotency_key", "client_ip"}
+
+// runIdempotent 按幂等键执行 fn：
+// 同一接口、同一幂等键的重试直接返回首次成功的响应；幂等键被参数不同的请求复用时拒绝；
+// fn 失败时删除记录，客户端可使用同一幂等键重试。未传幂等键时直接执行 fn
+func runIdempotent[T proto.Message](l *BaseLogic, scope, key string, req proto.Message, fn func() (T, error)) (T, error) {
+	var zero T
+	if key == "" {
+		return fn()
+	}
+	if len(key) > idempotencyKeyMaxLen {
+		return zero, fmt.Errorf("幂等键长度不能超过%d", idempotencyKeyMaxLen)
+	}
+
+	requestHash, err := idempotencyRequestHash(req)
+	if err != nil {
+		return zero, err
+	}
+
+	repo := payment_repo.NewLxtPaymentIdempotencyKeysRepo(l.svcCtx.DB)
+	record := &model.LxtPaymentIdempotencyKey{
+		Scope:          scope,
+		IdempotencyKey: key,
+		RequestHash:    requestHash,
+		Status:         constant.IdempotencyStatusProcessing,
+	}
+	created, err := repo.CreateIfNotExists(l.ctx, record)
+	if err != nil {
+		l.Errorf("Failed to save idempotency key: scope=%s, key=%s, err=%v", scope, key, err)
+		return zero, fmt.Errorf("保存幂等键失败: %w", err)
+	}
+
+	if !created {
+		existing, err := repo.GetByKey(l.ctx, scope, key)
+		if err != nil {
+			return zero, fmt.Errorf("查询幂等键失败: %w", err)
+		}
+		if existing.RequestHash != requestHash {
+			return zero, fmt.Errorf("幂等键已被其他请求使用")
+		}
+		if existing.Status == constant.IdempotencyStatusSuccess && existing.Response != nil {
+			resp := zero.ProtoReflect().Type().New().Interface().(T)
+			if err := protojson.Unmarshal([]byte(*existing.Response), resp); err != nil {
+				return zero, fmt.Errorf("解析幂等响应失败: %w", err)
+			}
+			l.Infof("Replayed idempotent response: scope=%s, key=%s", scope, key)
+			return resp, nil
+		}
+
+		// 首次请求仍在处理中，超时未完成的记录由本次请求接管
+		ok, err := repo.TakeOverStale(l.ctx, existing.ID, time.Now().Add(-idempotencyStaleAfter))
+		if err != nil {
+			return zero, err
+		}
+		if !ok {
+			return zero, fmt.Errorf("请求正在处理中，请稍后重试")
+		}
+		record = existing
+	}
+
+	resp, err := fn()
+	if err != nil {
+		if derr := repo.DeleteProcessing(l.ctx, record.ID); derr != nil {
+			l.Errorf("Failed to delete idempotency key: scope=%s, key=%s, err=%v", scope, key, derr)
+		}
+		return resp, err
+	}
+
+	data, err := protojson.Marshal(resp)
+	if err != nil {
+		l.Errorf("Failed to marshal idempotent response: scope=%s, key=%s, err=%v", scope, key, err)
+		return resp, nil
+	}
+	if err := repo.MarkSuccess(l.ctx, record.ID, string(data)); err != nil {
+		l.Errorf("Failed to save idempotent response: scope=%s, key=%s, err=%v", scope, key, err)
+	}
+	return resp, nil
+}
+
+// idempotencyRequestHash 计算请求参数摘要，用于识别幂等键是否被不同请求复用
+func idempotencyRequestHash(req proto.Message) (string, error) {
+	msg := proto.Clone(req)
+	ref := msg.ProtoReflect()
+	for _, name := range idempotencyIgnoredFields {
+		if fd := ref.Descriptor().Fields().ByName(name); fd != nil {
+			ref.Clear(fd)
+		}
+	}
+
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal request: %w", err)
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
@@ -0,0 +1,105 @@
+package logic
+
+import (
+	"fmt"
+	"time"
+
+	"lxtian-blog/common/constant"
+	"lxtian-blog/common/model"
+	"lxtian-blog/common/repository/user_repo"
+)
+
+// membershipProjection 购买会员后的会员状态
+type membershipProjection struct {
+	RenewalType     int32     // 续费类型 constant.MembershipRenewalType*
+	StartTime       time.Time // 开始时间
+	EndTime         time.Time // 到期时间
+	TotalMonths     int32     // 累计月数
+	Level           int32     // 会员等级
+	RemainingMonths *int32    // 续费前剩余月数，首次开通或已过期时为空
+}
+
+// projectMembership 计算购买会员类型 membershipType 后的会员状态，current 为空表示首次开通，levels 为会员等级配置；
+// prorated 表示升级时剩余时长已在下单时折算抵扣，新会员从当前时间开始计算，否则在原到期时间上顺延。
+// 报价和支付成功后开通会员使用同一规则，保证报价结果与实际开通结果一致
+func projectMembership(current *model.LxtUserMembership, membershipType *model.LxtUserMembershipType, levels []*model.LxtUserMembershipLevel, prorated bool, now time.Time) (*membershipProjection, error) {
+	monthsToAdd := int(membershipType.Months)
+	if monthsToAdd <= 0 {
+		return nil, fmt.Errorf("invalid membership months for type %d", membershipType.ID)
+	}
+
+	projection := &membershipProjection{
+		RenewalType: constant.MembershipRenewalTypeRenewal,
+		StartTime:   now,
+		EndTime:     now.AddDate(0, monthsToAdd, 0),
+		TotalMonths: int32(monthsToAdd),
+	}
+	if current == nil {
+		projection.Level = calculateMembershipLevel(levels, projection.TotalMonths)
+		return projection, nil
+	}
+
+	if current.MembershipTypeID != membershipType.ID {
+		projection.RenewalType = constant.MembershipRenewalTypeUpgrade
+	}
+
+	switch {
+	case current.EndTime.Before(now):
+		// 会员已过期，从当前时间重新开始
+	case projection.RenewalType == constant.MembershipRenewalTypeUpgrade && prorated:
+		remainingMonths := calculateRemainingMonths(current.EndTime, now)
+		projection.RemainingMonths = &remainingMonths
+	default:
+		// 会员未过期，在原有到期时间基础上续费
+		remainingMonths := calculateRemainingMonths(current.EndTime, now)
+		projection.RemainingMonths = &remainingMonths
+		projection.StartTime = current.StartTime
+		projection.EndTime = current.EndTime.AddDate(0, monthsToAdd, 0)
+	}
+
+	projection.TotalMonths = current.TotalMonths + int32(monthsToAdd)
+	projection.Level = calculateMembershipLevel(levels, projection.TotalMonths)
+	return projection, nil
+}
+
+// membershipLevels 按最少累计天数升序查询会员等级配置，开通、续费、退款扣回和到期停用使用同一份配置计算等级
+func (l *BaseLogic) membershipLevels() ([]*model.LxtUserMembershipLevel, error) {
+	levels, err := user_repo.NewMembershipLevelRepository(l.svcCtx.DB).ListOrderByMinDays(l.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("query membership levels failed: %w", err)
+	}
+	return levels, nil
+}
+
+// calculateMembershipLevel 按累计会员天数匹配 MinDays 不超过该天数的最高等级，未配置等级时按累计月数计算
+func calculateMembershipLevel(levels []*model.LxtUserMembershipLevel, totalMonths int32) int32 {
+	if len(levels) == 0 {
+		return levelByMonths(int(totalMonths))
+	}
+
+	days := totalMonths * membershipDaysPerMonth
+	level := levels[0].Level
+	for _, item := range levels {
+		if item.MinDays > days {
+			break
+		}
+		level = item.Level
+	}
+	return level
+}
+
+// levelByMonths 按累计月数计算会员等级
+func levelByMonths(totalMonths int) int32 {
+	switch {
+	case totalMonths <= 3:
+		return 1
+	case totalMonths <= 6:
+		return 2
+	case totalMonths <= 12:
+		return 3
+	case totalMonths <= 24:
+		return 4
+	default:
+		return 5
+	}
+}
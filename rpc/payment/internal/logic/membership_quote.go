@@ -0,0 +1,129 @@
+package logic
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"lxtian-blog/common/model"
+	redisutil "lxtian-blog/common/pkg/redis"
+	"lxtian-blog/common/pkg/utils"
+	"lxtian-blog/common/repository/user_repo"
+
+	"github.com/zeromicro/go-zero/core/stores/redis"
+)
+
+// membershipQuote 保存在 Redis 中的会员报价，下单时据此校验价格和会员状态是否仍与报价一致
+type membershipQuote struct {
+	UserId         int64  `json:"user_id"`
+	VipId          int64  `json:"vip_id"`
+	CouponCode     string `json:"coupon_code,omitempty"`
+	Amount         string `json:"amount"`
+	CurrentTypeId  int64  `json:"current_type_id,omitempty"`  // 报价时的会员类型ID
+	CurrentEndTime int64  `json:"current_end_time,omitempty"` // 报价时的会员到期时间（秒）
+}
+
+// errMembershipQuoteExpired 报价不存在或已过期
+var errMembershipQuoteExpired = errors.New("会员报价已过期，请重新获取报价")
+
+// currentMembership 查询用户的会员记录，没有会员记录时返回 nil
+func (l *BaseLogic) currentMembership(userId int64) *model.LxtUserMembership {
+	membership, err := user_repo.NewUserMembershipRepository(l.svcCtx.DB, l.svcCtx.Rds).GetByUserId(l.ctx, userId)
+	if err != nil {
+		return nil
+	}
+	return membership
+}
+
+// newMembershipQuote 根据价格和当前会员记录生成报价
+func newMembershipQuote(userId int64, price *orderPrice, current *model.LxtUserMembership) *membershipQuote {
+	quote := &membershipQuote{
+		UserId:     userId,
+		VipId:      price.ItemId,
+		CouponCode: price.CouponCode,
+		Amount:     utils.FormatAmount(price.Amount),
+	}
+	if current != nil {
+		quote.CurrentTypeId = current.MembershipTypeID
+		quote.CurrentEndTime = current.EndTime.Unix()
+	}
+	return quote
+}
+
+// matches 下单时重新计算的报价是否与保存的报价一致
+func (q *membershipQuote) matches(other *membershipQuote) bool {
+	return *q == *other
+}
+
+// saveMembershipQuote 保存报价，返回报价ID和过期时间
+func (l *BaseLogic) saveMembershipQuote(quote *membershipQuote) (string, time.Time, error) {
+	if l.svcCtx.Rds == nil {
+		return "", time.Time{}, fmt.Errorf("报价服务不可用")
+	}
+
+	data, err := json.Marshal(quote)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to marshal membership quote: %w", err)
+	}
+
+	quoteId := fmt.Sprintf("MQ%d", utils.Snowflake())
+	ttl := l.svcCtx.Config.MembershipQuoteTTL
+	key := redisutil.ReturnRedisKey(redisutil.PaymentMembershipQuote, quoteId)
+	if err := l.svcCtx.Rds.SetexCtx(l.ctx, key, string(data), ttl); err != nil {
+		l.Errorf("Failed to save membership quote: quoteId=%s, err=%v", quoteId, err)
+		return "", time.Time{}, fmt.Errorf("保存报价失败: %w", err)
+	}
+	return quoteId, time.Now().Add(time.Duration(ttl) * time.Second), nil
+}
+
+// loadMembershipQuote 读取报价，报价不存在或已过期时返回 errMembershipQuoteExpired
+func (l *BaseLogic) loadMembershipQuote(quoteId string) (*membershipQuote, error) {
+	if l.svcCtx.Rds == nil {
+		return nil, fmt.Errorf("报价服务不可用")
+	}
+
+	key := redisutil.ReturnRedisKey(redisutil.PaymentMembershipQuote, quoteId)
+	data, err := l.svcCtx.Rds.GetCtx(l.ctx, key)
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return nil, fmt.Errorf("读取报价失败: %w", err)
+	}
+	if data == "" {
+		return nil, errMembershipQuoteExpired
+	}
+
+	var quote membershipQuote
+	if err := json.Unmarshal([]byte(data), &quote); err != nil {
+		return nil, fmt.Errorf("解析报价失败: %w", err)
+	}
+	return &quote, nil
+}
+
+// deleteMembershipQuote 下单成功后删除报价，一个报价只能用于一笔订单
+func (l *BaseLogic) deleteMembershipQuote(quoteId string) {
+	if l.svcCtx.Rds == nil {
+		return
+	}
+	key := redisutil.ReturnRedisKey(redisutil.PaymentMembershipQuote, quoteId)
+	if _, err := l.svcCtx.Rds.DelCtx(l.ctx, key); err != nil {
+		l.Errorf("Failed to delete membership quote: quoteId=%s, err=%v", quoteId, err)
+	}
+}
+
+// verifyMembershipQuote 校验会员订单携带的报价：重新计算的价格和用户当前会员状态须与报价时一致
+func (l *BaseLogic) verifyMembershipQuote(quoteId string, userId int64, price *orderPrice) error {
+	if quoteId == "" {
+		return fmt.Errorf("购买会员须先获取报价")
+	}
+	quote, err := l.loadMembershipQuote(quoteId)
+	if err != nil {
+		return err
+	}
+
+	expected := newMembershipQuote(userId, price, l.currentMembership(userId))
+	if !quote.matches(expected) {
+		l.Errorf("Membership quote mismatch: quoteId=%s, quote=%+v, expected=%+v", quoteId, *quote, *expected)
+		return fmt.Errorf("会员价格或会员状态已变化，请重新获取报价")
+	}
+	return nil
+}
@@ -0,0 +1,143 @@
+package logic
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"lxtian-blog/common/model"
+
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// revokeMembershipForRefund 会员订单退款后扣回对应续费记录开通的会员时长
+// refundedAmount 为订单累计退款成功金额，按退款比例扣回，已扣回部分记录在续费记录上，重复调用是幂等的
+func (l *BaseLogic) revokeMembershipForRefund(order *model.LxtPaymentOrder, refundedAmount decimal.Decimal) error {
+	orderAmount := order.Amount.Round(2)
+	if !orderAmount.IsPositive() {
+		return nil
+	}
+	levels, err := l.membershipLevels()
+	if err != nil {
+		return err
+	}
+
+	ratio := refundedAmount.Div(orderAmount)
+	fullRefund := ratio.GreaterThanOrEqual(decimal.NewFromInt(1))
+	if fullRefund {
+		ratio = decimal.NewFromInt(1)
+	}
+
+	revoked := false
+	err = l.svcCtx.DB.WithContext(l.ctx).Transaction(func(tx *gorm.DB) error {
+		var renewal model.LxtUserMembershipRenewal
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("order_id = ?", order.ID).
+			First(&renewal).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			// 会员尚未开通，无需扣回
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("query membership renewal failed: %w", err)
+		}
+
+		var membership model.LxtUserMembership
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("user_id = ?", renewal.UserID).
+			First(&membership).Error; err != nil {
+			return fmt.Errorf("query membership failed: %w", err)
+		}
+
+		var membershipType model.LxtUserMembershipType
+		if err := tx.Where("id = ?", renewal.ToMembershipTypeID).First(&membershipType).Error; err != nil {
+			return fmt.Errorf("query membership type failed: %w", err)
+		}
+
+		// 本次续费开通的时长：在原到期时间基础上续费的从原到期时间算起，否则从续费后开始时间算起
+		grantedFrom := renewal.AfterStartTime
+		if renewal.BeforeStartTime != nil && renewal.BeforeEndTime != nil && renewal.AfterStartTime.Equal(*renewal.BeforeStartTime) {
+			grantedFrom = *renewal.BeforeEndTime
+		}
+		grantedDays := int32(renewal.AfterEndTime.Sub(grantedFrom).Hours() / 24)
+		grantedMonths := membershipType.Months
+
+		var laterCount int64
+		if err := tx.Model(&model.LxtUserMembershipRenewal{}).
+			Where("user_id = ? AND id > ?", renewal.UserID, renewal.ID).
+			Count(&laterCount).Error; err != nil {
+			return fmt.Errorf("query later membership renewal failed: %w", err)
+		}
+
+		now := time.Now()
+		if fullRefund && laterCount == 0 {
+			// 全额退款且是最近一次续费：直接恢复续费前的会员状态，之前部分退款已扣回的月数不重复扣减
+			if renewal.RevokedAt != nil && renewal.RevokedDays == grantedDays && renewal.RevokedMonths == grantedMonths {
+				return nil
+			}
+			if renewal.BeforeEndTime == nil {
+				membership.IsActive = 0
+				if membership.EndTime.After(now) {
+					membership.EndTime = now
+				}
+			} else {
+				membership.StartTime = *renewal.BeforeStartTime
+				membership.EndTime = *renewal.BeforeEndTime
+				if renewal.FromMembershipTypeID != nil {
+					membership.MembershipTypeID = *renewal.FromMembershipTypeID
+				}
+			}
+			membership.TotalMonths -= grantedMonths - renewal.RevokedMonths
+			renewal.RevokedDays = grantedDays
+			renewal.RevokedMonths = grantedMonths
+		} else {
+			// 部分退款或之后已有续费：按退款比例扣减到期时间和累计月数
+			targetDays := int32(decimal.NewFromInt32(grantedDays).Mul(ratio).Floor().IntPart())
+			targetMonths := int32(decimal.NewFromInt32(grantedMonths).Mul(ratio).Floor().IntPart())
+			deltaDays := targetDays - renewal.RevokedDays
+			deltaMonths := targetMonths - renewal.RevokedMonths
+			if deltaDays <= 0 && deltaMonths <= 0 {
+				return nil
+			}
+			if deltaDays > 0 {
+				membership.EndTime = membership.EndTime.AddDate(0, 0, -int(deltaDays))
+				renewal.RevokedDays = targetDays
+			}
+			if deltaMonths > 0 {
+				membership.TotalMonths -= deltaMonths
+				renewal.RevokedMonths = targetMonths
+			}
+		}
+
+		if membership.TotalMonths < 0 {
+			membership.TotalMonths = 0
+		}
+		membership.Level = calculateMembershipLevel(levels, membership.TotalMonths)
+		if !membership.EndTime.After(now) {
+			membership.IsActive = 0
+		}
+		if err := tx.Save(&membership).Error; err != nil {
+			return fmt.Errorf("update membership failed: %w", err)
+		}
+
+		renewal.RevokedAt = &now
+		if err := tx.Save(&renewal).Error; err != nil {
+			return fmt.Errorf("update membership renewal failed: %w", err)
+		}
+
+		revoked = true
+		l.Infof("Revoked membership for refund: paymentId=%s, userId=%d, revokedDays=%d, revokedMonths=%d, endTime=%s",
+			order.PaymentID, membership.UserID, renewal.RevokedDays, renewal.RevokedMonths, membership.EndTime.Format("2006-01-02 15:04:05"))
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if revoked {
+		l.clearUserCacheAfterMembershipUpdate(order.UserID)
+	}
+	return nil
+}
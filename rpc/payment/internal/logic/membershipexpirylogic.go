@@ -0,0 +1,149 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"lxtian-blog/common/constant"
+	"lxtian-blog/common/model"
+	"lxtian-blog/common/repository/user_repo"
+	"lxtian-blog/rpc/message/message"
+	"lxtian-blog/rpc/payment/internal/svc"
+)
+
+// 每批处理的会员数量
+const membershipExpiryBatchSize = 200
+
+// membershipReminderDays 到期提醒节点（到期前N天），需按从大到小排列
+var membershipReminderDays = []int{7, 1}
+
+// MembershipExpiryLogic 会员到期处理：停用已到期会员并重算等级，到期前发送提醒
+type MembershipExpiryLogic struct {
+	*BaseLogic
+	membershipRepo user_repo.UserMembershipRepository
+	typeRepo       user_repo.MembershipTypeRepository
+}
+
+func NewMembershipExpiryLogic(ctx context.Context, svcCtx *svc.ServiceContext) *MembershipExpiryLogic {
+	return &MembershipExpiryLogic{
+		BaseLogic:      NewBaseLogic(ctx, svcCtx),
+		membershipRepo: user_repo.NewUserMembershipRepository(svcCtx.DB, svcCtx.Rds),
+		typeRepo:       user_repo.NewMembershipTypeRepository(svcCtx.DB),
+	}
+}
+
+// ProcessMembershipExpiry 停用已到期的会员并发送到期提醒，返回本次停用的会员数
+func (l *MembershipExpiryLogic) ProcessMembershipExpiry() (int, error) {
+	now := time.Now()
+	deactivated, err := l.deactivateExpired(now)
+	if err != nil {
+		return deactivated, err
+	}
+	l.sendExpiringReminders(now)
+	return deactivated, nil
+}
+
+// deactivateExpired 分批停用已到期会员，停用条件在更新语句中再次校验，多实例并发执行时不会重复处理
+func (l *MembershipExpiryLogic) deactivateExpired(now time.Time) (int, error) {
+	levels, err := l.membershipLevels()
+	if err != nil {
+		l.Errorf("Failed to list membership levels: %v", err)
+		return 0, err
+	}
+
+	deactivated := 0
+	for {
+		memberships, err := l.membershipRepo.FindExpiredActive(l.ctx, now, membershipExpiryBatchSize)
+		if err != nil {
+			l.Errorf("Failed to find expired memberships: %v", err)
+			return deactivated, err
+		}
+
+		processed := 0
+		for _, membership := range memberships {
+			// 按会员等级配置重新计算等级，与开通/续费时的规则一致
+			ok, err := l.membershipRepo.Deactivate(l.ctx, membership.ID, calculateMembershipLevel(levels, membership.TotalMonths), now)
+			if err != nil {
+				l.Errorf("Failed to deactivate membership: id=%d, userId=%d, err=%v", membership.ID, membership.UserID, err)
+				continue
+			}
+			processed++
+			if ok {
+				deactivated++
+				l.clearUserCacheAfterMembershipUpdate(membership.UserID)
+			}
+		}
+		// 本批全部失败时停止，避免反复查询到同一批记录
+		if len(memberships) < membershipExpiryBatchSize || processed == 0 {
+			break
+		}
+	}
+
+	if deactivated > 0 {
+		l.Infof("Deactivated %d expired memberships", deactivated)
+	}
+	return deactivated, nil
+}
+
+// sendExpiringReminders 在到期前7天和1天各发送一次提醒，相邻节点之间的会员只按较近的节点提醒
+// 通知按 biz_key 去重，任务重复执行或多实例执行时不会重复发送
+func (l *MembershipExpiryLogic) sendExpiringReminders(now time.Time) {
+	typeNames := make(map[int64]string)
+	for i, days := range membershipReminderDays {
+		from := now
+		if i+1 < len(membershipReminderDays) {
+			from = now.AddDate(0, 0, membershipReminderDays[i+1])
+		}
+		to := now.AddDate(0, 0, days)
+
+		var afterId int64
+		for {
+			memberships, err := l.membershipRepo.FindActiveExpiringBetween(l.ctx, from, to, afterId, membershipExpiryBatchSize)
+			if err != nil {
+				l.Errorf("Failed to find expiring memberships: days=%d, err=%v", days, err)
+				break
+			}
+			for _, membership := range memberships {
+				afterId = membership.ID
+				l.sendExpiringReminder(membership, days, l.membershipTypeName(typeNames, membership.MembershipTypeID))
+			}
+			if len(memberships) < membershipExpiryBatchSize {
+				break
+			}
+		}
+	}
+}
+
+// membershipTypeName 查询会员类型名称，同一次任务中缓存查询结果
+func (l *MembershipExpiryLogic) membershipTypeName(cache map[int64]string, typeId int64) string {
+	if name, ok := cache[typeId]; ok {
+		return name
+	}
+	name := "会员"
+	if membershipType, err := l.typeRepo.GetByID(l.ctx, uint64(typeId)); err == nil {
+		name = membershipType.Name
+	}
+	cache[typeId] = name
+	return name
+}
+
+// sendExpiringReminder 通过消息服务发送单条到期提醒，发送失败只记录日志，下次任务执行时重试
+func (l *MembershipExpiryLogic) sendExpiringReminder(membership *model.LxtUserMembership, days int, typeName string) {
+	resp, err := l.svcCtx.MessageRpc.SendNotice(l.ctx, &message.SendNoticeReq{
+		UserId: membership.UserID,
+		Type:   constant.NoticeTypeMembershipExpiring,
+		Title:  "会员即将到期",
+		Content: fmt.Sprintf("您的%s将于%s到期，剩余不足%d天，请及时续费以免影响使用。",
+			typeName, membership.EndTime.Format("2006-01-02 15:04:05"), days),
+		BizKey: fmt.Sprintf("membership_expiring:%d:%d:%d", membership.UserID, membership.EndTime.Unix(), days),
+	})
+	if err != nil {
+		l.Errorf("Failed to send membership expiring notice: userId=%d, days=%d, err=%v", membership.UserID, days, err)
+		return
+	}
+	if !resp.Duplicated {
+		l.Infof("Sent membership expiring notice: userId=%d, days=%d, endTime=%s",
+			membership.UserID, days, membership.EndTime.Format("2006-01-02 15:04:05"))
+	}
+}
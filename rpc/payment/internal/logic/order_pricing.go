@@ -0,0 +1,221 @@
+package logic
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"time"
+
+	"lxtian-blog/common/constant"
+	"lxtian-blog/common/model"
+	"lxtian-blog/common/pkg/utils"
+	"lxtian-blog/common/repository/payment_repo"
+	"lxtian-blog/common/repository/user_repo"
+	"lxtian-blog/rpc/payment/internal/svc"
+	"lxtian-blog/rpc/payment/pb/payment"
+
+	"github.com/shopspring/decimal"
+)
+
+// 会员时长折算时每月按 30 天计算
+const membershipDaysPerMonth = 30
+
+// orderPrice 服务端计算出的订单价格
+type orderPrice struct {
+	ItemId         int64           // 商品ID或会员类型ID
+	ItemName       string          // 商品或会员名称
+	UnitPrice      decimal.Decimal // 单价
+	Quantity       int64           // 数量
+	OriginalAmount decimal.Decimal // 抵扣前金额
+	Discount       decimal.Decimal // 抵扣金额
+	Amount         decimal.Decimal // 应付金额
+	FromTypeId     int64           // 升级前会员类型ID
+	RemainingDays  int64           // 升级前会员剩余天数
+	ClassifyId     int32           // 商品分类ID
+	CouponCode     string          // 使用的优惠码
+	CouponDiscount decimal.Decimal // 优惠券减免金额
+}
+
+// priceSnapshot 写入订单 price_snapshot 字段的价格快照
+type priceSnapshot struct {
+	BuyType        int64  `json:"buy_type"`
+	ItemId         int64  `json:"item_id"`
+	ItemName       string `json:"item_name"`
+	UnitPrice      string `json:"unit_price"`
+	Quantity       int64  `json:"quantity"`
+	OriginalAmount string `json:"original_amount"`
+	Discount       string `json:"discount"`
+	Amount         string `json:"amount"`
+	FromTypeId     int64  `json:"from_type_id,omitempty"`
+	RemainingDays  int64  `json:"remaining_days,omitempty"`
+	CouponCode     string `json:"coupon_code,omitempty"`
+	CouponDiscount string `json:"coupon_discount,omitempty"`
+	PricedAt       string `json:"priced_at"`
+}
+
+// calculateOrderPrice 根据购买类型从数据库查询商品或会员价格，计算订单应付金额
+// 捐赠订单金额由用户自定，直接使用请求金额
+func calculateOrderPrice(ctx context.Context, svcCtx *svc.ServiceContext, in *payment.CreatePaymentReq) (*orderPrice, error) {
+	switch in.BuyType {
+	case constant.BuyTypeGoods:
+		return calculateGoodsPrice(ctx, svcCtx, in.GoodsId, int64(in.Quantity))
+	case constant.BuyTypeMembership:
+		typeId := in.VipId
+		if typeId == 0 {
+			typeId = in.GoodsId
+		}
+		return calculateMembershipPrice(ctx, svcCtx, int64(in.UserId), typeId)
+	case constant.BuyTypeDonate:
+		amount, err := utils.ParseAmount(in.Amount)
+		if err != nil {
+			return nil, fmt.Errorf("支付金额不正确: %w", err)
+		}
+		return &orderPrice{
+			UnitPrice:      amount,
+			Quantity:       1,
+			OriginalAmount: amount,
+			Discount:       decimal.Zero,
+			Amount:         amount,
+		}, nil
+	default:
+		return nil, fmt.Errorf("不支持的购买类型: %d", in.BuyType)
+	}
+}
+
+// calculateGoodsPrice 商品订单：单价 × 数量
+func calculateGoodsPrice(ctx context.Context, svcCtx *svc.ServiceContext, goodsId, quantity int64) (*orderPrice, error) {
+	if goodsId <= 0 {
+		return nil, fmt.Errorf("商品ID不能为空")
+	}
+	if quantity <= 0 {
+		quantity = 1
+	}
+
+	goods, err := payment_repo.NewLxtPaymentGoodsRepo(svcCtx.DB).GetById(ctx, goodsId)
+	if err != nil {
+		return nil, fmt.Errorf("商品不存在: %w", err)
+	}
+	if goods.Status != 1 {
+		return nil, fmt.Errorf("商品未发布，无法购买")
+	}
+
+	unitPrice := goods.Price.Round(2)
+	amount := unitPrice.Mul(decimal.NewFromInt(quantity))
+
+	return &orderPrice{
+		ItemId:         goods.ID,
+		ItemName:       goods.Name,
+		UnitPrice:      unitPrice,
+		Quantity:       quantity,
+		OriginalAmount: amount,
+		Discount:       decimal.Zero,
+		Amount:         amount,
+		ClassifyId:     goods.ClassifyID,
+	}, nil
+}
+
+// calculateMembershipPrice 会员订单：同级续费或过期后重新开通按原价计算；
+// 升级时将当前会员剩余天数按原会员日均价折算后抵扣，剩余时长不再叠加到新会员上
+func calculateMembershipPrice(ctx context.Context, svcCtx *svc.ServiceContext, userId, typeId int64) (*orderPrice, error) {
+	if typeId <= 0 {
+		return nil, fmt.Errorf("会员类型ID不能为空")
+	}
+
+	typeRepo := user_repo.NewMembershipTypeRepository(svcCtx.DB)
+	membershipType, err := typeRepo.GetByID(ctx, uint64(typeId))
+	if err != nil {
+		return nil, fmt.Errorf("会员类型不存在: %w", err)
+	}
+	if membershipType.Status != 1 {
+		return nil, fmt.Errorf("会员类型已停用，无法购买")
+	}
+
+	unitPrice := membershipType.Price.Round(2)
+	price := &orderPrice{
+		ItemId:         membershipType.ID,
+		ItemName:       membershipType.Name,
+		UnitPrice:      unitPrice,
+		Quantity:       1,
+		OriginalAmount: unitPrice,
+		Discount:       decimal.Zero,
+		Amount:         unitPrice,
+	}
+
+	membershipRepo := user_repo.NewUserMembershipRepository(svcCtx.DB, svcCtx.Rds)
+	membership, err := membershipRepo.GetByUserId(ctx, userId)
+	if err != nil || membership == nil {
+		// 没有会员记录，按原价开通
+		return price, nil
+	}
+
+	now := time.Now()
+	if membership.IsActive != 1 || !membership.EndTime.After(now) {
+		// 会员已过期，按原价重新开通
+		return price, nil
+	}
+	if membership.MembershipTypeID > typeId {
+		return nil, fmt.Errorf("会员只允许升级和续费，不能降级")
+	}
+	if membership.MembershipTypeID == typeId {
+		// 同级续费，按原价计算
+		return price, nil
+	}
+
+	// 升级：剩余天数 × 原会员日均价
+	currentType, err := typeRepo.GetByID(ctx, uint64(membership.MembershipTypeID))
+	if err != nil || currentType.Months <= 0 {
+		return price, nil
+	}
+	remainingDays := int64(math.Ceil(membership.EndTime.Sub(now).Hours() / 24))
+	dailyPrice := currentType.Price.
+		Div(decimal.NewFromInt(int64(currentType.Months) * membershipDaysPerMonth))
+	credit := dailyPrice.Mul(decimal.NewFromInt(remainingDays)).Round(2)
+
+	// 抵扣后至少支付 0.01 元
+	minAmount := decimal.New(1, -2)
+	if credit.GreaterThan(unitPrice.Sub(minAmount)) {
+		credit = unitPrice.Sub(minAmount)
+	}
+
+	price.Discount = credit
+	price.Amount = unitPrice.Sub(credit)
+	price.FromTypeId = membership.MembershipTypeID
+	price.RemainingDays = remainingDays
+	return price, nil
+}
+
+// toSnapshot 将计算结果序列化为订单价格快照
+func (p *orderPrice) toSnapshot(buyType int64) *string {
+	snapshot := priceSnapshot{
+		BuyType:        buyType,
+		ItemId:         p.ItemId,
+		ItemName:       p.ItemName,
+		UnitPrice:      p.UnitPrice.StringFixed(2),
+		Quantity:       p.Quantity,
+		OriginalAmount: p.OriginalAmount.StringFixed(2),
+		Discount:       p.Discount.StringFixed(2),
+		Amount:         p.Amount.StringFixed(2),
+		FromTypeId:     p.FromTypeId,
+		RemainingDays:  p.RemainingDays,
+		PricedAt:       time.Now().Format("2006-01-02 15:04:05"),
+	}
+	if p.CouponCode != "" {
+		snapshot.CouponCode = p.CouponCode
+		snapshot.CouponDiscount = p.CouponDiscount.StringFixed(2)
+	}
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		return nil
+	}
+	s := string(data)
+	return &s
+}
+
+// membershipTypeIdOf 返回会员订单对应的会员类型ID（优先 vip_id，兼容旧订单使用 goods_id）
+func membershipTypeIdOf(order *model.LxtPaymentOrder) int64 {
+	if order.VipID > 0 {
+		return int64(order.VipID)
+	}
+	return int64(order.GoodsID)
+}
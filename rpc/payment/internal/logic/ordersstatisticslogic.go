@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"lxtian-blog/common/constant"
+	"lxtian-blog/common/pkg/utils"
 	"lxtian-blog/common/repository/payment_repo"
 	"lxtian-blog/rpc/payment/internal/svc"
 	"lxtian-blog/rpc/payment/pb/payment"
@@ -26,7 +27,7 @@ func NewOrdersStatisticsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *
 // 支付订单统计
 func (l *OrdersStatisticsLogic) OrdersStatistics(in *payment.OrdersStatisticsReq) (*payment.OrdersStatisticsResp, error) {
 	var total, paid, pending int64
-	var payAmount float64
+	var payAmount decimal.Decimal
 	var err error
 
 	// 如果指定了用户ID，则统计该用户的订单
@@ -97,15 +98,10 @@ func (l *OrdersStatisticsLogic) OrdersStatistics(in *payment.OrdersStatisticsReq
 		}
 	}
 
-	// 使用 decimal 库进行精确计算，避免浮点数精度问题
-	// 将 float64 转换为 decimal，保留2位小数，然后转换回 float32
-	payAmountDecimal := decimal.NewFromFloat(payAmount).Round(2)
-	payAmountFloat32, _ := payAmountDecimal.Float64()
-
 	return &payment.OrdersStatisticsResp{
 		Total:     total,
 		Paid:      paid,
 		Pending:   pending,
-		PayAmount: float32(payAmountFloat32),
+		PayAmount: utils.FormatAmount(payAmount),
 	}, nil
 }
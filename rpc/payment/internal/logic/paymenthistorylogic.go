@@ -143,7 +143,7 @@ func (l *PaymentHistoryLogic) buildPaymentOrderItem(order *model.LxtPaymentOrder
 	if order.BuyerLogonID != "" {
 		item["buyer_logon_id"] = order.BuyerLogonID
 	}
-	if order.ReceiptAmount != 0 {
+	if !order.ReceiptAmount.IsZero() {
 		item["receipt_amount"] = order.ReceiptAmount
 	}
 	if order.PayTime != nil {
@@ -2,24 +2,27 @@ package logic
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"github.com/leiphp/unit-go-sdk/pkg/gconv"
-	"net/url"
-	"sort"
-	"strconv"
 	"strings"
 	"time"
 
 	"lxtian-blog/common/constant"
 	"lxtian-blog/common/model"
+	"lxtian-blog/common/pkg/payprovider"
 	redisutil "lxtian-blog/common/pkg/redis"
+	"lxtian-blog/common/pkg/utils"
 	paymentSvc "lxtian-blog/common/repository/payment_repo"
+	"lxtian-blog/rpc/payment/internal/orderstate"
 
 	"lxtian-blog/rpc/payment/internal/svc"
 	"lxtian-blog/rpc/payment/pb/payment"
 
+	"github.com/shopspring/decimal"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type PaymentNotifyLogic struct {
@@ -43,19 +46,30 @@ func (l *PaymentNotifyLogic) PaymentNotify(in *payment.PaymentNotifyReq) (*payme
 		}, fmt.Errorf("notify_data is required")
 	}
 
+	payType := int32(in.PayType)
+	if payType == 0 {
+		payType = constant.PayTypeAlipay
+	}
+
 	// 生成通知ID
 	notifyId := l.generateNotifyId()
 
-	// 创建通知记录
+	// 创建通知记录，保存请求头以便重放时重新验签
 	paymentNotify := &model.LxtPaymentNotify{
 		NotifyID:      notifyId,
 		NotifyType:    constant.NotifyTypePayment,
 		NotifyData:    in.NotifyData,
 		Sign:          &in.Sign,
 		SignType:      &in.SignType,
+		PayType:       payType,
 		VerifyStatus:  constant.VerifyStatusPending,
 		ProcessStatus: constant.ProcessStatusPending,
 	}
+	if len(in.Headers) > 0 {
+		headers, _ := json.Marshal(in.Headers)
+		headersStr := string(headers)
+		paymentNotify.Headers = &headersStr
+	}
 
 	// 保存通知记录
 	err := l.svcCtx.DB.WithContext(l.ctx).Create(paymentNotify).Error
@@ -67,11 +81,12 @@ func (l *PaymentNotifyLogic) PaymentNotify(in *payment.PaymentNotifyReq) (*payme
 		}, fmt.Errorf("failed to insert payment notify: %w", err)
 	}
 
-	// 验证签名
-	err = l.verifySign(in.NotifyData, in.Sign)
+	// 验证签名并解析通知数据
+	notifyResult, err := l.verifyNotify(paymentNotify)
 	if err != nil {
-		l.Errorf("Failed to verify sign: %v", err)
+		l.Errorf("Failed to verify notify: %v", err)
 		l.paymentService.UpdatePaymentNotifyVerifyStatus(l.ctx, notifyId, constant.VerifyStatusFailed)
+		l.paymentService.UpdatePaymentNotifyProcessStatus(l.ctx, notifyId, constant.ProcessStatusFailed, "签名验证失败")
 		return &payment.PaymentNotifyResp{
 			Success: false,
 			Message: "签名验证失败",
@@ -84,19 +99,8 @@ func (l *PaymentNotifyLogic) PaymentNotify(in *payment.PaymentNotifyReq) (*payme
 		l.Errorf("Failed to update verify status: %v", err)
 	}
 
-	// 解析通知数据
-	notifyData, err := l.parseNotifyData(in.NotifyData)
-	if err != nil {
-		l.Errorf("Failed to parse notify data: %v", err)
-		l.paymentService.UpdatePaymentNotifyProcessStatus(l.ctx, notifyId, constant.ProcessStatusFailed, "解析通知数据失败")
-		return &payment.PaymentNotifyResp{
-			Success: false,
-			Message: "解析通知数据失败",
-		}, fmt.Errorf("failed to parse notify data: %w", err)
-	}
-
 	// 处理通知
-	err = l.processNotify(notifyData, notifyId)
+	err = l.processNotify(notifyResult, notifyId)
 	if err != nil {
 		l.Errorf("Failed to process notify: %v", err)
 		l.paymentService.UpdatePaymentNotifyProcessStatus(l.ctx, notifyId, constant.ProcessStatusFailed, err.Error())
@@ -113,7 +117,7 @@ func (l *PaymentNotifyLogic) PaymentNotify(in *payment.PaymentNotifyReq) (*payme
 	}
 
 	// 记录日志
-	l.Infof("Processed payment notify: notifyId=%s, out_trade_no=%s", notifyId, notifyData["out_trade_no"])
+	l.Infof("Processed payment notify: notifyId=%s, out_trade_no=%s", notifyId, notifyResult.OutTradeNo)
 
 	return &payment.PaymentNotifyResp{
 		Success: true,
@@ -121,30 +125,32 @@ func (l *PaymentNotifyLogic) PaymentNotify(in *payment.PaymentNotifyReq) (*payme
 	}, nil
 }
 
-// 验证签名
-func (l *PaymentNotifyLogic) verifySign(data, sign string) error {
-	if strings.TrimSpace(sign) == "" {
-		return fmt.Errorf("sign is empty")
+// verifyNotify 按通知记录的支付方式验证签名并解析通知数据
+func (l *PaymentNotifyLogic) verifyNotify(notify *model.LxtPaymentNotify) (*payprovider.NotifyResult, error) {
+	provider, err := l.paymentProvider(notify.PayType)
+	if err != nil {
+		return nil, err
 	}
 
-	// 构建待验签内容
-	signContent, err := buildSignContent(data)
-	if err != nil {
-		return fmt.Errorf("failed to build sign content: %w", err)
+	req := &payprovider.NotifyRequest{
+		Body: notify.NotifyData,
 	}
-	if signContent == "" {
-		return fmt.Errorf("sign content is empty")
+	if notify.Sign != nil {
+		req.Sign = *notify.Sign
+	}
+	if notify.Headers != nil && *notify.Headers != "" {
+		if err := json.Unmarshal([]byte(*notify.Headers), &req.Headers); err != nil {
+			return nil, fmt.Errorf("invalid notify headers: %w", err)
+		}
 	}
 
-	// 使用支付宝客户端验证签名
-	err = l.svcCtx.AlipayClient.VerifySign(signContent, sign)
+	result, err := provider.VerifyNotify(l.ctx, req)
 	if err != nil {
-		l.Errorf("Alipay sign verification failed: %v", err)
-		return fmt.Errorf("alipay sign verification failed: %w", err)
+		return nil, err
 	}
 
-	l.Infof("Alipay sign verification success")
-	return nil
+	l.Infof("%s notify sign verification success", provider.Name())
+	return result, nil
 }
 
 // 安全截取字符串
@@ -155,125 +161,10 @@ func safeSubstring(s string, length int) string {
 	return s[:length] + "..."
 }
 
-// buildSignContent 构建待验签字符串
-func buildSignContent(rawData string) (string, error) {
-	if strings.TrimSpace(rawData) == "" {
-		return "", fmt.Errorf("raw notify data is empty")
-	}
-
-	segments := strings.Split(rawData, "&")
-	if len(segments) == 0 {
-		return "", fmt.Errorf("invalid notify data")
-	}
-
-	values := make(map[string][]string)
-	for _, segment := range segments {
-		if segment == "" {
-			continue
-		}
-
-		kv := strings.SplitN(segment, "=", 2)
-		key := kv[0]
-		var value string
-		if len(kv) == 2 {
-			value = kv[1]
-		}
-
-		if key == "sign" || key == "sign_type" {
-			continue
-		}
-
-		decodedKey, err := url.QueryUnescape(key)
-		if err != nil {
-			decodedKey = key
-		}
-
-		decodedValue, err := url.QueryUnescape(value)
-		if err != nil {
-			decodedValue = value
-		}
-
-		values[decodedKey] = append(values[decodedKey], decodedValue)
-	}
-
-	if len(values) == 0 {
-		return "", fmt.Errorf("no parameters available for sign")
-	}
-
-	keys := make([]string, 0, len(values))
-	for key := range values {
-		keys = append(keys, key)
-	}
-	sort.Strings(keys)
-
-	var builder strings.Builder
-	first := true
-	for _, key := range keys {
-		for _, value := range values[key] {
-			// 排除空值参数（支付宝规则：空值参数不参与签名）
-			if value == "" {
-				continue
-			}
-			if !first {
-				builder.WriteByte('&')
-			} else {
-				first = false
-			}
-			builder.WriteString(key)
-			builder.WriteByte('=')
-			builder.WriteString(value)
-		}
-	}
-
-	return builder.String(), nil
-}
-
-// 解析通知数据
-func (l *PaymentNotifyLogic) parseNotifyData(notifyData string) (map[string]string, error) {
-	data := make(map[string]string)
-
-	pairs := strings.Split(notifyData, "&")
-	for _, pair := range pairs {
-		if pair == "" {
-			continue
-		}
-
-		kv := strings.SplitN(pair, "=", 2)
-		if len(kv) != 2 {
-			continue
-		}
-
-		key, err := url.QueryUnescape(kv[0])
-		if err != nil {
-			key = kv[0]
-		}
-		value, err := url.QueryUnescape(kv[1])
-		if err != nil {
-			value = kv[1]
-		}
-
-		data[strings.TrimSpace(key)] = strings.TrimSpace(value)
-	}
-
-	// 验证必要字段
-	if data["out_trade_no"] == "" {
-		return nil, fmt.Errorf("missing out_trade_no")
-	}
-
-	if data["trade_status"] == "" {
-		return nil, fmt.Errorf("missing trade_status")
-	}
-
-	// 统一交易状态格式
-	data["trade_status"] = strings.ToUpper(data["trade_status"])
-
-	return data, nil
-}
-
 // 处理通知
-func (l *PaymentNotifyLogic) processNotify(notifyData map[string]string, notifyId string) error {
-	outTradeNo := notifyData["out_trade_no"]
-	tradeStatus := notifyData["trade_status"]
+func (l *PaymentNotifyLogic) processNotify(notifyResult *payprovider.NotifyResult, notifyId string) error {
+	outTradeNo := notifyResult.OutTradeNo
+	tradeStatus := notifyResult.TradeStatus
 
 	// 查找支付订单
 	paymentOrder, err := l.paymentService.FindPaymentOrderByOutTradeNo(l.ctx, outTradeNo)
@@ -295,79 +186,69 @@ func (l *PaymentNotifyLogic) processNotify(notifyData map[string]string, notifyI
 	case constant.TradeStatusSuccess, constant.TradeStatusFinished:
 		// 支付成功
 		if paymentOrder.Status == constant.PaymentStatusPaid {
-			// 已经处理过，直接返回成功
-			return nil
-		}
-
-		// 解析支付时间
-		var gmtPayment *time.Time
-		if notifyData["gmt_payment"] != "" {
-			if t, err := time.Parse("2006-01-02 15:04:05", notifyData["gmt_payment"]); err == nil {
-				gmtPayment = &t
-			} else {
-				l.Errorf("Failed to parse gmt_payment '%s': %v", notifyData["gmt_payment"], err)
-			}
+			// 订单已支付（重复通知或重放通知），仅补偿执行支付成功后的业务逻辑，
+			// 会员开通按订单幂等，已开通过不会重复开通
+			return l.handlePaymentSuccess(paymentOrder)
 		}
-
-		// 解析金额
-		var receiptAmount float64
-		if notifyData["receipt_amount"] != "" {
-			if amount, err := parseFloat(notifyData["receipt_amount"]); err == nil {
-				receiptAmount = amount
-			} else {
-				l.Errorf("Failed to parse receipt_amount '%s': %v", notifyData["receipt_amount"], err)
-			}
+		if paymentOrder.Status == constant.PaymentStatusRefunded || paymentOrder.Status == constant.PaymentStatusPartialRefunded {
+			// 退款后支付宝仍会推送交易成功通知，订单已退款时不能再恢复为已支付或重新开通会员
+			l.Infof("Payment order already refunded, skip notify: paymentId=%s, status=%s", paymentOrder.PaymentID, paymentOrder.Status)
+			return nil
 		}
 
-		// 更新订单信息
-		err = l.paymentService.UpdatePaymentOrderTradeInfo(
-			l.ctx,
+		// 通过状态机更新订单为已支付，已关闭/已取消的订单以支付结果为准
+		_, err = l.markOrderPaid(
 			paymentOrder.PaymentID,
-			notifyData["trade_no"],
+			notifyResult.TradeNo,
 			tradeStatus,
-			notifyData["buyer_id"],
-			notifyData["buyer_logon_id"],
-			receiptAmount,
-			gmtPayment,
+			notifyResult.BuyerId,
+			notifyResult.BuyerLogonId,
+			notifyResult.ReceiptAmount,
+			notifyResult.PayTime,
 		)
 		if err != nil {
 			return fmt.Errorf("failed to update trade info: %w", err)
 		}
 
-		// 显式更新订单状态为已支付，确保状态同步
-		//if err := l.paymentService.UpdatePaymentOrderStatus(l.ctx, paymentOrder.PaymentID, constant.PaymentStatusPaid); err != nil {
-		//	return fmt.Errorf("failed to update payment order status: %w", err)
-		//}
-
 		// 同步更新内存对象，便于后续逻辑使用
-		paymentOrder.TradeNo = notifyData["trade_no"]
+		paymentOrder.TradeNo = notifyResult.TradeNo
 		paymentOrder.TradeStatus = tradeStatus
-		paymentOrder.BuyerUserID = notifyData["buyer_id"]
-		paymentOrder.BuyerLogonID = notifyData["buyer_logon_id"]
-		paymentOrder.ReceiptAmount = receiptAmount
+		paymentOrder.BuyerUserID = notifyResult.BuyerId
+		paymentOrder.BuyerLogonID = notifyResult.BuyerLogonId
+		paymentOrder.ReceiptAmount = notifyResult.ReceiptAmount
 		paymentOrder.Status = constant.PaymentStatusPaid
-		if gmtPayment != nil {
-			paymentOrder.PayTime = gmtPayment
+		if notifyResult.PayTime != nil {
+			paymentOrder.PayTime = notifyResult.PayTime
 		}
 
 		// 支付成功后的业务逻辑
-		if err := l.handlePaymentSuccess(paymentOrder, notifyData); err != nil {
+		if err := l.handlePaymentSuccess(paymentOrder); err != nil {
 			return err
 		}
 
-		// 支付成功后，当前订单不再属于「待支付」，从 Redis 计数中减 1
-		adjustUserDailyPendingCount(l.ctx, l.svcCtx.Rds, paymentOrder.UserID, paymentOrder.CreatedAt, -1)
+		// 支付成功后，当前订单不再属于「待支付」，释放待支付名额
+		l.releaseUserPendingOrder(paymentOrder.UserID, paymentOrder.OutTradeNo)
 
 	case constant.TradeStatusClosed:
-		// 交易关闭
-		if paymentOrder.Status != constant.PaymentStatusClosed {
-			err = l.paymentService.UpdatePaymentOrderStatus(l.ctx, paymentOrder.PaymentID, constant.PaymentStatusClosed)
-			if err != nil {
-				return fmt.Errorf("failed to update status to closed: %w", err)
-			}
-
-			// 订单被关闭，同样视为不再处于待支付状态，减 1
-			adjustUserDailyPendingCount(l.ctx, l.svcCtx.Rds, paymentOrder.UserID, paymentOrder.CreatedAt, -1)
+		// 交易关闭；已支付订单全额退款后支付宝同样推送 TRADE_CLOSED，状态机不允许覆盖已支付/已退款状态
+		changed, err := l.transitionOrder(&orderstate.Transition{
+			PaymentId: paymentOrder.PaymentID,
+			To:        constant.PaymentStatusClosed,
+			Event:     constant.OrderEventTradeClosed,
+			Remark:    "notify:" + notifyId,
+			Updates:   map[string]interface{}{"trade_status": tradeStatus, "close_time": time.Now()},
+		})
+		if errors.Is(err, orderstate.ErrInvalidTransition) {
+			l.Infof("Ignore trade closed notify: paymentId=%s, status=%s", paymentOrder.PaymentID, paymentOrder.Status)
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to update status to closed: %w", err)
+		}
+
+		if changed {
+			// 订单被关闭，同样视为不再处于待支付状态，释放待支付名额
+			l.releaseUserPendingOrder(paymentOrder.UserID, paymentOrder.OutTradeNo)
 		}
 
 	case constant.TradeStatusWaitBuyerPay:
@@ -382,9 +263,9 @@ func (l *PaymentNotifyLogic) processNotify(notifyData map[string]string, notifyI
 }
 
 // 处理支付成功后的业务逻辑
-func (l *PaymentNotifyLogic) handlePaymentSuccess(paymentOrder *model.LxtPaymentOrder, notifyData map[string]string) error {
-	l.Infof("Payment success: paymentId=%s, orderSn=%s, amount=%.2f",
-		paymentOrder.PaymentID, paymentOrder.OrderSn, paymentOrder.Amount)
+func (l *PaymentNotifyLogic) handlePaymentSuccess(paymentOrder *model.LxtPaymentOrder) error {
+	l.Infof("Payment success: paymentId=%s, orderSn=%s, amount=%s",
+		paymentOrder.PaymentID, paymentOrder.OrderSn, utils.FormatAmount(paymentOrder.Amount))
 
 	// 如果是会员购买订单，自动开通或续费会员
 	if paymentOrder.BuyType == constant.BuyTypeMembership {
@@ -429,119 +310,160 @@ func (l *PaymentNotifyLogic) activateMembership(paymentOrder *model.LxtPaymentOr
 	}
 
 	return l.svcCtx.DB.WithContext(l.ctx).Transaction(func(tx *gorm.DB) error {
-		var membershipType model.LxtUserMembershipType
-		if err := tx.Where("id = ?", paymentOrder.GoodsID).First(&membershipType).Error; err != nil {
-			if errors.Is(err, gorm.ErrRecordNotFound) {
-				return fmt.Errorf("membership type not found for goods_id=%d", paymentOrder.GoodsID)
-			}
-			return fmt.Errorf("query membership type failed: %w", err)
+		// 锁定订单行，串行化同一订单的并发通知/重放
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ?", paymentOrder.ID).
+			First(&model.LxtPaymentOrder{}).Error; err != nil {
+			return fmt.Errorf("lock payment order failed: %w", err)
+		}
+
+		// 该订单已有续费记录说明会员已开通，直接返回保证幂等
+		var renewalCount int64
+		if err := tx.Model(&model.LxtUserMembershipRenewal{}).
+			Where("order_id = ?", paymentOrder.ID).
+			Count(&renewalCount).Error; err != nil {
+			return fmt.Errorf("query membership renewal failed: %w", err)
+		}
+		if renewalCount > 0 {
+			l.Infof("Membership already activated for paymentId=%s, skip", paymentOrder.PaymentID)
+			return nil
 		}
 
-		monthsToAdd := int(membershipType.Months)
-		if monthsToAdd <= 0 {
-			return fmt.Errorf("invalid membership months for type %d", membershipType.ID)
+		if _, err := l.grantMembership(tx, &membershipGrant{
+			UserId:           paymentOrder.UserID,
+			MembershipTypeId: membershipTypeIdOf(paymentOrder),
+			OrderId:          paymentOrder.ID,
+			Amount:           paymentOrder.Amount,
+			Prorated:         paymentOrder.DiscountAmount.IsPositive(),
+		}); err != nil {
+			return err
 		}
 
-		now := time.Now()
+		// 会员续费成功后，删除相关缓存
+		// 注意：这里在事务提交后执行，确保数据已持久化
+		// 如果事务回滚，这些操作也不会执行（因为函数会返回错误）
+		l.clearUserCacheAfterMembershipUpdate(paymentOrder.UserID)
 
-		var membership model.LxtUserMembership
-		err := tx.Where("user_id = ?", paymentOrder.UserID).First(&membership).Error
+		return nil
+	})
+}
 
-		var (
-			beforeStart        *time.Time
-			beforeEnd          *time.Time
-			fromTypeID         *int64
-			remainingMonthsPtr *int32
-			renewalType        int32 = constant.MembershipRenewalTypeRenewal
-		)
+// membershipGrant 开通或续费会员的参数，支付成功与礼品码兑换共用
+type membershipGrant struct {
+	UserId           int64
+	MembershipTypeId int64
+	OrderId          int64           // 关联订单ID，用于续费记录幂等
+	Amount           decimal.Decimal // 支付金额，礼品码兑换为0
+	Prorated         bool            // 升级时剩余时长已在下单时折算抵扣
+	RenewalType      int32           // 续费类型，为0时按会员变化推算（同级续费/向上升级）
+}
 
+// grantMembership 在事务中开通或续费会员并写入续费记录，返回更新后的会员记录
+// 调用方负责锁定订单保证幂等，并在事务提交后清除用户缓存
+func (l *BaseLogic) grantMembership(tx *gorm.DB, grant *membershipGrant) (*model.LxtUserMembership, error) {
+	membershipTypeID := grant.MembershipTypeId
+	var membershipType model.LxtUserMembershipType
+	if err := tx.Where("id = ?", membershipTypeID).First(&membershipType).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			membership = model.LxtUserMembership{
-				UserID:           paymentOrder.UserID,
-				MembershipTypeID: membershipType.ID,
-				StartTime:        now,
-				EndTime:          now.AddDate(0, monthsToAdd, 0),
-				IsActive:         1,
-				TotalMonths:      int32(monthsToAdd),
-				Level:            calculateMembershipLevel(int(monthsToAdd)),
-			}
-
-			if err := tx.Create(&membership).Error; err != nil {
-				return fmt.Errorf("create membership failed: %w", err)
-			}
-		} else if err != nil {
-			return fmt.Errorf("query membership failed: %w", err)
-		} else {
-			origStart := membership.StartTime
-			origEnd := membership.EndTime
-			beforeStart = &origStart
-			beforeEnd = &origEnd
-			fromType := membership.MembershipTypeID
-			fromTypeID = &fromType
-
-			if membership.MembershipTypeID != membershipType.ID {
-				renewalType = constant.MembershipRenewalTypeUpgrade
-			}
-
-			if membership.EndTime.Before(now) {
-				// 会员已过期，从当前时间重新开始
-				membership.StartTime = now
-				membership.EndTime = now.AddDate(0, monthsToAdd, 0)
-			} else {
-				// 会员未过期，在原有到期时间基础上续费
-				membership.EndTime = membership.EndTime.AddDate(0, monthsToAdd, 0)
-
-				// 计算剩余月数：原到期时间到当前时间的剩余月数
-				// 用于记录续费前还有多少个月剩余（升级时计算用）
-				remainingMonths := calculateRemainingMonths(origEnd, now)
-				remainingMonthsPtr = &remainingMonths
-			}
-
-			membership.MembershipTypeID = membershipType.ID
-			membership.IsActive = 1
-			newTotal := membership.TotalMonths + int32(monthsToAdd)
-			membership.TotalMonths = newTotal
-			membership.Level = calculateMembershipLevel(int(newTotal))
-
-			if err := tx.Save(&membership).Error; err != nil {
-				return fmt.Errorf("update membership failed: %w", err)
-			}
+			return nil, fmt.Errorf("membership type not found for vip_id=%d", membershipTypeID)
 		}
+		return nil, fmt.Errorf("query membership type failed: %w", err)
+	}
+
+	now := time.Now()
+
+	var current *model.LxtUserMembership
+	var membership model.LxtUserMembership
+	err := tx.Where("user_id = ?", grant.UserId).First(&membership).Error
+	if err == nil {
+		current = &membership
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, fmt.Errorf("query membership failed: %w", err)
+	}
 
-		orderID := paymentOrder.ID
-		renewalRecord := &model.LxtUserMembershipRenewal{
-			UserID:               paymentOrder.UserID,
-			OrderID:              &orderID,
-			FromMembershipTypeID: fromTypeID,
-			ToMembershipTypeID:   membershipType.ID,
-			RenewalType:          renewalType,
-			BeforeStartTime:      beforeStart,
-			BeforeEndTime:        beforeEnd,
-			AfterStartTime:       membership.StartTime,
-			AfterEndTime:         membership.EndTime,
-			RemainingMonths:      remainingMonthsPtr,
-			CalculatedMonths:     membership.TotalMonths,
-			Amount:               paymentOrder.Amount,
+	levels, err := l.membershipLevels()
+	if err != nil {
+		return nil, err
+	}
+
+	// 升级时剩余时长已在下单时折算抵扣，新会员从当前时间开始计算
+	projection, err := projectMembership(current, &membershipType, levels, grant.Prorated, now)
+	if err != nil {
+		return nil, err
+	}
+	renewalType := projection.RenewalType
+	if grant.RenewalType != 0 {
+		renewalType = grant.RenewalType
+	}
+
+	var (
+		beforeStart *time.Time
+		beforeEnd   *time.Time
+		fromTypeID  *int64
+	)
+
+	if current == nil {
+		membership = model.LxtUserMembership{
+			UserID:           grant.UserId,
+			MembershipTypeID: membershipType.ID,
+			StartTime:        projection.StartTime,
+			EndTime:          projection.EndTime,
+			IsActive:         1,
+			TotalMonths:      projection.TotalMonths,
+			Level:            projection.Level,
 		}
 
-		if err := tx.Create(renewalRecord).Error; err != nil {
-			return fmt.Errorf("create membership renewal failed: %w", err)
+		if err := tx.Create(&membership).Error; err != nil {
+			return nil, fmt.Errorf("create membership failed: %w", err)
 		}
+	} else {
+		origStart := membership.StartTime
+		origEnd := membership.EndTime
+		beforeStart = &origStart
+		beforeEnd = &origEnd
+		fromType := membership.MembershipTypeID
+		fromTypeID = &fromType
+
+		membership.MembershipTypeID = membershipType.ID
+		membership.StartTime = projection.StartTime
+		membership.EndTime = projection.EndTime
+		membership.IsActive = 1
+		membership.TotalMonths = projection.TotalMonths
+		membership.Level = projection.Level
+
+		if err := tx.Save(&membership).Error; err != nil {
+			return nil, fmt.Errorf("update membership failed: %w", err)
+		}
+	}
 
-		// 会员续费成功后，删除相关缓存
-		// 注意：这里在事务提交后执行，确保数据已持久化
-		// 如果事务回滚，这些操作也不会执行（因为函数会返回错误）
-		l.clearUserCacheAfterMembershipUpdate(paymentOrder.UserID)
+	orderID := grant.OrderId
+	renewalRecord := &model.LxtUserMembershipRenewal{
+		UserID:               grant.UserId,
+		OrderID:              &orderID,
+		FromMembershipTypeID: fromTypeID,
+		ToMembershipTypeID:   membershipType.ID,
+		RenewalType:          renewalType,
+		BeforeStartTime:      beforeStart,
+		BeforeEndTime:        beforeEnd,
+		AfterStartTime:       membership.StartTime,
+		AfterEndTime:         membership.EndTime,
+		RemainingMonths:      projection.RemainingMonths,
+		CalculatedMonths:     membership.TotalMonths,
+		Amount:               grant.Amount,
+	}
 
-		return nil
-	})
+	if err := tx.Create(renewalRecord).Error; err != nil {
+		return nil, fmt.Errorf("create membership renewal failed: %w", err)
+	}
+
+	return &membership, nil
 }
 
 // clearUserCacheAfterMembershipUpdate 清除用户相关缓存
-// 包括：会员 Redis 缓存和用户信息缓存
+// 包括：会员 Redis 缓存、会员权限缓存和用户信息缓存
 // 注意：用户信息本地缓存（userInfo:{userId}）在 user 服务中，payment 服务无法直接删除
 // 通过删除 Redis 缓存和发布事件来通知 user 服务清除本地缓存
-func (l *PaymentNotifyLogic) clearUserCacheAfterMembershipUpdate(userID int64) {
+func (l *BaseLogic) clearUserCacheAfterMembershipUpdate(userID int64) {
 	if l.svcCtx.Rds == nil {
 		return
 	}
@@ -555,7 +477,13 @@ func (l *PaymentNotifyLogic) clearUserCacheAfterMembershipUpdate(userID int64) {
 		l.Infof("Deleted membership cache for user %d", userID)
 	}
 
-	// 2. 删除用户信息 Redis 缓存（如果存在）
+	// 2. 删除会员权限 Redis 缓存
+	permissionCacheKey := redisutil.ReturnRedisKey(redisutil.UserMembershipPermission, userID)
+	if _, err = l.svcCtx.Rds.DelCtx(l.ctx, permissionCacheKey); err != nil {
+		l.Errorf("Failed to delete membership permission cache for user %d: %v", userID, err)
+	}
+
+	// 3. 删除用户信息 Redis 缓存（如果存在）
 	userInfoCacheKey := redisutil.ReturnRedisKey(redisutil.ApiUserInfoSet, nil)
 	_, err = l.svcCtx.Rds.Hdel(userInfoCacheKey, gconv.String(userID))
 	if err != nil {
@@ -565,33 +493,3 @@ func (l *PaymentNotifyLogic) clearUserCacheAfterMembershipUpdate(userID int64) {
 	}
 
 }
-
-// calculateMembershipLevel 按累计月数计算会员等级
-func calculateMembershipLevel(totalMonths int) int32 {
-	switch {
-	case totalMonths <= 3:
-		return 1
-	case totalMonths <= 6:
-		return 2
-	case totalMonths <= 12:
-		return 3
-	case totalMonths <= 24:
-		return 4
-	default:
-		return 5
-	}
-}
-
-// 简单的浮点数解析
-func parseFloat(s string) (float64, error) {
-	if strings.TrimSpace(s) == "" {
-		return 0, fmt.Errorf("empty string")
-	}
-
-	value, err := strconv.ParseFloat(s, 64)
-	if err != nil {
-		return 0, err
-	}
-
-	return value, nil
-}
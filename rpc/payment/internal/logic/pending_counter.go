@@ -3,34 +3,102 @@ package logic
 import (
 	"context"
 	"fmt"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/logx"
 	"github.com/zeromicro/go-zero/core/stores/redis"
 	redisutil "lxtian-blog/common/pkg/redis"
-	"strconv"
-	"time"
+	"lxtian-blog/rpc/payment/internal/config"
 )
 
-// adjustUserDailyPendingCount 在 Redis 中调整用户某天的「待支付订单」计数。
-// delta < 0 时执行安全的减 1：仅当 key 存在且当前值 > 0 才进行 DECR，避免产生负数或错误的 key。
-func adjustUserDailyPendingCount(ctx context.Context, rds *redis.Redis, userID int64, createdAt time.Time, delta int64) {
-	if rds == nil || delta >= 0 {
-		// 当前只在支付成功/取消/关闭时使用减 1 逻辑
-		return
+// 待支付订单预占：每个用户（捐赠按用户或IP）一个有序集合，成员为商户订单号，分值为预占时间（毫秒）
+// 统计窗口为滑动窗口，超过窗口的预占自动失效，不再按自然日划分
+
+// reservePendingScript 清理窗口外的预占后检查数量，未达上限时预占，已预占的订单刷新预占时间
+// KEYS[1] 预占集合，ARGV[1] 当前时间，ARGV[2] 窗口长度，ARGV[3] 上限，ARGV[4] 商户订单号
+// 返回 1 表示预占成功，0 表示已达上限
+var reservePendingScript = redis.NewScript(`
+local now = tonumber(ARGV[1])
+local window = tonumber(ARGV[2])
+redis.call('ZREMRANGEBYSCORE', KEYS[1], '-inf', now - window)
+if not redis.call('ZSCORE', KEYS[1], ARGV[4]) and redis.call('ZCARD', KEYS[1]) >= tonumber(ARGV[3]) then
+	return 0
+end
+redis.call('ZADD', KEYS[1], now, ARGV[4])
+redis.call('PEXPIRE', KEYS[1], window)
+return 1
+`)
+
+// releasePendingScript 释放订单的预占，同时清理窗口外的预占，重复释放无副作用
+// KEYS[1] 预占集合，ARGV[1] 当前时间，ARGV[2] 窗口长度，ARGV[3] 商户订单号
+// 返回实际释放的数量
+var releasePendingScript = redis.NewScript(`
+redis.call('ZREMRANGEBYSCORE', KEYS[1], '-inf', tonumber(ARGV[1]) - tonumber(ARGV[2]))
+return redis.call('ZREM', KEYS[1], ARGV[3])
+`)
+
+// userPendingScope 会员/商城订单的预占范围
+func userPendingScope(userID int64) string {
+	return fmt.Sprintf("user:%d", userID)
+}
+
+// donatePendingScope 捐赠订单的预占范围，未登录用户按IP限制
+func donatePendingScope(userID int64, clientIP string) string {
+	if userID > 0 {
+		return fmt.Sprintf("donate:user:%d", userID)
+	}
+	return fmt.Sprintf("donate:ip:%s", clientIP)
+}
+
+// reservePendingOrder 为订单预占一个待支付名额，返回 false 表示窗口内待支付订单已达上限
+// Redis 不可用时不做限制，避免影响下单
+func reservePendingOrder(ctx context.Context, rds *redis.Redis, limit config.PendingLimitConfig, scope, outTradeNo string) bool {
+	if rds == nil || limit.Limit <= 0 {
+		return true
+	}
+
+	key := redisutil.ReturnRedisKey(redisutil.PendingOrderReserve, scope)
+	result, err := rds.ScriptRunCtx(ctx, reservePendingScript, []string{key},
+		time.Now().UnixMilli(), int64(limit.Window)*1000, limit.Limit, outTradeNo)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("Failed to reserve pending order: key=%s, outTradeNo=%s, err=%v", key, outTradeNo, err)
+		return true
 	}
 
-	dateStr := createdAt.Format("2006-01-02")
-	key := fmt.Sprintf("%spayment:pending:count:%d:%s", redisutil.KeyPrefix, userID, dateStr)
+	reserved, ok := result.(int64)
+	return !ok || reserved == 1
+}
 
-	// 读取当前值，避免对不存在的 key 做 DECR（会变成 -1）
-	val, err := rds.GetCtx(ctx, key)
-	if err != nil || val == "" {
+// releasePendingOrder 释放订单的待支付名额，在订单支付成功、关闭、取消或创建失败时调用
+func releasePendingOrder(ctx context.Context, rds *redis.Redis, limit config.PendingLimitConfig, scope, outTradeNo string) {
+	if rds == nil {
 		return
 	}
 
-	current, err := strconv.ParseInt(val, 10, 64)
-	if err != nil || current <= 0 {
-		return
+	key := redisutil.ReturnRedisKey(redisutil.PendingOrderReserve, scope)
+	_, err := rds.ScriptRunCtx(ctx, releasePendingScript, []string{key},
+		time.Now().UnixMilli(), int64(limit.Window)*1000, outTradeNo)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("Failed to release pending order: key=%s, outTradeNo=%s, err=%v", key, outTradeNo, err)
 	}
+}
 
-	_, _ = rds.DecrCtx(ctx, key)
+// reserveUserPendingOrder 预占会员/商城订单的待支付名额
+func (l *BaseLogic) reserveUserPendingOrder(userID int64, outTradeNo string) bool {
+	return reservePendingOrder(l.ctx, l.svcCtx.Rds, l.svcCtx.Config.PendingLimit, userPendingScope(userID), outTradeNo)
 }
 
+// releaseUserPendingOrder 释放会员/商城订单的待支付名额
+func (l *BaseLogic) releaseUserPendingOrder(userID int64, outTradeNo string) {
+	releasePendingOrder(l.ctx, l.svcCtx.Rds, l.svcCtx.Config.PendingLimit, userPendingScope(userID), outTradeNo)
+}
+
+// reserveDonatePendingOrder 预占捐赠订单的待支付名额
+func (l *BaseLogic) reserveDonatePendingOrder(userID int64, clientIP, outTradeNo string) bool {
+	return reservePendingOrder(l.ctx, l.svcCtx.Rds, l.svcCtx.Config.DonatePendingLimit, donatePendingScope(userID, clientIP), outTradeNo)
+}
+
+// releaseDonatePendingOrder 释放捐赠订单的待支付名额
+func (l *BaseLogic) releaseDonatePendingOrder(userID int64, clientIP, outTradeNo string) {
+	releasePendingOrder(l.ctx, l.svcCtx.Rds, l.svcCtx.Config.DonatePendingLimit, donatePendingScope(userID, clientIP), outTradeNo)
+}
@@ -2,13 +2,16 @@ package logic
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"lxtian-blog/common/constant"
 	paymentSvc "lxtian-blog/common/repository/payment_repo"
 	"time"
 
 	"lxtian-blog/common/model"
-	"lxtian-blog/common/pkg/alipay"
+	"lxtian-blog/common/pkg/payprovider"
+	"lxtian-blog/common/pkg/utils"
+	"lxtian-blog/rpc/payment/internal/orderstate"
 	"lxtian-blog/rpc/payment/internal/svc"
 	"lxtian-blog/rpc/payment/pb/payment"
 )
@@ -59,21 +62,24 @@ func (l *QueryPaymentLogic) QueryPayment(in *payment.QueryPaymentReq) (*payment.
 		return l.buildQueryResponse(paymentOrder), nil
 	}
 
-	// 调用支付宝API查询最新状态
-	alipayReq := &alipay.TradeQueryRequest{
+	// 按订单的支付方式调用支付渠道查询最新状态
+	provider, err := l.paymentProvider(paymentOrder.PayType)
+	if err != nil {
+		l.Errorf("Unsupported pay type for query: paymentId=%s, payType=%d", paymentOrder.PaymentID, paymentOrder.PayType)
+		return l.buildQueryResponse(paymentOrder), nil
+	}
+	queryResult, err := provider.QueryPayment(l.ctx, &payprovider.QueryRequest{
 		OutTradeNo: paymentOrder.OutTradeNo,
 		TradeNo:    paymentOrder.TradeNo,
-	}
-
-	alipayResp, err := l.svcCtx.AlipayClient.QueryPayment(alipayReq)
+	})
 	if err != nil {
-		l.Errorf("Failed to query alipay payment_repo: %v", err)
-		// 即使支付宝查询失败，也返回本地数据库的信息
+		l.Errorf("Failed to query %s payment: %v", provider.Name(), err)
+		// 即使渠道查询失败，也返回本地数据库的信息
 		return l.buildQueryResponse(paymentOrder), nil
 	}
 
 	// 更新本地订单状态
-	err = l.updatePaymentStatus(paymentOrder, alipayResp)
+	err = l.updatePaymentStatus(paymentOrder, queryResult)
 	if err != nil {
 		l.Errorf("Failed to update payment_repo status: %v", err)
 	}
@@ -89,43 +95,49 @@ func (l *QueryPaymentLogic) QueryPayment(in *payment.QueryPaymentReq) (*payment.
 }
 
 // 更新支付状态
-func (l *QueryPaymentLogic) updatePaymentStatus(paymentOrder *model.LxtPaymentOrder, alipayResp *alipay.TradeQueryResponse) error {
-	// 根据支付宝返回的交易状态更新本地订单状态
-	switch alipayResp.TradeStatus {
-	case "TRADE_SUCCESS", "TRADE_FINISHED":
+func (l *QueryPaymentLogic) updatePaymentStatus(paymentOrder *model.LxtPaymentOrder, queryResult *payprovider.QueryResult) error {
+	// 根据渠道返回的交易状态更新本地订单状态
+	switch queryResult.TradeStatus {
+	case constant.TradeStatusSuccess, constant.TradeStatusFinished:
 		// 支付成功
-		if paymentOrder.Status != constant.PaymentStatusPaid {
-			// 解析支付时间
-			var gmtPayment *time.Time
-			if alipayResp.GmtPayment != "" {
-				if t, err := time.Parse("2006-01-02 15:04:05", alipayResp.GmtPayment); err == nil {
-					gmtPayment = &t
-				}
-			}
-
-			err := l.paymentService.UpdatePaymentOrderTradeInfo(
-				l.ctx,
-				paymentOrder.PaymentID,
-				alipayResp.TradeNo,
-				alipayResp.TradeStatus,
-				alipayResp.BuyerUserId,
-				alipayResp.BuyerLogonId,
-				alipayResp.ReceiptAmount,
-				gmtPayment,
-			)
-			if err != nil {
-				return err
-			}
+		changed, err := l.markOrderPaid(
+			paymentOrder.PaymentID,
+			queryResult.TradeNo,
+			queryResult.TradeStatus,
+			queryResult.BuyerUserId,
+			queryResult.BuyerLogonId,
+			queryResult.ReceiptAmount,
+			queryResult.PayTime,
+		)
+		if errors.Is(err, orderstate.ErrInvalidTransition) {
+			// 已退款的订单查询结果仍为交易成功，保持退款状态
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if changed {
+			l.releaseUserPendingOrder(paymentOrder.UserID, paymentOrder.OutTradeNo)
+		}
+	case constant.TradeStatusClosed:
+		// 交易关闭，已支付订单全额退款后同样为交易关闭，由状态机拒绝覆盖
+		changed, err := l.transitionOrder(&orderstate.Transition{
+			PaymentId: paymentOrder.PaymentID,
+			To:        constant.PaymentStatusClosed,
+			Event:     constant.OrderEventTradeClosed,
+			Remark:    "query",
+			Updates:   map[string]interface{}{"trade_status": queryResult.TradeStatus, "close_time": time.Now()},
+		})
+		if errors.Is(err, orderstate.ErrInvalidTransition) {
+			return nil
+		}
+		if err != nil {
+			return err
 		}
-	case "TRADE_CLOSED":
-		// 交易关闭
-		if paymentOrder.Status != constant.PaymentStatusClosed {
-			err := l.paymentService.UpdatePaymentOrderStatus(l.ctx, paymentOrder.PaymentID, constant.PaymentStatusClosed)
-			if err != nil {
-				return err
-			}
+		if changed {
+			l.releaseUserPendingOrder(paymentOrder.UserID, paymentOrder.OutTradeNo)
 		}
-	case "WAIT_BUYER_PAY":
+	case constant.TradeStatusWaitBuyerPay:
 		// 等待买家付款，保持待支付状态
 		// 不需要更新状态
 	}
@@ -141,7 +153,7 @@ func (l *QueryPaymentLogic) buildQueryResponse(paymentOrder *model.LxtPaymentOrd
 		OutTradeNo:   paymentOrder.OutTradeNo,
 		TradeNo:      paymentOrder.TradeNo,
 		TradeStatus:  paymentOrder.TradeStatus,
-		TotalAmount:  paymentOrder.Amount,
+		TotalAmount:  utils.FormatAmount(paymentOrder.Amount),
 		BuyerUserId:  paymentOrder.BuyerUserID,
 		BuyerLogonId: paymentOrder.BuyerLogonID,
 		Message:      "查询成功",
@@ -0,0 +1,86 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"lxtian-blog/common/constant"
+	"lxtian-blog/common/pkg/utils"
+	"lxtian-blog/common/repository/user_repo"
+	"lxtian-blog/rpc/payment/internal/svc"
+	"lxtian-blog/rpc/payment/pb/payment"
+)
+
+type QuoteMembershipLogic struct {
+	*BaseLogic
+}
+
+func NewQuoteMembershipLogic(ctx context.Context, svcCtx *svc.ServiceContext) *QuoteMembershipLogic {
+	return &QuoteMembershipLogic{
+		BaseLogic: NewBaseLogic(ctx, svcCtx),
+	}
+}
+
+// QuoteMembership 按下单时的计价规则计算会员开通/续费/升级的应付金额，
+// 并按支付成功后开通会员的规则给出到期时间和会员等级；下单时须携带报价ID
+func (l *QuoteMembershipLogic) QuoteMembership(in *payment.QuoteMembershipReq) (*payment.QuoteMembershipResp, error) {
+	if in.UserId == 0 {
+		return nil, fmt.Errorf("用户ID不能为空")
+	}
+	userId := int64(in.UserId)
+
+	price, err := calculateMembershipPrice(l.ctx, l.svcCtx, userId, in.VipId)
+	if err != nil {
+		return nil, err
+	}
+	if in.CouponCode != "" {
+		if _, err := applyCoupon(l.ctx, l.svcCtx, in.CouponCode, userId, constant.BuyTypeMembership, price); err != nil {
+			return nil, err
+		}
+	}
+
+	membershipType, err := user_repo.NewMembershipTypeRepository(l.svcCtx.DB).GetByID(l.ctx, uint64(price.ItemId))
+	if err != nil {
+		return nil, fmt.Errorf("会员类型不存在: %w", err)
+	}
+	levels, err := l.membershipLevels()
+	if err != nil {
+		return nil, err
+	}
+	current := l.currentMembership(userId)
+	projection, err := projectMembership(current, membershipType, levels, price.Discount.IsPositive(), time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	quoteId, expireAt, err := l.saveMembershipQuote(newMembershipQuote(userId, price, current))
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &payment.QuoteMembershipResp{
+		QuoteId:          quoteId,
+		ExpireAt:         expireAt.Format("2006-01-02 15:04:05"),
+		MembershipTypeId: membershipType.ID,
+		MembershipName:   membershipType.Name,
+		RenewalType:      projection.RenewalType,
+		RemainingDays:    price.RemainingDays,
+		OriginalAmount:   utils.FormatAmount(price.OriginalAmount),
+		Credit:           utils.FormatAmount(price.Discount),
+		CouponCode:       price.CouponCode,
+		CouponDiscount:   utils.FormatAmount(price.CouponDiscount),
+		Amount:           utils.FormatAmount(price.Amount),
+		StartTime:        projection.StartTime.Format("2006-01-02 15:04:05"),
+		EndTime:          projection.EndTime.Format("2006-01-02 15:04:05"),
+		TotalMonths:      projection.TotalMonths,
+		Level:            projection.Level,
+	}
+	if current != nil && current.EndTime.After(time.Now()) {
+		resp.FromTypeId = current.MembershipTypeID
+	}
+
+	l.Infof("Quoted membership: quoteId=%s, userId=%d, vipId=%d, amount=%s, endTime=%s",
+		quoteId, userId, membershipType.ID, resp.Amount, resp.EndTime)
+	return resp, nil
+}
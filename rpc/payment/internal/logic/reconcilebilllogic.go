@@ -0,0 +1,494 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"lxtian-blog/common/constant"
+	"lxtian-blog/common/model"
+	"lxtian-blog/common/pkg/alipay"
+	redisutil "lxtian-blog/common/pkg/redis"
+	"lxtian-blog/common/repository/payment_repo"
+	"lxtian-blog/rpc/payment/internal/svc"
+	"lxtian-blog/rpc/payment/pb/payment"
+
+	"github.com/shopspring/decimal"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+)
+
+// 对账锁的过期时间（秒）
+const reconcileLockSeconds = 600
+
+// ReconcileBillLogic 支付宝账单对账
+type ReconcileBillLogic struct {
+	*BaseLogic
+	orderService       payment_repo.PaymentOrderRepository
+	refundService      payment_repo.LxtPaymentRefundsRepo
+	batchService       payment_repo.LxtPaymentReconciliationsRepo
+	discrepancyService payment_repo.LxtPaymentReconcileDiscrepanciesRepo
+}
+
+func NewReconcileBillLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ReconcileBillLogic {
+	return &ReconcileBillLogic{
+		BaseLogic:          NewBaseLogic(ctx, svcCtx),
+		orderService:       payment_repo.NewPaymentOrderRepository(svcCtx.DB),
+		refundService:      payment_repo.NewLxtPaymentRefundsRepo(svcCtx.DB),
+		batchService:       payment_repo.NewLxtPaymentReconciliationsRepo(svcCtx.DB),
+		discrepancyService: payment_repo.NewLxtPaymentReconcileDiscrepanciesRepo(svcCtx.DB),
+	}
+}
+
+// ReconcileBill 导入支付宝账单并与本地订单对账，差异记录保存后由后台处理
+// 同一账单日期重复对账时，未处理的差异会被新批次的结果替换，已处理或已忽略的差异不会重复生成
+func (l *ReconcileBillLogic) ReconcileBill(in *payment.ReconcileBillReq) (*payment.ReconcileBillResp, error) {
+	billDay, err := time.ParseInLocation("2006-01-02", in.BillDate, time.Local)
+	if err != nil {
+		return &payment.ReconcileBillResp{
+			Message: "账单日期格式错误，应为yyyy-MM-dd",
+		}, fmt.Errorf("invalid bill_date: %s", in.BillDate)
+	}
+
+	// 同一账单日期同时只允许一个对账任务
+	if l.svcCtx.Rds != nil {
+		lock := redis.NewRedisLock(l.svcCtx.Rds, redisutil.ReturnRedisKey(redisutil.PaymentReconcileLock, in.BillDate))
+		lock.SetExpire(reconcileLockSeconds)
+		ok, err := lock.AcquireCtx(l.ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to acquire reconcile lock: %w", err)
+		}
+		if !ok {
+			return &payment.ReconcileBillResp{
+				Message: "该账单日期正在对账",
+			}, fmt.Errorf("reconcile is running for bill_date %s", in.BillDate)
+		}
+		defer func() {
+			if _, err := lock.ReleaseCtx(l.ctx); err != nil {
+				l.Errorf("Failed to release reconcile lock: billDate=%s, err=%v", in.BillDate, err)
+			}
+		}()
+	}
+
+	batch := &model.LxtPaymentReconciliation{
+		BatchID:  l.generateReconcileBatchId(),
+		BillDate: in.BillDate,
+		Source:   constant.ReconcileSourceAlipay,
+		Status:   constant.ReconcileStatusRunning,
+	}
+	if in.FilePath != "" {
+		batch.Source = constant.ReconcileSourceFile
+	}
+	if err := l.batchService.Create(l.ctx, batch); err != nil {
+		l.Errorf("Failed to create reconcile batch: %v", err)
+		return nil, fmt.Errorf("failed to create reconcile batch: %w", err)
+	}
+
+	records, err := l.loadBillRecords(in)
+	if err != nil {
+		l.Errorf("Failed to load bill: batchId=%s, billDate=%s, err=%v", batch.BatchID, in.BillDate, err)
+		l.failBatch(batch.BatchID, err)
+		return &payment.ReconcileBillResp{
+			BatchId: batch.BatchID,
+			Message: "读取账单失败: " + err.Error(),
+		}, fmt.Errorf("failed to load bill: %w", err)
+	}
+
+	discrepancies, matched, billAmount, err := l.reconcile(records, billDay)
+	if err != nil {
+		l.Errorf("Failed to reconcile bill: batchId=%s, err=%v", batch.BatchID, err)
+		l.failBatch(batch.BatchID, err)
+		return &payment.ReconcileBillResp{
+			BatchId: batch.BatchID,
+			Message: "对账失败",
+		}, fmt.Errorf("failed to reconcile bill: %w", err)
+	}
+
+	// 保存差异：替换该日期未处理的差异，跳过已处理或已忽略的差异
+	var saved []*model.LxtPaymentReconcileDiscrepancy
+	err = l.discrepancyService.WithTransaction(l.ctx, func(txCtx context.Context) error {
+		closed, err := l.discrepancyService.FindClosedByBillDate(txCtx, in.BillDate)
+		if err != nil {
+			return err
+		}
+		closedKeys := make(map[string]struct{}, len(closed))
+		for _, d := range closed {
+			closedKeys[discrepancyKey(d)] = struct{}{}
+		}
+
+		if err := l.discrepancyService.DeletePendingByBillDate(txCtx, in.BillDate); err != nil {
+			return err
+		}
+
+		for _, d := range discrepancies {
+			if _, ok := closedKeys[discrepancyKey(d)]; ok {
+				continue
+			}
+			d.BatchID = batch.BatchID
+			d.BillDate = in.BillDate
+			d.ResolveStatus = constant.DiscrepancyResolvePending
+			saved = append(saved, d)
+		}
+		if len(saved) > 0 {
+			if err := l.discrepancyService.CreateBatch(txCtx, saved); err != nil {
+				return err
+			}
+		}
+
+		return l.batchService.UpdateByBatchId(txCtx, batch.BatchID, map[string]interface{}{
+			"status":            constant.ReconcileStatusSuccess,
+			"total_count":       len(records),
+			"matched_count":     matched,
+			"discrepancy_count": len(saved),
+			"bill_amount":       billAmount,
+		})
+	})
+	if err != nil {
+		l.Errorf("Failed to save reconcile result: batchId=%s, err=%v", batch.BatchID, err)
+		l.failBatch(batch.BatchID, err)
+		return &payment.ReconcileBillResp{
+			BatchId: batch.BatchID,
+			Message: "保存对账结果失败",
+		}, fmt.Errorf("failed to save reconcile result: %w", err)
+	}
+
+	l.Infof("Reconciled bill: batchId=%s, billDate=%s, total=%d, matched=%d, discrepancies=%d",
+		batch.BatchID, in.BillDate, len(records), matched, len(saved))
+
+	return &payment.ReconcileBillResp{
+		BatchId:          batch.BatchID,
+		TotalCount:       int64(len(records)),
+		MatchedCount:     int64(matched),
+		DiscrepancyCount: int64(len(saved)),
+		Message:          "对账完成",
+	}, nil
+}
+
+// ReconcileYesterday 对账前一天的账单，已对账完成的日期直接跳过
+func (l *ReconcileBillLogic) ReconcileYesterday() error {
+	billDate := time.Now().AddDate(0, 0, -1).Format("2006-01-02")
+	done, err := l.batchService.ExistsSuccessByBillDate(l.ctx, billDate)
+	if err != nil {
+		return err
+	}
+	if done {
+		return nil
+	}
+
+	_, err = l.ReconcileBill(&payment.ReconcileBillReq{BillDate: billDate})
+	return err
+}
+
+// loadBillRecords 读取本地账单文件，或从支付宝下载业务账单
+func (l *ReconcileBillLogic) loadBillRecords(in *payment.ReconcileBillReq) ([]*alipay.BillRecord, error) {
+	if in.FilePath != "" {
+		path, err := l.resolveBillFile(in.FilePath)
+		if err != nil {
+			return nil, err
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		if strings.HasSuffix(strings.ToLower(path), ".zip") {
+			return alipay.ParseBillZip(data)
+		}
+		return alipay.ParseBillCSV(data)
+	}
+
+	urlResp, err := l.svcCtx.AlipayClient.QueryBillDownloadUrl(&alipay.BillDownloadUrlQueryRequest{
+		BillType: "trade",
+		BillDate: in.BillDate,
+	})
+	if err != nil {
+		return nil, err
+	}
+	data, err := l.svcCtx.AlipayClient.DownloadBill(urlResp.BillDownloadUrl)
+	if err != nil {
+		return nil, err
+	}
+	return alipay.ParseBillZip(data)
+}
+
+// resolveBillFile 将账单文件路径解析为账单目录下的文件，拒绝绝对路径和跳出账单目录的路径
+func (l *ReconcileBillLogic) resolveBillFile(name string) (string, error) {
+	dir := l.svcCtx.Config.ReconcileBillDir
+	if dir == "" {
+		return "", errors.New("未配置本地账单目录")
+	}
+	if filepath.IsAbs(name) || !filepath.IsLocal(name) {
+		return "", errors.New("账单文件路径必须是账单目录下的相对路径")
+	}
+	return filepath.Join(dir, filepath.Clean(name)), nil
+}
+
+// reconcile 按商户订单号/退款单号核对账单明细与本地订单、捐赠订单和退款记录
+// 返回差异列表、核对一致的账单明细数和账单交易总额
+func (l *ReconcileBillLogic) reconcile(records []*alipay.BillRecord, billDay time.Time) ([]*model.LxtPaymentReconcileDiscrepancy, int, decimal.Decimal, error) {
+	dayStart, dayEnd := billDay, billDay.AddDate(0, 0, 1)
+	billAmount := decimal.Zero
+
+	outTradeNos := make([]string, 0, len(records))
+	for _, record := range records {
+		outTradeNos = append(outTradeNos, record.OutTradeNo)
+	}
+
+	orders, err := l.orderService.FindByOutTradeNos(l.ctx, outTradeNos)
+	if err != nil {
+		return nil, 0, billAmount, fmt.Errorf("query payment orders failed: %w", err)
+	}
+	ordersByTradeNo := make(map[string]*model.LxtPaymentOrder, len(orders))
+	for _, order := range orders {
+		ordersByTradeNo[order.OutTradeNo] = order
+	}
+
+	var donations []*model.TxyOrder
+	if len(outTradeNos) > 0 {
+		if err := l.svcCtx.DB.WithContext(l.ctx).Where("out_trade_no IN ?", outTradeNos).Find(&donations).Error; err != nil {
+			return nil, 0, billAmount, fmt.Errorf("query donate orders failed: %w", err)
+		}
+	}
+	donationsByTradeNo := make(map[string]*model.TxyOrder, len(donations))
+	for _, donation := range donations {
+		donationsByTradeNo[donation.OutTradeNo] = donation
+	}
+
+	refunds, err := l.refundService.FindPaymentRefundsByOutTradeNos(l.ctx, outTradeNos)
+	if err != nil {
+		return nil, 0, billAmount, fmt.Errorf("query payment refunds failed: %w", err)
+	}
+	refundsByRequestNo := make(map[string]*model.LxtPaymentRefund, len(refunds))
+	refundsByTradeNo := make(map[string][]*model.LxtPaymentRefund)
+	for _, refund := range refunds {
+		refundsByRequestNo[refund.OutRequestNo] = refund
+		refundsByTradeNo[refund.OutTradeNo] = append(refundsByTradeNo[refund.OutTradeNo], refund)
+	}
+
+	var (
+		discrepancies []*model.LxtPaymentReconcileDiscrepancy
+		matched       int
+		seenTrades    = make(map[string]struct{})
+		seenRefunds   = make(map[string]struct{})
+	)
+	for _, record := range records {
+		var found []*model.LxtPaymentReconcileDiscrepancy
+		switch record.BizType {
+		case alipay.BillBizTypeTrade:
+			billAmount = billAmount.Add(record.TotalAmount)
+			seenTrades[record.OutTradeNo] = struct{}{}
+			found = l.checkTrade(record, ordersByTradeNo[record.OutTradeNo], donationsByTradeNo[record.OutTradeNo])
+		case alipay.BillBizTypeRefund:
+			refund := matchBillRefund(record, refundsByRequestNo, refundsByTradeNo, seenRefunds)
+			if refund != nil {
+				seenRefunds[refund.RefundID] = struct{}{}
+			}
+			found = checkRefund(record, refund)
+		default:
+			// 其他业务类型不参与对账
+		}
+		if len(found) == 0 {
+			matched++
+		}
+		discrepancies = append(discrepancies, found...)
+	}
+
+	// 反向核对：本地当天已支付/已退款但账单中不存在的记录，只核对支付宝订单，其他支付方式不会出现在支付宝账单中
+	paidOrders, err := l.orderService.FindPaidByPayTime(l.ctx, constant.PayTypeAlipay, dayStart, dayEnd)
+	if err != nil {
+		return nil, 0, billAmount, fmt.Errorf("query paid orders failed: %w", err)
+	}
+	for _, order := range paidOrders {
+		if _, ok := seenTrades[order.OutTradeNo]; ok {
+			continue
+		}
+		discrepancies = append(discrepancies, &model.LxtPaymentReconcileDiscrepancy{
+			Type:        constant.DiscrepancyTypeMissingBill,
+			BizType:     alipay.BillBizTypeTrade,
+			OutTradeNo:  order.OutTradeNo,
+			TradeNo:     order.TradeNo,
+			LocalAmount: amountPtr(order.Amount),
+			LocalStatus: stringPtr(order.Status),
+			Detail:      "本地订单已支付，账单中无该交易",
+		})
+	}
+
+	var paidDonations []*model.TxyOrder
+	if err := l.svcCtx.DB.WithContext(l.ctx).
+		Where("status = ? AND pay_time >= ? AND pay_time < ? AND pay_type = ?", constant.PaymentStatusPaid, dayStart, dayEnd, constant.PayTypeAlipay).
+		Find(&paidDonations).Error; err != nil {
+		return nil, 0, billAmount, fmt.Errorf("query paid donate orders failed: %w", err)
+	}
+	for _, donation := range paidDonations {
+		if _, ok := seenTrades[donation.OutTradeNo]; ok {
+			continue
+		}
+		discrepancies = append(discrepancies, &model.LxtPaymentReconcileDiscrepancy{
+			Type:        constant.DiscrepancyTypeMissingBill,
+			BizType:     alipay.BillBizTypeTrade,
+			OutTradeNo:  donation.OutTradeNo,
+			TradeNo:     donation.TradeNo,
+			LocalAmount: amountPtr(donation.Amount),
+			LocalStatus: stringPtr(donation.Status),
+			Detail:      "本地捐赠订单已支付，账单中无该交易",
+		})
+	}
+
+	successRefunds, err := l.refundService.FindSuccessRefundsByRefundTime(l.ctx, constant.PayTypeAlipay, dayStart, dayEnd)
+	if err != nil {
+		return nil, 0, billAmount, fmt.Errorf("query success refunds failed: %w", err)
+	}
+	for _, refund := range successRefunds {
+		if _, ok := seenRefunds[refund.RefundID]; ok {
+			continue
+		}
+		discrepancies = append(discrepancies, &model.LxtPaymentReconcileDiscrepancy{
+			Type:         constant.DiscrepancyTypeMissingBill,
+			BizType:      alipay.BillBizTypeRefund,
+			OutTradeNo:   refund.OutTradeNo,
+			OutRequestNo: refund.OutRequestNo,
+			LocalAmount:  amountPtr(refund.RefundAmount),
+			LocalStatus:  stringPtr(refund.Status),
+			Detail:       "本地退款已成功，账单中无该退款",
+		})
+	}
+
+	return discrepancies, matched, billAmount, nil
+}
+
+// checkTrade 核对账单交易明细与本地订单或捐赠订单
+func (l *ReconcileBillLogic) checkTrade(record *alipay.BillRecord, order *model.LxtPaymentOrder, donation *model.TxyOrder) []*model.LxtPaymentReconcileDiscrepancy {
+	newDiscrepancy := func(discrepancyType, detail string, localAmount decimal.Decimal, localStatus string) *model.LxtPaymentReconcileDiscrepancy {
+		return &model.LxtPaymentReconcileDiscrepancy{
+			Type:        discrepancyType,
+			BizType:     record.BizType,
+			OutTradeNo:  record.OutTradeNo,
+			TradeNo:     record.TradeNo,
+			LocalAmount: amountPtr(localAmount),
+			BillAmount:  amountPtr(record.TotalAmount),
+			LocalStatus: stringPtr(localStatus),
+			Detail:      detail,
+		}
+	}
+
+	var (
+		localAmount decimal.Decimal
+		localStatus string
+		paid        bool
+	)
+	switch {
+	case order != nil:
+		localAmount, localStatus = order.Amount, order.Status
+		for _, status := range []string{constant.PaymentStatusPaid, constant.PaymentStatusPartialRefunded, constant.PaymentStatusRefunded} {
+			if order.Status == status {
+				paid = true
+			}
+		}
+	case donation != nil:
+		localAmount, localStatus = donation.Amount, donation.Status
+		paid = donation.Status == constant.PaymentStatusPaid
+	default:
+		return []*model.LxtPaymentReconcileDiscrepancy{{
+			Type:       constant.DiscrepancyTypeMissingLocal,
+			BizType:    record.BizType,
+			OutTradeNo: record.OutTradeNo,
+			TradeNo:    record.TradeNo,
+			BillAmount: amountPtr(record.TotalAmount),
+			Detail:     "账单交易在本地订单和捐赠订单中均不存在",
+		}}
+	}
+
+	var found []*model.LxtPaymentReconcileDiscrepancy
+	if !amountEqual(localAmount, record.TotalAmount) {
+		found = append(found, newDiscrepancy(constant.DiscrepancyTypeAmountMismatch, "订单金额与账单金额不一致", localAmount, localStatus))
+	}
+	if !paid {
+		found = append(found, newDiscrepancy(constant.DiscrepancyTypeStatusMismatch, "账单已收款，本地订单未支付", localAmount, localStatus))
+	}
+	return found
+}
+
+// matchBillRefund 查找账单退款明细对应的本地退款：优先按退款单号匹配，账单无退款单号时按订单号和金额匹配
+func matchBillRefund(record *alipay.BillRecord, byRequestNo map[string]*model.LxtPaymentRefund,
+	byTradeNo map[string][]*model.LxtPaymentRefund, seen map[string]struct{}) *model.LxtPaymentRefund {
+	if record.OutRequestNo != "" {
+		return byRequestNo[record.OutRequestNo]
+	}
+	for _, refund := range byTradeNo[record.OutTradeNo] {
+		if _, ok := seen[refund.RefundID]; ok {
+			continue
+		}
+		if amountEqual(refund.RefundAmount, record.TotalAmount.Neg()) {
+			return refund
+		}
+	}
+	return nil
+}
+
+// checkRefund 核对账单退款明细与本地退款记录，账单中退款金额为负数
+func checkRefund(record *alipay.BillRecord, refund *model.LxtPaymentRefund) []*model.LxtPaymentReconcileDiscrepancy {
+	billAmount := record.TotalAmount.Neg()
+	if refund == nil {
+		return []*model.LxtPaymentReconcileDiscrepancy{{
+			Type:         constant.DiscrepancyTypeMissingLocal,
+			BizType:      record.BizType,
+			OutTradeNo:   record.OutTradeNo,
+			TradeNo:      record.TradeNo,
+			OutRequestNo: record.OutRequestNo,
+			BillAmount:   amountPtr(billAmount),
+			Detail:       "账单退款在本地退款记录中不存在",
+		}}
+	}
+
+	newDiscrepancy := func(discrepancyType, detail string) *model.LxtPaymentReconcileDiscrepancy {
+		return &model.LxtPaymentReconcileDiscrepancy{
+			Type:         discrepancyType,
+			BizType:      record.BizType,
+			OutTradeNo:   record.OutTradeNo,
+			TradeNo:      record.TradeNo,
+			OutRequestNo: refund.OutRequestNo,
+			LocalAmount:  amountPtr(refund.RefundAmount),
+			BillAmount:   amountPtr(billAmount),
+			LocalStatus:  stringPtr(refund.Status),
+			Detail:       detail,
+		}
+	}
+
+	var found []*model.LxtPaymentReconcileDiscrepancy
+	if !amountEqual(refund.RefundAmount, billAmount) {
+		found = append(found, newDiscrepancy(constant.DiscrepancyTypeAmountMismatch, "退款金额与账单金额不一致"))
+	}
+	if refund.Status != constant.RefundStatusSuccess {
+		found = append(found, newDiscrepancy(constant.DiscrepancyTypeStatusMismatch, "账单已退款，本地退款未成功"))
+	}
+	return found
+}
+
+// failBatch 标记对账批次失败
+func (l *ReconcileBillLogic) failBatch(batchId string, cause error) {
+	if err := l.batchService.UpdateByBatchId(l.ctx, batchId, map[string]interface{}{
+		"status":        constant.ReconcileStatusFailed,
+		"error_message": cause.Error(),
+	}); err != nil {
+		l.Errorf("Failed to mark reconcile batch failed: batchId=%s, err=%v", batchId, err)
+	}
+}
+
+// discrepancyKey 差异唯一标识，用于重新对账时跳过已处理的差异
+func discrepancyKey(d *model.LxtPaymentReconcileDiscrepancy) string {
+	return strings.Join([]string{d.Type, d.BizType, d.OutTradeNo, d.OutRequestNo}, "|")
+}
+
+func amountEqual(a, b decimal.Decimal) bool {
+	return a.Round(2).Equal(b.Round(2))
+}
+
+func amountPtr(v decimal.Decimal) *decimal.Decimal {
+	return &v
+}
+
+func stringPtr(v string) *string {
+	return &v
+}
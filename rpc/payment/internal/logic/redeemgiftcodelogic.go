@@ -0,0 +1,184 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"lxtian-blog/common/constant"
+	"lxtian-blog/common/model"
+	"lxtian-blog/common/pkg/utils"
+	"lxtian-blog/rpc/payment/internal/svc"
+	"lxtian-blog/rpc/payment/pb/payment"
+
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type RedeemGiftCodeLogic struct {
+	*BaseLogic
+}
+
+func NewRedeemGiftCodeLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RedeemGiftCodeLogic {
+	return &RedeemGiftCodeLogic{
+		BaseLogic: NewBaseLogic(ctx, svcCtx),
+	}
+}
+
+// RedeemGiftCode 兑换礼品码：生成一笔零元已支付订单，会员礼品码同时开通或续费会员
+// 礼品码在事务中加行锁校验并标记为已兑换，同一礼品码并发兑换时只有一次成功
+func (l *RedeemGiftCodeLogic) RedeemGiftCode(in *payment.RedeemGiftCodeReq) (*payment.RedeemGiftCodeResp, error) {
+	if in.UserId == 0 {
+		return nil, fmt.Errorf("用户ID不能为空")
+	}
+	code := utils.NormalizeGiftCode(in.Code)
+	if code == "" {
+		return nil, fmt.Errorf("礼品码不能为空")
+	}
+
+	userId := int64(in.UserId)
+	var (
+		order      *model.LxtPaymentOrder
+		membership *model.LxtUserMembership
+	)
+	err := l.svcCtx.DB.WithContext(l.ctx).Transaction(func(tx *gorm.DB) error {
+		var giftCode model.LxtPaymentGiftCode
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("code = ?", code).
+			First(&giftCode).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return fmt.Errorf("礼品码不存在")
+			}
+			return fmt.Errorf("lock gift code failed: %w", err)
+		}
+
+		now := time.Now()
+		switch giftCode.Status {
+		case constant.GiftCodeStatusUnused:
+		case constant.GiftCodeStatusRedeemed:
+			return fmt.Errorf("礼品码已被兑换")
+		default:
+			return fmt.Errorf("礼品码已失效")
+		}
+		if giftCode.ExpireAt != nil && !giftCode.ExpireAt.After(now) {
+			return fmt.Errorf("礼品码已过期")
+		}
+
+		subject, err := giftCodeSubject(tx, &giftCode)
+		if err != nil {
+			return err
+		}
+
+		order = &model.LxtPaymentOrder{
+			GoodsID:    int32(giftCode.GoodsID),
+			Quantity:   1,
+			PaymentID:  l.generatePaymentId(),
+			OrderSn:    fmt.Sprintf("%d", utils.Snowflake()),
+			OutTradeNo: fmt.Sprintf("GC%d", utils.Snowflake()),
+			UserID:     userId,
+			Amount:     decimal.Zero,
+			Subject:    subject,
+			Status:     constant.PaymentStatusPaid,
+			PayType:    constant.PayTypeGiftCode,
+			BuyType:    giftCode.BuyType,
+			ClientIP:   in.ClientIp,
+			Remark:     "礼品码:" + giftCode.Code,
+			PayTime:    &now,
+		}
+		if giftCode.BuyType == constant.BuyTypeMembership {
+			// 会员订单统一以 vip_id 记录会员类型
+			order.VipID = int32(giftCode.MembershipTypeID)
+			order.GoodsID = int32(giftCode.MembershipTypeID)
+		}
+		if err := tx.Create(order).Error; err != nil {
+			return fmt.Errorf("create gift order failed: %w", err)
+		}
+		if err := tx.Create(&model.LxtPaymentOrderStatusLog{
+			PaymentID:  order.PaymentID,
+			FromStatus: "",
+			ToStatus:   constant.PaymentStatusPaid,
+			Event:      constant.OrderEventGiftRedeem,
+		}).Error; err != nil {
+			return fmt.Errorf("create order status log failed: %w", err)
+		}
+
+		if giftCode.BuyType == constant.BuyTypeMembership {
+			if membership, err = l.grantMembership(tx, &membershipGrant{
+				UserId:           userId,
+				MembershipTypeId: giftCode.MembershipTypeID,
+				OrderId:          order.ID,
+				Amount:           decimal.Zero,
+				RenewalType:      constant.MembershipRenewalTypeGift,
+			}); err != nil {
+				return err
+			}
+		}
+
+		// 状态条件保证礼品码只会被标记一次
+		result := tx.Model(&model.LxtPaymentGiftCode{}).
+			Where("id = ? AND status = ?", giftCode.ID, constant.GiftCodeStatusUnused).
+			Updates(map[string]interface{}{
+				"status":      constant.GiftCodeStatusRedeemed,
+				"redeemed_by": userId,
+				"redeemed_at": now,
+				"payment_id":  order.PaymentID,
+			})
+		if result.Error != nil {
+			return fmt.Errorf("update gift code failed: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return fmt.Errorf("礼品码已被兑换")
+		}
+		return nil
+	})
+	if err != nil {
+		l.Errorf("Failed to redeem gift code: userId=%d, code=%s, err=%v", userId, code, err)
+		return nil, err
+	}
+
+	resp := &payment.RedeemGiftCodeResp{
+		PaymentId: order.PaymentID,
+		OrderSn:   order.OrderSn,
+		BuyType:   int64(order.BuyType),
+		GoodsId:   int64(order.GoodsID),
+		Subject:   order.Subject,
+	}
+	if membership != nil {
+		// 会员变更后清除用户缓存，使新的会员权限立即生效
+		l.clearUserCacheAfterMembershipUpdate(userId)
+		resp.MembershipTypeId = membership.MembershipTypeID
+		resp.MembershipEndTime = membership.EndTime.Format("2006-01-02 15:04:05")
+	}
+
+	l.Infof("Redeemed gift code: userId=%d, code=%s, paymentId=%s, buyType=%d",
+		userId, code, order.PaymentID, order.BuyType)
+	return resp, nil
+}
+
+// giftCodeSubject 查询礼品码对应的会员类型或商品，作为兑换订单的标题
+func giftCodeSubject(tx *gorm.DB, giftCode *model.LxtPaymentGiftCode) (string, error) {
+	switch giftCode.BuyType {
+	case constant.BuyTypeMembership:
+		var membershipType model.LxtUserMembershipType
+		if err := tx.Where("id = ?", giftCode.MembershipTypeID).First(&membershipType).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return "", fmt.Errorf("礼品码对应的会员类型不存在")
+			}
+			return "", fmt.Errorf("query membership type failed: %w", err)
+		}
+		return membershipType.Name, nil
+	case constant.BuyTypeGoods:
+		var goods model.LxtPaymentGood
+		if err := tx.Where("id = ?", giftCode.GoodsID).First(&goods).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return "", fmt.Errorf("礼品码对应的商品不存在")
+			}
+			return "", fmt.Errorf("query goods failed: %w", err)
+		}
+		return goods.Name, nil
+	default:
+		return "", fmt.Errorf("不支持的礼品码类型: %d", giftCode.BuyType)
+	}
+}
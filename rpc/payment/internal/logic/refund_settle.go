@@ -0,0 +1,62 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+
+	"lxtian-blog/common/constant"
+	"lxtian-blog/common/repository/payment_repo"
+	"lxtian-blog/rpc/payment/internal/orderstate"
+
+	"github.com/shopspring/decimal"
+)
+
+// settleOrderRefund 根据订单累计退款成功金额更新订单状态：退满支付金额为已退款，否则为部分退款；
+// 会员订单同时按退款比例扣回已开通的会员时长
+func (l *BaseLogic) settleOrderRefund(repo payment_repo.LxtPaymentRefundsRepo, paymentId string) error {
+	var refundedAmount decimal.Decimal
+	err := repo.WithTransaction(l.ctx, func(txCtx context.Context) error {
+		order, err := repo.LockPaymentOrderByPaymentId(txCtx, paymentId)
+		if err != nil {
+			return fmt.Errorf("lock payment order failed: %w", err)
+		}
+
+		refunded, err := repo.SumRefundAmountByPaymentId(txCtx, paymentId, []string{constant.RefundStatusSuccess})
+		if err != nil {
+			return fmt.Errorf("sum refunded amount failed: %w", err)
+		}
+
+		refundedAmount = refunded.Round(2)
+		status := order.Status
+		switch {
+		case refundedAmount.GreaterThanOrEqual(order.Amount.Round(2)):
+			status = constant.PaymentStatusRefunded
+		case refundedAmount.IsPositive():
+			status = constant.PaymentStatusPartialRefunded
+		}
+		if status == order.Status {
+			return nil
+		}
+
+		_, err = orderstate.NewMachine(l.svcCtx.DB).Fire(txCtx, &orderstate.Transition{
+			PaymentId: paymentId,
+			To:        status,
+			Event:     constant.OrderEventRefund,
+			Remark:    fmt.Sprintf("累计退款%s", refundedAmount.StringFixed(2)),
+		})
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	order, err := repo.FindPaymentOrderByPaymentId(l.ctx, paymentId)
+	if err != nil {
+		return fmt.Errorf("find payment order failed: %w", err)
+	}
+	if order.BuyType != constant.BuyTypeMembership || !refundedAmount.IsPositive() {
+		return nil
+	}
+
+	return l.revokeMembershipForRefund(order, refundedAmount)
+}
@@ -2,17 +2,24 @@ package logic
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"lxtian-blog/common/constant"
 	paymentSvc "lxtian-blog/common/repository/payment_repo"
 	"time"
 
 	"lxtian-blog/common/model"
-	"lxtian-blog/common/pkg/alipay"
+	"lxtian-blog/common/pkg/payprovider"
+	"lxtian-blog/common/pkg/utils"
 	"lxtian-blog/rpc/payment/internal/svc"
 	"lxtian-blog/rpc/payment/pb/payment"
 )
 
+var (
+	errRefundNotAllowed     = errors.New("order status does not allow refund")
+	errRefundAmountExceeded = errors.New("退款金额超过可退金额")
+)
+
 type RefundPaymentLogic struct {
 	*BaseLogic
 	repo paymentSvc.LxtPaymentRefundsRepo
@@ -26,6 +33,13 @@ func NewRefundPaymentLogic(ctx context.Context, svcCtx *svc.ServiceContext) *Ref
 }
 
 func (l *RefundPaymentLogic) RefundPayment(in *payment.RefundPaymentReq) (*payment.RefundPaymentResp, error) {
+	// 携带幂等键的重试直接返回首次退款结果，避免重复退款
+	return runIdempotent(l.BaseLogic, "RefundPayment", in.IdempotencyKey, in, func() (*payment.RefundPaymentResp, error) {
+		return l.refundPayment(in)
+	})
+}
+
+func (l *RefundPaymentLogic) refundPayment(in *payment.RefundPaymentReq) (*payment.RefundPaymentResp, error) {
 	// 参数验证
 	if in.PaymentId == "" && in.OrderSn == "" && in.OutTradeNo == "" {
 		return &payment.RefundPaymentResp{
@@ -33,14 +47,14 @@ func (l *RefundPaymentLogic) RefundPayment(in *payment.RefundPaymentReq) (*payme
 		}, fmt.Errorf("at least one of payment_id, order_id, out_trade_no is required")
 	}
 
-	if in.RefundAmount <= 0 {
+	refundAmount, err := utils.ParseAmount(in.RefundAmount)
+	if err != nil {
 		return &payment.RefundPaymentResp{
-			Message: "退款金额必须大于0",
-		}, fmt.Errorf("refund_amount must be greater than 0")
+			Message: "退款金额必须大于0且最多两位小数",
+		}, fmt.Errorf("invalid refund_amount: %w", err)
 	}
 
 	var paymentOrder *model.LxtPaymentOrder
-	var err error
 
 	// 根据提供的参数查找支付订单
 	if in.PaymentId != "" {
@@ -58,36 +72,19 @@ func (l *RefundPaymentLogic) RefundPayment(in *payment.RefundPaymentReq) (*payme
 		}, fmt.Errorf("payment_repo order not found: %w", err)
 	}
 
-	// 检查订单状态是否允许退款
-	if paymentOrder.Status != constant.PaymentStatusPaid {
-		return &payment.RefundPaymentResp{
-			Message: "订单状态不允许退款",
-		}, fmt.Errorf("order status does not allow refund")
-	}
-
-	// 检查退款金额
-	if in.RefundAmount > paymentOrder.Amount {
-		return &payment.RefundPaymentResp{
-			Message: "退款金额不能超过支付金额",
-		}, fmt.Errorf("refund amount exceeds payment_repo amount")
-	}
-
-	// 生成退款ID和退款单号
+	// 生成退款ID和退款单号（部分退款时每次退款的退款单号必须不同）
 	refundId := l.generateRefundId()
 	outRequestNo := in.OutRequestNo
 	if outRequestNo == "" {
 		outRequestNo = fmt.Sprintf("REFUND_%s_%d", time.Now().Format("20060102150405"), time.Now().UnixNano()%10000)
 	}
 
-	// 检查是否已有相同的退款单号
+	// 相同退款单号重复提交时直接返回已有退款记录，保证幂等
 	existingRefund, err := l.repo.FindPaymentRefundByOutRequestNo(l.ctx, outRequestNo)
 	if err == nil && existingRefund != nil {
-		return &payment.RefundPaymentResp{
-			Message: "退款单号已存在",
-		}, fmt.Errorf("refund request number already exists")
+		return buildRefundPaymentResp(existingRefund, "退款单号已存在"), nil
 	}
 
-	// 创建退款记录
 	paymentRefund := &model.LxtPaymentRefund{
 		RefundID:     refundId,
 		PaymentID:    paymentOrder.PaymentID,
@@ -95,60 +92,92 @@ func (l *RefundPaymentLogic) RefundPayment(in *payment.RefundPaymentReq) (*payme
 		OutTradeNo:   paymentOrder.OutTradeNo,
 		OutRequestNo: outRequestNo,
 		UserID:       paymentOrder.UserID,
-		RefundAmount: in.RefundAmount,
+		RefundAmount: refundAmount,
 		RefundReason: &in.RefundReason,
 		Status:       constant.RefundStatusPending,
 		RefundStatus: nil,
 	}
 
-	// 保存退款记录到数据库
-	_, err = l.repo.InsertPaymentRefund(l.ctx, paymentRefund)
+	// 锁定订单后校验可退金额并创建退款记录，避免并发退款超出支付金额
+	err = l.repo.WithTransaction(l.ctx, func(txCtx context.Context) error {
+		order, err := l.repo.LockPaymentOrderByPaymentId(txCtx, paymentOrder.PaymentID)
+		if err != nil {
+			return fmt.Errorf("lock payment order failed: %w", err)
+		}
+
+		// 检查订单状态是否允许退款
+		if order.Status != constant.PaymentStatusPaid && order.Status != constant.PaymentStatusPartialRefunded {
+			return errRefundNotAllowed
+		}
+
+		// 已退款及退款中的金额
+		refunded, err := l.repo.SumRefundAmountByPaymentId(txCtx, order.PaymentID,
+			[]string{constant.RefundStatusSuccess, constant.RefundStatusPending})
+		if err != nil {
+			return fmt.Errorf("sum refunded amount failed: %w", err)
+		}
+		refundable := order.Amount.Sub(refunded).Round(2)
+		if refundAmount.GreaterThan(refundable) {
+			return fmt.Errorf("%w，可退金额为%s元", errRefundAmountExceeded, utils.FormatAmount(refundable))
+		}
+
+		_, err = l.repo.InsertPaymentRefund(txCtx, paymentRefund)
+		return err
+	})
+	if err != nil {
+		l.Errorf("Failed to create payment refund: paymentId=%s, err=%v", paymentOrder.PaymentID, err)
+		switch {
+		case errors.Is(err, errRefundNotAllowed):
+			return &payment.RefundPaymentResp{
+				Message: "订单状态不允许退款",
+			}, fmt.Errorf("order status does not allow refund")
+		case errors.Is(err, errRefundAmountExceeded):
+			return &payment.RefundPaymentResp{
+				Message: err.Error(),
+			}, err
+		default:
+			return &payment.RefundPaymentResp{
+				Message: "创建退款记录失败",
+			}, fmt.Errorf("failed to insert payment_repo refund: %w", err)
+		}
+	}
+
+	// 按订单的支付方式调用支付渠道申请退款
+	provider, err := l.paymentProvider(paymentOrder.PayType)
 	if err != nil {
-		l.Errorf("Failed to insert payment_repo refund: %v", err)
+		l.Errorf("Unsupported pay type for refund: paymentId=%s, payType=%d", paymentOrder.PaymentID, paymentOrder.PayType)
+		l.repo.UpdatePaymentRefundStatus(l.ctx, refundId, constant.RefundStatusFailed)
 		return &payment.RefundPaymentResp{
-			Message: "创建退款记录失败",
-		}, fmt.Errorf("failed to insert payment_repo refund: %w", err)
+			Message: "不支持的支付方式",
+		}, err
 	}
 
-	// 调用支付宝API申请退款
-	alipayReq := &alipay.TradeRefundRequest{
+	refundResult, err := provider.RefundPayment(l.ctx, &payprovider.RefundRequest{
 		OutTradeNo:   paymentOrder.OutTradeNo,
 		TradeNo:      paymentOrder.TradeNo,
-		RefundAmount: in.RefundAmount,
-		RefundReason: in.RefundReason,
 		OutRequestNo: outRequestNo,
-	}
-
-	alipayResp, err := l.svcCtx.AlipayClient.RefundPayment(alipayReq)
+		RefundAmount: refundAmount,
+		TotalAmount:  paymentOrder.Amount,
+		RefundReason: in.RefundReason,
+	})
 	if err != nil {
-		l.Errorf("Failed to refund alipay payment_repo: %v", err)
-		// 更新退款状态为失败
+		l.Errorf("Failed to refund %s payment: refundId=%s, err=%v", provider.Name(), refundId, err)
+		if !errors.Is(err, payprovider.ErrRefundRejected) {
+			// 超时、渠道繁忙等结果未知，渠道可能已受理退款，保持待确认由退款同步任务查询后更新
+			return buildRefundPaymentResp(paymentRefund, "退款结果待确认"), nil
+		}
+		// 渠道明确拒绝退款，更新退款状态为失败
 		l.repo.UpdatePaymentRefundStatus(l.ctx, refundId, constant.RefundStatusFailed)
 		return &payment.RefundPaymentResp{
 			Message: "申请退款失败",
-		}, fmt.Errorf("failed to refund alipay payment_repo: %w", err)
+		}, fmt.Errorf("failed to refund %s payment: %w", provider.Name(), err)
 	}
 
-	// 更新退款记录
-	paymentRefund.RefundStatus = &alipayResp.RefundStatus
-	paymentRefund.RefundFee = &alipayResp.RefundFee
-
-	// 解析退款时间
-	if alipayResp.GmtRefund != "" {
-		if t, err := time.Parse("2006-01-02 15:04:05", alipayResp.GmtRefund); err == nil {
-			paymentRefund.GmtRefund = &t
-		}
-	}
-
-	// 根据支付宝返回的状态更新本地状态
-	switch alipayResp.RefundStatus {
-	case "REFUND_SUCCESS":
-		paymentRefund.Status = constant.RefundStatusSuccess
-	case "REFUND_CLOSED":
-		paymentRefund.Status = constant.RefundStatusClosed
-	default:
-		paymentRefund.Status = constant.RefundStatusFailed
-	}
+	// 根据渠道返回的状态更新本地状态；无法确认结果时保持待确认，由退款同步任务查询后更新
+	paymentRefund.Status = refundResult.Status
+	paymentRefund.RefundStatus = &refundResult.RawStatus
+	paymentRefund.RefundFee = &refundResult.RefundFee
+	paymentRefund.GmtRefund = refundResult.RefundTime
 
 	// 更新退款记录
 	err = l.repo.UpdatePaymentRefund(l.ctx, paymentRefund)
@@ -156,42 +185,43 @@ func (l *RefundPaymentLogic) RefundPayment(in *payment.RefundPaymentReq) (*payme
 		l.Errorf("Failed to update payment_repo refund: %v", err)
 	}
 
-	// 如果退款成功，更新支付订单状态
+	// 如果退款成功，根据累计退款金额更新支付订单状态
 	if paymentRefund.Status == constant.RefundStatusSuccess {
-		if in.RefundAmount >= paymentOrder.Amount {
-			// 全额退款
-			l.repo.UpdatePaymentOrderStatus(l.ctx, paymentOrder.PaymentID, constant.PaymentStatusRefunded)
-		} else {
-			// 部分退款
-			l.repo.UpdatePaymentOrderStatus(l.ctx, paymentOrder.PaymentID, constant.PaymentStatusPartialRefunded)
+		if err := l.settleOrderRefund(l.repo, paymentOrder.PaymentID); err != nil {
+			l.Errorf("Failed to settle order refund: paymentId=%s, err=%v", paymentOrder.PaymentID, err)
 		}
 	}
 
 	// 记录日志
-	l.Infof("Refund payment: refundId=%s, paymentId=%s, amount=%.2f",
-		refundId, paymentOrder.PaymentID, in.RefundAmount)
+	l.Infof("Refund payment: refundId=%s, paymentId=%s, amount=%s",
+		refundId, paymentOrder.PaymentID, utils.FormatAmount(refundAmount))
+
+	return buildRefundPaymentResp(paymentRefund, "退款申请成功"), nil
+}
 
+// 构建退款响应
+func buildRefundPaymentResp(refund *model.LxtPaymentRefund, message string) *payment.RefundPaymentResp {
 	resp := &payment.RefundPaymentResp{
-		RefundId:     refundId,
-		OutRequestNo: outRequestNo,
-		RefundAmount: in.RefundAmount,
-		Message:      "退款申请成功",
+		RefundId:     refund.RefundID,
+		OutRequestNo: refund.OutRequestNo,
+		RefundAmount: utils.FormatAmount(refund.RefundAmount),
+		Message:      message,
 	}
 
 	// 设置退款手续费
-	if paymentRefund.RefundFee != nil {
-		resp.RefundFee = *paymentRefund.RefundFee
+	if refund.RefundFee != nil {
+		resp.RefundFee = utils.FormatAmount(*refund.RefundFee)
 	}
 
 	// 设置退款状态
-	if paymentRefund.RefundStatus != nil {
-		resp.RefundStatus = *paymentRefund.RefundStatus
+	if refund.RefundStatus != nil {
+		resp.RefundStatus = *refund.RefundStatus
 	}
 
 	// 设置退款时间
-	if paymentRefund.GmtRefund != nil {
-		resp.GmtRefund = paymentRefund.GmtRefund.Format("2006-01-02 15:04:05")
+	if refund.GmtRefund != nil {
+		resp.GmtRefund = refund.GmtRefund.Format("2006-01-02 15:04:05")
 	}
 
-	return resp, nil
+	return resp
 }
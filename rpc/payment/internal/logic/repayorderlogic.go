@@ -5,10 +5,11 @@ import (
 	"fmt"
 	"lxtian-blog/common/constant"
 	"lxtian-blog/common/model"
-	"lxtian-blog/common/pkg/alipay"
+	"lxtian-blog/common/pkg/payprovider"
+	"lxtian-blog/common/pkg/utils"
+	"lxtian-blog/common/repository/payment_repo"
 	"lxtian-blog/rpc/payment/internal/svc"
 	"lxtian-blog/rpc/payment/pb/payment"
-	"strconv"
 
 	"gorm.io/gorm"
 )
@@ -25,6 +26,13 @@ func NewRepayOrderLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RepayO
 
 // RepayOrder 重新支付订单（针对已存在的未支付订单）
 func (l *RepayOrderLogic) RepayOrder(in *payment.RepayOrderReq) (*payment.RepayOrderResp, error) {
+	// 携带幂等键的重试直接返回首次生成的支付链接
+	return runIdempotent(l.BaseLogic, "RepayOrder", in.IdempotencyKey, in, func() (*payment.RepayOrderResp, error) {
+		return l.repayOrder(in)
+	})
+}
+
+func (l *RepayOrderLogic) repayOrder(in *payment.RepayOrderReq) (*payment.RepayOrderResp, error) {
 	// 参数验证：order_id 和 out_trade_no 至少提供一个
 	if in.OrderSn == "" && in.OutTradeNo == "" {
 		return nil, fmt.Errorf("订单ID或商户订单号至少提供一个")
@@ -63,11 +71,22 @@ func (l *RepayOrderLogic) RepayOrder(in *payment.RepayOrderReq) (*payment.RepayO
 		return nil, fmt.Errorf("订单状态不是待支付，当前状态：%s", paymentOrder.Status)
 	}
 
+	// 重新发起支付同样占用待支付名额，订单仍在窗口内时只刷新预占时间
+	if !l.reserveUserPendingOrder(paymentOrder.UserID, paymentOrder.OutTradeNo) {
+		return nil, fmt.Errorf("待支付订单数量已达上限（%d个），请处理现有订单后再支付", l.svcCtx.Config.PendingLimit.Limit)
+	}
+
+	// 按订单的支付方式选择支付渠道
+	provider, err := l.paymentProvider(paymentOrder.PayType)
+	if err != nil {
+		return nil, fmt.Errorf("不支持的支付方式: %d", paymentOrder.PayType)
+	}
+
 	// 4. 生成新的支付ID（每次发起支付都生成新的payment_id，但使用相同的out_trade_no）
 	newPaymentId := l.generatePaymentId()
 
-	// 5. 调用支付宝API创建支付订单
-	amountStr := strconv.FormatFloat(paymentOrder.Amount, 'f', 2, 64)
+	// 5. 调用支付渠道创建支付
+	amountStr := utils.FormatAmount(paymentOrder.Amount)
 
 	// 超时时间
 	timeout := paymentOrder.Timeout
@@ -86,35 +105,48 @@ func (l *RepayOrderLogic) RepayOrder(in *payment.RepayOrderReq) (*payment.RepayO
 		notifyUrl = paymentOrder.NotifyURL
 	}
 
-	alipayReq := &alipay.TradeCreateRequest{
+	var body, productCode string
+	if paymentOrder.Body != nil {
+		body = *paymentOrder.Body
+	}
+	if paymentOrder.ProductCode != nil {
+		productCode = *paymentOrder.ProductCode
+	}
+
+	payUrl, err := provider.CreatePayment(l.ctx, &payprovider.CreateRequest{
 		OutTradeNo:  paymentOrder.OutTradeNo,
-		TotalAmount: amountStr,
+		Amount:      amountStr,
 		Subject:     paymentOrder.Subject,
-		Body:        *paymentOrder.Body,
-		ProductCode: *paymentOrder.ProductCode,
+		Body:        body,
+		ProductCode: productCode,
 		Timeout:     timeout,
 		ReturnUrl:   returnUrl,
-	}
-
-	// 调用支付宝API创建支付URL
-	payUrl, err := l.svcCtx.AlipayClient.CreatePayment(alipayReq)
+		ClientIp:    paymentOrder.ClientIP,
+	})
 	if err != nil {
-		l.Errorf("Failed to create alipay payment_repo: %v", err)
+		l.Errorf("Failed to create %s payment: %v", provider.Name(), err)
 		return nil, fmt.Errorf("创建支付订单失败: %w", err)
 	}
 
-	// 6. 更新订单的 payment_id（可选，如果需要记录最新的支付ID）
-	err = l.svcCtx.DB.WithContext(l.ctx).Model(&model.LxtPaymentOrder{}).
-		Where("id = ?", paymentOrder.ID).
-		Update("payment_id", newPaymentId).Error
+	// 6. 更新订单的 payment_id，优惠券使用记录按 payment_id 核销/释放，需在同一事务中迁移到新的支付ID
+	orderRepo := payment_repo.NewPaymentOrderRepository(l.svcCtx.DB)
+	usageRepo := payment_repo.NewLxtPaymentCouponUsagesRepo(l.svcCtx.DB)
+	err = orderRepo.WithTransaction(l.ctx, func(txCtx context.Context) error {
+		changed, err := orderRepo.ChangePaymentId(txCtx, paymentOrder.PaymentID, newPaymentId)
+		if err != nil || !changed {
+			// 未更新表示支付ID已被并发的重新支付更新，使用记录随该次更新迁移
+			return err
+		}
+		return usageRepo.ChangePaymentId(txCtx, paymentOrder.PaymentID, newPaymentId)
+	})
 	if err != nil {
 		l.Errorf("Failed to update payment_id: %v", err)
 		// 这里不返回错误，因为支付链接已经生成
 	}
 
 	// 记录日志
-	l.Infof("Repay order: paymentId=%s, orderSn=%s, outTradeNo=%s, amount=%.2f, payUrl=%s",
-		newPaymentId, paymentOrder.OrderSn, paymentOrder.OutTradeNo, paymentOrder.Amount, payUrl)
+	l.Infof("Repay order: paymentId=%s, orderSn=%s, outTradeNo=%s, amount=%s, payUrl=%s",
+		newPaymentId, paymentOrder.OrderSn, paymentOrder.OutTradeNo, amountStr, payUrl)
 
 	return &payment.RepayOrderResp{
 		PaymentId:  newPaymentId,
@@ -0,0 +1,93 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+
+	"lxtian-blog/common/constant"
+	"lxtian-blog/common/model"
+	"lxtian-blog/rpc/payment/internal/svc"
+	"lxtian-blog/rpc/payment/pb/payment"
+)
+
+type ReplayNotifyLogic struct {
+	*PaymentNotifyLogic
+}
+
+func NewReplayNotifyLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ReplayNotifyLogic {
+	return &ReplayNotifyLogic{
+		PaymentNotifyLogic: NewPaymentNotifyLogic(ctx, svcCtx),
+	}
+}
+
+// ReplayNotify 重放已保存的支付通知：重新验签并走 processNotify 处理流程
+// 处理流程对已支付订单是幂等的，会员只会开通一次
+func (l *ReplayNotifyLogic) ReplayNotify(in *payment.ReplayNotifyReq) (*payment.ReplayNotifyResp, error) {
+	if in.NotifyId == "" && in.PaymentId == "" {
+		return &payment.ReplayNotifyResp{
+			Success: false,
+			Message: "通知ID和支付ID至少提供一个",
+		}, fmt.Errorf("notify_id or payment_id is required")
+	}
+
+	var notify *model.LxtPaymentNotify
+	var err error
+	if in.NotifyId != "" {
+		notify, err = l.paymentService.FindPaymentNotifyByNotifyId(l.ctx, in.NotifyId)
+	} else {
+		notify, err = l.paymentService.FindLatestPaymentNotifyByPaymentId(l.ctx, in.PaymentId)
+	}
+	if err != nil {
+		l.Errorf("Failed to find payment notify: notifyId=%s, paymentId=%s, err=%v", in.NotifyId, in.PaymentId, err)
+		return &payment.ReplayNotifyResp{
+			Success: false,
+			Message: "通知记录不存在",
+		}, fmt.Errorf("payment notify not found: %w", err)
+	}
+	if notify.NotifyType != constant.NotifyTypePayment {
+		return &payment.ReplayNotifyResp{
+			Success:  false,
+			Message:  "仅支持重放支付通知",
+			NotifyId: notify.NotifyID,
+		}, fmt.Errorf("unsupported notify type: %s", notify.NotifyType)
+	}
+
+	// 重新验证签名并解析通知数据
+	notifyResult, err := l.verifyNotify(notify)
+	if err != nil {
+		l.Errorf("Failed to verify notify on replay: notifyId=%s, err=%v", notify.NotifyID, err)
+		l.paymentService.UpdatePaymentNotifyVerifyStatus(l.ctx, notify.NotifyID, constant.VerifyStatusFailed)
+		l.paymentService.UpdatePaymentNotifyProcessStatus(l.ctx, notify.NotifyID, constant.ProcessStatusFailed, "签名验证失败")
+		return &payment.ReplayNotifyResp{
+			Success:  false,
+			Message:  "签名验证失败",
+			NotifyId: notify.NotifyID,
+		}, fmt.Errorf("sign verification failed: %w", err)
+	}
+	if err := l.paymentService.UpdatePaymentNotifyVerifyStatus(l.ctx, notify.NotifyID, constant.VerifyStatusSuccess); err != nil {
+		l.Errorf("Failed to update verify status: %v", err)
+	}
+
+	// 处理通知
+	if err := l.processNotify(notifyResult, notify.NotifyID); err != nil {
+		l.Errorf("Failed to process notify on replay: notifyId=%s, err=%v", notify.NotifyID, err)
+		l.paymentService.UpdatePaymentNotifyProcessStatus(l.ctx, notify.NotifyID, constant.ProcessStatusFailed, err.Error())
+		return &payment.ReplayNotifyResp{
+			Success:  false,
+			Message:  "处理通知失败: " + err.Error(),
+			NotifyId: notify.NotifyID,
+		}, nil
+	}
+
+	if err := l.paymentService.UpdatePaymentNotifyProcessStatus(l.ctx, notify.NotifyID, constant.ProcessStatusSuccess, ""); err != nil {
+		l.Errorf("Failed to update process status: %v", err)
+	}
+
+	l.Infof("Replayed payment notify: notifyId=%s, out_trade_no=%s", notify.NotifyID, notifyResult.OutTradeNo)
+
+	return &payment.ReplayNotifyResp{
+		Success:  true,
+		Message:  "处理成功",
+		NotifyId: notify.NotifyID,
+	}, nil
+}
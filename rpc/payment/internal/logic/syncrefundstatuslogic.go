@@ -0,0 +1,133 @@
+package logic
+
+import (
+	"context"
+	"time"
+
+	"lxtian-blog/common/constant"
+	"lxtian-blog/common/model"
+	"lxtian-blog/common/pkg/payprovider"
+	redisutil "lxtian-blog/common/pkg/redis"
+	"lxtian-blog/common/repository/payment_repo"
+	"lxtian-blog/rpc/payment/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/stores/redis"
+)
+
+const (
+	// 每轮同步的退款记录数
+	refundSyncBatchSize = 100
+	// 退款创建后等待多久再查询（渠道退款结果有延迟）
+	refundSyncDelay = time.Minute
+	// 超过该时间仍查询不到退款结果则视为退款失败
+	refundSyncGiveUp = 24 * time.Hour
+	// 单条退款同步锁的过期时间（秒）
+	refundSyncLockSeconds = 60
+)
+
+// SyncRefundStatusLogic 同步待确认退款的渠道退款状态
+type SyncRefundStatusLogic struct {
+	*BaseLogic
+	repo payment_repo.LxtPaymentRefundsRepo
+}
+
+func NewSyncRefundStatusLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SyncRefundStatusLogic {
+	return &SyncRefundStatusLogic{
+		BaseLogic: NewBaseLogic(ctx, svcCtx),
+		repo:      payment_repo.NewLxtPaymentRefundsRepo(svcCtx.DB),
+	}
+}
+
+// SyncRefundStatus 查询待确认的退款并更新退款结果，返回状态发生变化的退款数
+func (l *SyncRefundStatusLogic) SyncRefundStatus() (int, error) {
+	refunds, err := l.repo.FindPendingRefunds(l.ctx, time.Now().Add(-refundSyncDelay), refundSyncBatchSize)
+	if err != nil {
+		l.Errorf("Failed to get pending refunds: %v", err)
+		return 0, err
+	}
+
+	synced := 0
+	for _, refund := range refunds {
+		if l.syncRefund(refund) {
+			synced++
+		}
+	}
+	if synced > 0 {
+		l.Infof("Synced %d pending refunds", synced)
+	}
+	return synced, nil
+}
+
+// syncRefund 按订单支付方式调用渠道退款查询接口确认单条退款结果
+func (l *SyncRefundStatusLogic) syncRefund(refund *model.LxtPaymentRefund) bool {
+	if l.svcCtx.Rds != nil {
+		lock := redis.NewRedisLock(l.svcCtx.Rds, redisutil.ReturnRedisKey(redisutil.PaymentRefundSyncLock, refund.RefundID))
+		lock.SetExpire(refundSyncLockSeconds)
+		ok, err := lock.AcquireCtx(l.ctx)
+		if err != nil {
+			l.Errorf("Failed to acquire refund sync lock: refundId=%s, err=%v", refund.RefundID, err)
+			return false
+		}
+		if !ok {
+			// 其他实例正在处理该退款
+			return false
+		}
+		defer func() {
+			if _, err := lock.ReleaseCtx(l.ctx); err != nil {
+				l.Errorf("Failed to release refund sync lock: refundId=%s, err=%v", refund.RefundID, err)
+			}
+		}()
+	}
+
+	order, err := l.repo.FindPaymentOrderByPaymentId(l.ctx, refund.PaymentID)
+	if err != nil {
+		l.Errorf("Failed to find payment order for refund: refundId=%s, paymentId=%s, err=%v", refund.RefundID, refund.PaymentID, err)
+		return false
+	}
+	provider, err := l.paymentProvider(order.PayType)
+	if err != nil {
+		l.Errorf("Unsupported pay type for refund sync: refundId=%s, payType=%d", refund.RefundID, order.PayType)
+		return false
+	}
+
+	result, err := provider.QueryRefund(l.ctx, &payprovider.RefundQueryRequest{
+		OutTradeNo:   refund.OutTradeNo,
+		OutRequestNo: refund.OutRequestNo,
+	})
+	if err != nil {
+		l.Errorf("Failed to query %s refund: refundId=%s, outRequestNo=%s, err=%v", provider.Name(), refund.RefundID, refund.OutRequestNo, err)
+		return false
+	}
+
+	switch result.Status {
+	case constant.RefundStatusSuccess:
+		refund.Status = constant.RefundStatusSuccess
+		refund.RefundStatus = &result.RawStatus
+		if result.RefundTime != nil {
+			refund.GmtRefund = result.RefundTime
+		}
+	case constant.RefundStatusClosed, constant.RefundStatusFailed:
+		refund.Status = result.Status
+		refund.RefundStatus = &result.RawStatus
+	default:
+		// 渠道未确认退款成功，超过等待时间后标记为失败
+		if time.Since(refund.CreatedAt) < refundSyncGiveUp {
+			return false
+		}
+		refund.Status = constant.RefundStatusFailed
+	}
+
+	if err := l.repo.UpdatePaymentRefund(l.ctx, refund); err != nil {
+		l.Errorf("Failed to update payment refund: refundId=%s, err=%v", refund.RefundID, err)
+		return false
+	}
+
+	if refund.Status == constant.RefundStatusSuccess {
+		if err := l.settleOrderRefund(l.repo, refund.PaymentID); err != nil {
+			l.Errorf("Failed to settle order refund: paymentId=%s, err=%v", refund.PaymentID, err)
+		}
+	}
+
+	l.Infof("Synced refund status: refundId=%s, paymentId=%s, status=%s", refund.RefundID, refund.PaymentID, refund.Status)
+	return true
+}
@@ -0,0 +1,55 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+
+	"lxtian-blog/common/pkg/utils"
+	"lxtian-blog/rpc/payment/internal/svc"
+	"lxtian-blog/rpc/payment/pb/payment"
+)
+
+type ValidateCouponLogic struct {
+	*BaseLogic
+}
+
+func NewValidateCouponLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ValidateCouponLogic {
+	return &ValidateCouponLogic{
+		BaseLogic: NewBaseLogic(ctx, svcCtx),
+	}
+}
+
+// ValidateCoupon 按下单时的计价规则试算优惠码，优惠码不可用时返回 valid=false 及原因，不锁定优惠券
+func (l *ValidateCouponLogic) ValidateCoupon(in *payment.ValidateCouponReq) (*payment.ValidateCouponResp, error) {
+	if in.UserId == 0 {
+		return nil, fmt.Errorf("用户ID不能为空")
+	}
+	if in.CouponCode == "" {
+		return nil, fmt.Errorf("优惠码不能为空")
+	}
+
+	price, err := calculateOrderPrice(l.ctx, l.svcCtx, &payment.CreatePaymentReq{
+		UserId:   in.UserId,
+		BuyType:  in.BuyType,
+		GoodsId:  in.GoodsId,
+		VipId:    in.VipId,
+		Quantity: in.Quantity,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &payment.ValidateCouponResp{
+		CouponCode:     normalizeCouponCode(in.CouponCode),
+		OriginalAmount: utils.FormatAmount(price.OriginalAmount),
+		Discount:       utils.FormatAmount(price.Discount),
+	}
+	if _, err := applyCoupon(l.ctx, l.svcCtx, in.CouponCode, int64(in.UserId), in.BuyType, price); err != nil {
+		resp.Message = err.Error()
+	} else {
+		resp.Valid = true
+	}
+	resp.CouponDiscount = utils.FormatAmount(price.CouponDiscount)
+	resp.Amount = utils.FormatAmount(price.Amount)
+	return resp, nil
+}
@@ -0,0 +1,148 @@
+package orderstate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"lxtian-blog/common/constant"
+	"lxtian-blog/common/model"
+	"lxtian-blog/common/repository/payment_repo"
+
+	"gorm.io/gorm"
+)
+
+// ErrInvalidTransition 订单当前状态不允许迁移到目标状态
+var ErrInvalidTransition = errors.New("invalid order status transition")
+
+// transitions 支付订单允许的状态迁移：源状态 -> 目标状态
+// 支付结果以支付渠道为准，已关闭/已取消/下单失败的订单仍可因迟到的支付成功通知变为已支付；
+// 已支付的订单只能进入退款流程，迟到的交易关闭通知不会覆盖已支付状态
+var transitions = map[string][]string{
+	constant.PaymentStatusPending: {
+		constant.PaymentStatusPaid,
+		constant.PaymentStatusClosed,
+		constant.PaymentStatusCancelled,
+		constant.PaymentStatusFAILED,
+	},
+	constant.PaymentStatusFAILED:    {constant.PaymentStatusPaid},
+	constant.PaymentStatusClosed:    {constant.PaymentStatusPaid},
+	constant.PaymentStatusCancelled: {constant.PaymentStatusPaid},
+	constant.PaymentStatusPaid: {
+		constant.PaymentStatusPartialRefunded,
+		constant.PaymentStatusRefunded,
+	},
+	constant.PaymentStatusPartialRefunded: {constant.PaymentStatusRefunded},
+}
+
+// CanTransition 是否允许从 from 状态迁移到 to 状态
+func CanTransition(from, to string) bool {
+	for _, status := range transitions[from] {
+		if status == to {
+			return true
+		}
+	}
+	return false
+}
+
+// Transition 订单状态迁移
+type Transition struct {
+	PaymentId string                 // 支付ID
+	To        string                 // 目标状态
+	Event     string                 // 触发事件 constant.OrderEvent*
+	Remark    string                 // 备注
+	Updates   map[string]interface{} // 随状态一同更新的字段，如交易号、关闭时间
+}
+
+// Machine 支付订单状态机，所有订单状态变更都应通过 Fire 执行
+type Machine struct {
+	orderRepo  payment_repo.PaymentOrderRepository
+	logRepo    payment_repo.LxtPaymentOrderStatusLogsRepo
+	couponRepo payment_repo.LxtPaymentCouponsRepo
+	usageRepo  payment_repo.LxtPaymentCouponUsagesRepo
+}
+
+// NewMachine 创建支付订单状态机
+func NewMachine(db *gorm.DB) *Machine {
+	return &Machine{
+		orderRepo:  payment_repo.NewPaymentOrderRepository(db),
+		logRepo:    payment_repo.NewLxtPaymentOrderStatusLogsRepo(db),
+		couponRepo: payment_repo.NewLxtPaymentCouponsRepo(db),
+		usageRepo:  payment_repo.NewLxtPaymentCouponUsagesRepo(db),
+	}
+}
+
+// Fire 执行状态迁移：锁定订单后校验迁移是否合法，按当前状态条件更新并记录状态变更
+// 返回 true 表示状态已变更；订单已处于目标状态时返回 false（重复通知等场景）；
+// 迁移不被允许时返回 ErrInvalidTransition
+func (m *Machine) Fire(ctx context.Context, t *Transition) (bool, error) {
+	var changed bool
+	err := m.orderRepo.WithTransaction(ctx, func(txCtx context.Context) error {
+		order, err := m.orderRepo.LockByPaymentId(txCtx, t.PaymentId)
+		if err != nil {
+			return fmt.Errorf("lock payment order failed: %w", err)
+		}
+		if order.Status == t.To {
+			return nil
+		}
+		if !CanTransition(order.Status, t.To) {
+			return fmt.Errorf("%w: %s -> %s", ErrInvalidTransition, order.Status, t.To)
+		}
+
+		ok, err := m.orderRepo.TransitionStatus(txCtx, t.PaymentId, order.Status, t.To, t.Updates)
+		if err != nil {
+			return fmt.Errorf("update order status failed: %w", err)
+		}
+		if !ok {
+			return fmt.Errorf("order status changed concurrently: paymentId=%s", t.PaymentId)
+		}
+
+		log := &model.LxtPaymentOrderStatusLog{
+			PaymentID:  t.PaymentId,
+			FromStatus: order.Status,
+			ToStatus:   t.To,
+			Event:      t.Event,
+		}
+		if t.Remark != "" {
+			log.Remark = &t.Remark
+		}
+		if err := m.logRepo.CreateLog(txCtx, log); err != nil {
+			return err
+		}
+		if order.CouponCode != "" {
+			if err := m.settleCoupon(txCtx, order.PaymentID, t.To); err != nil {
+				return err
+			}
+		}
+
+		changed = true
+		return nil
+	})
+	return changed, err
+}
+
+// settleCoupon 随订单状态变更结算优惠券：支付成功时核销并计入已使用次数，关闭、取消或下单失败时释放
+func (m *Machine) settleCoupon(ctx context.Context, paymentId, to string) error {
+	switch to {
+	case constant.PaymentStatusPaid:
+		usage, err := m.usageRepo.GetByPaymentId(ctx, paymentId)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil
+			}
+			return fmt.Errorf("get coupon usage failed: %w", err)
+		}
+		consumed, err := m.usageRepo.Consume(ctx, paymentId)
+		if err != nil {
+			return err
+		}
+		if consumed {
+			return m.couponRepo.IncrUsedCount(ctx, usage.CouponID)
+		}
+	case constant.PaymentStatusClosed, constant.PaymentStatusCancelled, constant.PaymentStatusFAILED:
+		if _, err := m.usageRepo.Release(ctx, paymentId); err != nil {
+			return err
+		}
+	}
+	return nil
+}
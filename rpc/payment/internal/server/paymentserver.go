@@ -106,3 +106,45 @@ func (s *PaymentServer) Goods(ctx context.Context, in *payment.GoodsReq) (*payme
 	l := logic.NewGoodsLogic(ctx, s.svcCtx)
 	return l.Goods(in)
 }
+
+// 重放已保存的支付通知
+func (s *PaymentServer) ReplayNotify(ctx context.Context, in *payment.ReplayNotifyReq) (*payment.ReplayNotifyResp, error) {
+	l := logic.NewReplayNotifyLogic(ctx, s.svcCtx)
+	return l.ReplayNotify(in)
+}
+
+// 导入支付宝账单并与本地订单对账
+func (s *PaymentServer) ReconcileBill(ctx context.Context, in *payment.ReconcileBillReq) (*payment.ReconcileBillResp, error) {
+	l := logic.NewReconcileBillLogic(ctx, s.svcCtx)
+	return l.ReconcileBill(in)
+}
+
+// 校验优惠码并计算优惠后金额
+func (s *PaymentServer) ValidateCoupon(ctx context.Context, in *payment.ValidateCouponReq) (*payment.ValidateCouponResp, error) {
+	l := logic.NewValidateCouponLogic(ctx, s.svcCtx)
+	return l.ValidateCoupon(in)
+}
+
+// 会员开通/续费/升级报价
+func (s *PaymentServer) QuoteMembership(ctx context.Context, in *payment.QuoteMembershipReq) (*payment.QuoteMembershipResp, error) {
+	l := logic.NewQuoteMembershipLogic(ctx, s.svcCtx)
+	return l.QuoteMembership(in)
+}
+
+// 兑换礼品码
+func (s *PaymentServer) RedeemGiftCode(ctx context.Context, in *payment.RedeemGiftCodeReq) (*payment.RedeemGiftCodeResp, error) {
+	l := logic.NewRedeemGiftCodeLogic(ctx, s.svcCtx)
+	return l.RedeemGiftCode(in)
+}
+
+// 获取已购商品的限时下载地址
+func (s *PaymentServer) GoodsDownload(ctx context.Context, in *payment.GoodsDownloadReq) (*payment.GoodsDownloadResp, error) {
+	l := logic.NewGoodsDownloadLogic(ctx, s.svcCtx)
+	return l.GoodsDownload(in)
+}
+
+// 发表商品评价（仅限已购买用户）
+func (s *PaymentServer) GoodsReviewCreate(ctx context.Context, in *payment.GoodsReviewCreateReq) (*payment.GoodsReviewCreateResp, error) {
+	l := logic.NewGoodsReviewCreateLogic(ctx, s.svcCtx)
+	return l.GoodsReviewCreate(in)
+}
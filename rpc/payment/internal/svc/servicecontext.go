@@ -2,11 +2,19 @@ package svc
 
 import (
 	"fmt"
+	"github.com/leiphp/gokit/pkg/sdk/qiniu"
 	"github.com/zeromicro/go-zero/core/stores/redis"
 	"gorm.io/gorm"
+	"lxtian-blog/common/constant"
 	"lxtian-blog/common/pkg/alipay"
 	"lxtian-blog/common/pkg/initdb"
+	"lxtian-blog/common/pkg/payprovider"
+	"lxtian-blog/common/pkg/security"
+	"lxtian-blog/common/pkg/wechatpay"
+	"lxtian-blog/rpc/message/messageclient"
 	"lxtian-blog/rpc/payment/internal/config"
+
+	"github.com/zeromicro/go-zero/zrpc"
 )
 
 type ServiceContext struct {
@@ -14,6 +22,11 @@ type ServiceContext struct {
 	DB           *gorm.DB
 	Rds          *redis.Redis
 	AlipayClient *alipay.AlipayClient
+	Providers    *payprovider.Registry // 按 pay_type 选择支付渠道
+	MessageRpc   messageclient.Message
+
+	QiniuClient    *qiniu.QiniuClient       // 未配置七牛时为空
+	DownloadSigner *security.DownloadSigner // 本地商品文件下载链接签名，未配置密钥时为空
 }
 
 func NewServiceContext(c config.Config) *ServiceContext {
@@ -52,10 +65,52 @@ func NewServiceContext(c config.Config) *ServiceContext {
 		panic(err)
 	}
 
+	providers := payprovider.NewRegistry()
+	providers.Register(constant.PayTypeAlipay, payprovider.NewAlipayProvider(alipayClient))
+
+	// 初始化微信支付客户端
+	if c.WechatPay.MchId != "" {
+		wechatClient, err := wechatpay.NewWechatPayClient(&wechatpay.WechatPayConfig{
+			AppId:               c.WechatPay.AppId,
+			MchId:               c.WechatPay.MchId,
+			MchSerialNo:         c.WechatPay.MchSerialNo,
+			MchPrivateKey:       c.WechatPay.MchPrivateKey,
+			ApiV3Key:            c.WechatPay.ApiV3Key,
+			PlatformPublicKey:   c.WechatPay.PlatformPublicKey,
+			PlatformPublicKeyId: c.WechatPay.PlatformPublicKeyId,
+			NotifyUrl:           c.WechatPay.NotifyUrl,
+			GatewayUrl:          c.WechatPay.GatewayUrl,
+		})
+		if err != nil {
+			panic(err)
+		}
+		providers.Register(constant.PayTypeWechat, payprovider.NewWechatProvider(wechatClient))
+	}
+
+	// 初始化商品文件下载链接签发
+	var qiniuClient *qiniu.QiniuClient
+	if c.QiniuOss.AccessKey != "" {
+		qiniuClient = qiniu.NewClient(qiniu.QiniuConfig{
+			AccessKey: c.QiniuOss.AccessKey,
+			SecretKey: c.QiniuOss.SecretKey,
+			Bucket:    c.QiniuOss.Bucket,
+			Domain:    c.QiniuOss.Domain,
+			Region:    c.QiniuOss.Region,
+		})
+	}
+	var downloadSigner *security.DownloadSigner
+	if c.GoodsDelivery.LocalSecret != "" {
+		downloadSigner = security.NewDownloadSigner(c.GoodsDelivery.LocalSecret)
+	}
+
 	return &ServiceContext{
-		Config:       c,
-		DB:           mysqlDb,
-		Rds:          rds,
-		AlipayClient: alipayClient,
+		Config:         c,
+		DB:             mysqlDb,
+		Rds:            rds,
+		AlipayClient:   alipayClient,
+		Providers:      providers,
+		MessageRpc:     messageclient.NewMessage(zrpc.MustNewClient(c.MessageRpc)),
+		QiniuClient:    qiniuClient,
+		DownloadSigner: downloadSigner,
 	}
 }
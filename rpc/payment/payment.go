@@ -7,6 +7,7 @@ import (
 	"os"
 
 	"lxtian-blog/rpc/payment/internal/config"
+	"lxtian-blog/rpc/payment/internal/job"
 	"lxtian-blog/rpc/payment/internal/server"
 	"lxtian-blog/rpc/payment/internal/svc"
 	"lxtian-blog/rpc/payment/pb/payment"
@@ -27,6 +28,7 @@ func main() {
 	conf.MustLoad(*configFile, &c)
 	// 使用通用方法解析Etcd主机列表字符串
 	c.Etcd.Hosts = utils.ParseHosts(os.Getenv("ETCD_HOSTS"))
+	c.MessageRpc.Etcd.Hosts = utils.ParseHosts(os.Getenv("ETCD_HOSTS"))
 	ctx := svc.NewServiceContext(c)
 
 	s := zrpc.MustNewServer(c.RpcServerConf, func(grpcServer *grpc.Server) {
@@ -36,8 +38,23 @@ func main() {
 			reflection.Register(grpcServer)
 		}
 	})
-	defer s.Stop()
+
+	group := service.NewServiceGroup()
+	defer group.Stop()
+	group.Add(s)
+	if !c.OrderExpiry.Disabled {
+		group.Add(job.NewOrderExpiryJob(ctx))
+	}
+	if !c.RefundSync.Disabled {
+		group.Add(job.NewRefundSyncJob(ctx))
+	}
+	if !c.Reconcile.Disabled {
+		group.Add(job.NewReconcileJob(ctx))
+	}
+	if !c.MembershipExpiry.Disabled {
+		group.Add(job.NewMembershipExpiryJob(ctx))
+	}
 
 	fmt.Printf("Starting rpc server at %s...\n", c.ListenOn)
-	s.Start()
+	group.Start()
 }
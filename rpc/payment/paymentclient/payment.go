@@ -14,34 +14,48 @@ import (
 )
 
 type (
-	CancelPaymentReq     = payment.CancelPaymentReq
-	CancelPaymentResp    = payment.CancelPaymentResp
-	ClosePaymentReq      = payment.ClosePaymentReq
-	ClosePaymentResp     = payment.ClosePaymentResp
-	CreatePaymentReq     = payment.CreatePaymentReq
-	CreatePaymentResp    = payment.CreatePaymentResp
-	DeletePaymentReq     = payment.DeletePaymentReq
-	DeletePaymentResp    = payment.DeletePaymentResp
-	DonateNotifyReq      = payment.DonateNotifyReq
-	DonateNotifyResp     = payment.DonateNotifyResp
-	DonateReq            = payment.DonateReq
-	DonateResp           = payment.DonateResp
-	GoodsListReq         = payment.GoodsListReq
-	GoodsListResp        = payment.GoodsListResp
-	GoodsReq             = payment.GoodsReq
-	GoodsResp            = payment.GoodsResp
-	OrdersStatisticsReq  = payment.OrdersStatisticsReq
-	OrdersStatisticsResp = payment.OrdersStatisticsResp
-	PaymentHistoryReq    = payment.PaymentHistoryReq
-	PaymentHistoryResp   = payment.PaymentHistoryResp
-	PaymentNotifyReq     = payment.PaymentNotifyReq
-	PaymentNotifyResp    = payment.PaymentNotifyResp
-	QueryPaymentReq      = payment.QueryPaymentReq
-	QueryPaymentResp     = payment.QueryPaymentResp
-	RefundPaymentReq     = payment.RefundPaymentReq
-	RefundPaymentResp    = payment.RefundPaymentResp
-	RepayOrderReq        = payment.RepayOrderReq
-	RepayOrderResp       = payment.RepayOrderResp
+	CancelPaymentReq      = payment.CancelPaymentReq
+	CancelPaymentResp     = payment.CancelPaymentResp
+	ClosePaymentReq       = payment.ClosePaymentReq
+	ClosePaymentResp      = payment.ClosePaymentResp
+	CreatePaymentReq      = payment.CreatePaymentReq
+	CreatePaymentResp     = payment.CreatePaymentResp
+	DeletePaymentReq      = payment.DeletePaymentReq
+	DeletePaymentResp     = payment.DeletePaymentResp
+	DonateNotifyReq       = payment.DonateNotifyReq
+	DonateNotifyResp      = payment.DonateNotifyResp
+	DonateReq             = payment.DonateReq
+	DonateResp            = payment.DonateResp
+	GoodsDownloadReq      = payment.GoodsDownloadReq
+	GoodsDownloadResp     = payment.GoodsDownloadResp
+	GoodsListReq          = payment.GoodsListReq
+	GoodsListResp         = payment.GoodsListResp
+	GoodsReq              = payment.GoodsReq
+	GoodsResp             = payment.GoodsResp
+	GoodsReviewCreateReq  = payment.GoodsReviewCreateReq
+	GoodsReviewCreateResp = payment.GoodsReviewCreateResp
+	OrdersStatisticsReq   = payment.OrdersStatisticsReq
+	OrdersStatisticsResp  = payment.OrdersStatisticsResp
+	PaymentHistoryReq     = payment.PaymentHistoryReq
+	PaymentHistoryResp    = payment.PaymentHistoryResp
+	PaymentNotifyReq      = payment.PaymentNotifyReq
+	PaymentNotifyResp     = payment.PaymentNotifyResp
+	QueryPaymentReq       = payment.QueryPaymentReq
+	QueryPaymentResp      = payment.QueryPaymentResp
+	QuoteMembershipReq    = payment.QuoteMembershipReq
+	QuoteMembershipResp   = payment.QuoteMembershipResp
+	ReconcileBillReq      = payment.ReconcileBillReq
+	ReconcileBillResp     = payment.ReconcileBillResp
+	RedeemGiftCodeReq     = payment.RedeemGiftCodeReq
+	RedeemGiftCodeResp    = payment.RedeemGiftCodeResp
+	RefundPaymentReq      = payment.RefundPaymentReq
+	RefundPaymentResp     = payment.RefundPaymentResp
+	RepayOrderReq         = payment.RepayOrderReq
+	RepayOrderResp        = payment.RepayOrderResp
+	ReplayNotifyReq       = payment.ReplayNotifyReq
+	ReplayNotifyResp      = payment.ReplayNotifyResp
+	ValidateCouponReq     = payment.ValidateCouponReq
+	ValidateCouponResp    = payment.ValidateCouponResp
 
 	Payment interface {
 		// 创建捐赠订单
@@ -72,6 +86,20 @@ type (
 		GoodsList(ctx context.Context, in *GoodsListReq, opts ...grpc.CallOption) (*GoodsListResp, error)
 		// 商品详情
 		Goods(ctx context.Context, in *GoodsReq, opts ...grpc.CallOption) (*GoodsResp, error)
+		// 重放已保存的支付通知
+		ReplayNotify(ctx context.Context, in *ReplayNotifyReq, opts ...grpc.CallOption) (*ReplayNotifyResp, error)
+		// 导入支付宝账单并与本地订单对账
+		ReconcileBill(ctx context.Context, in *ReconcileBillReq, opts ...grpc.CallOption) (*ReconcileBillResp, error)
+		// 校验优惠码并计算优惠后金额
+		ValidateCoupon(ctx context.Context, in *ValidateCouponReq, opts ...grpc.CallOption) (*ValidateCouponResp, error)
+		// 会员开通/续费/升级报价
+		QuoteMembership(ctx context.Context, in *QuoteMembershipReq, opts ...grpc.CallOption) (*QuoteMembershipResp, error)
+		// 兑换礼品码
+		RedeemGiftCode(ctx context.Context, in *RedeemGiftCodeReq, opts ...grpc.CallOption) (*RedeemGiftCodeResp, error)
+		// 获取已购商品的限时下载地址
+		GoodsDownload(ctx context.Context, in *GoodsDownloadReq, opts ...grpc.CallOption) (*GoodsDownloadResp, error)
+		// 发表商品评价（仅限已购买用户）
+		GoodsReviewCreate(ctx context.Context, in *GoodsReviewCreateReq, opts ...grpc.CallOption) (*GoodsReviewCreateResp, error)
 	}
 
 	defaultPayment struct {
@@ -168,3 +196,45 @@ func (m *defaultPayment) Goods(ctx context.Context, in *GoodsReq, opts ...grpc.C
 	client := payment.NewPaymentClient(m.cli.Conn())
 	return client.Goods(ctx, in, opts...)
 }
+
+// 重放已保存的支付通知
+func (m *defaultPayment) ReplayNotify(ctx context.Context, in *ReplayNotifyReq, opts ...grpc.CallOption) (*ReplayNotifyResp, error) {
+	client := payment.NewPaymentClient(m.cli.Conn())
+	return client.ReplayNotify(ctx, in, opts...)
+}
+
+// 导入支付宝账单并与本地订单对账
+func (m *defaultPayment) ReconcileBill(ctx context.Context, in *ReconcileBillReq, opts ...grpc.CallOption) (*ReconcileBillResp, error) {
+	client := payment.NewPaymentClient(m.cli.Conn())
+	return client.ReconcileBill(ctx, in, opts...)
+}
+
+// 校验优惠码并计算优惠后金额
+func (m *defaultPayment) ValidateCoupon(ctx context.Context, in *ValidateCouponReq, opts ...grpc.CallOption) (*ValidateCouponResp, error) {
+	client := payment.NewPaymentClient(m.cli.Conn())
+	return client.ValidateCoupon(ctx, in, opts...)
+}
+
+// 会员开通/续费/升级报价
+func (m *defaultPayment) QuoteMembership(ctx context.Context, in *QuoteMembershipReq, opts ...grpc.CallOption) (*QuoteMembershipResp, error) {
+	client := payment.NewPaymentClient(m.cli.Conn())
+	return client.QuoteMembership(ctx, in, opts...)
+}
+
+// 兑换礼品码
+func (m *defaultPayment) RedeemGiftCode(ctx context.Context, in *RedeemGiftCodeReq, opts ...grpc.CallOption) (*RedeemGiftCodeResp, error) {
+	client := payment.NewPaymentClient(m.cli.Conn())
+	return client.RedeemGiftCode(ctx, in, opts...)
+}
+
+// 获取已购商品的限时下载地址
+func (m *defaultPayment) GoodsDownload(ctx context.Context, in *GoodsDownloadReq, opts ...grpc.CallOption) (*GoodsDownloadResp, error) {
+	client := payment.NewPaymentClient(m.cli.Conn())
+	return client.GoodsDownload(ctx, in, opts...)
+}
+
+// 发表商品评价（仅限已购买用户）
+func (m *defaultPayment) GoodsReviewCreate(ctx context.Context, in *GoodsReviewCreateReq, opts ...grpc.CallOption) (*GoodsReviewCreateResp, error) {
+	client := payment.NewPaymentClient(m.cli.Conn())
+	return client.GoodsReviewCreate(ctx, in, opts...)
+}
@@ -19,20 +19,27 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Payment_Donate_FullMethodName           = "/payment.Payment/Donate"
-	Payment_DonateNotify_FullMethodName     = "/payment.Payment/DonateNotify"
-	Payment_CreatePayment_FullMethodName    = "/payment.Payment/CreatePayment"
-	Payment_RepayOrder_FullMethodName       = "/payment.Payment/RepayOrder"
-	Payment_QueryPayment_FullMethodName     = "/payment.Payment/QueryPayment"
-	Payment_RefundPayment_FullMethodName    = "/payment.Payment/RefundPayment"
-	Payment_PaymentHistory_FullMethodName   = "/payment.Payment/PaymentHistory"
-	Payment_OrdersStatistics_FullMethodName = "/payment.Payment/OrdersStatistics"
-	Payment_PaymentNotify_FullMethodName    = "/payment.Payment/PaymentNotify"
-	Payment_ClosePayment_FullMethodName     = "/payment.Payment/ClosePayment"
-	Payment_CancelPayment_FullMethodName    = "/payment.Payment/CancelPayment"
-	Payment_DeletePayment_FullMethodName    = "/payment.Payment/DeletePayment"
-	Payment_GoodsList_FullMethodName        = "/payment.Payment/GoodsList"
-	Payment_Goods_FullMethodName            = "/payment.Payment/Goods"
+	Payment_Donate_FullMethodName            = "/payment.Payment/Donate"
+	Payment_DonateNotify_FullMethodName      = "/payment.Payment/DonateNotify"
+	Payment_CreatePayment_FullMethodName     = "/payment.Payment/CreatePayment"
+	Payment_RepayOrder_FullMethodName        = "/payment.Payment/RepayOrder"
+	Payment_QueryPayment_FullMethodName      = "/payment.Payment/QueryPayment"
+	Payment_RefundPayment_FullMethodName     = "/payment.Payment/RefundPayment"
+	Payment_PaymentHistory_FullMethodName    = "/payment.Payment/PaymentHistory"
+	Payment_OrdersStatistics_FullMethodName  = "/payment.Payment/OrdersStatistics"
+	Payment_PaymentNotify_FullMethodName     = "/payment.Payment/PaymentNotify"
+	Payment_ClosePayment_FullMethodName      = "/payment.Payment/ClosePayment"
+	Payment_CancelPayment_FullMethodName     = "/payment.Payment/CancelPayment"
+	Payment_DeletePayment_FullMethodName     = "/payment.Payment/DeletePayment"
+	Payment_GoodsList_FullMethodName         = "/payment.Payment/GoodsList"
+	Payment_Goods_FullMethodName             = "/payment.Payment/Goods"
+	Payment_ReplayNotify_FullMethodName      = "/payment.Payment/ReplayNotify"
+	Payment_ReconcileBill_FullMethodName     = "/payment.Payment/ReconcileBill"
+	Payment_ValidateCoupon_FullMethodName    = "/payment.Payment/ValidateCoupon"
+	Payment_QuoteMembership_FullMethodName   = "/payment.Payment/QuoteMembership"
+	Payment_RedeemGiftCode_FullMethodName    = "/payment.Payment/RedeemGiftCode"
+	Payment_GoodsDownload_FullMethodName     = "/payment.Payment/GoodsDownload"
+	Payment_GoodsReviewCreate_FullMethodName = "/payment.Payment/GoodsReviewCreate"
 )
 
 // PaymentClient is the client API for Payment service.
@@ -67,6 +74,20 @@ type PaymentClient interface {
 	GoodsList(ctx context.Context, in *GoodsListReq, opts ...grpc.CallOption) (*GoodsListResp, error)
 	// 商品详情
 	Goods(ctx context.Context, in *GoodsReq, opts ...grpc.CallOption) (*GoodsResp, error)
+	// 重放已保存的支付通知
+	ReplayNotify(ctx context.Context, in *ReplayNotifyReq, opts ...grpc.CallOption) (*ReplayNotifyResp, error)
+	// 导入支付宝账单并与本地订单对账
+	ReconcileBill(ctx context.Context, in *ReconcileBillReq, opts ...grpc.CallOption) (*ReconcileBillResp, error)
+	// 校验优惠码并计算优惠后金额
+	ValidateCoupon(ctx context.Context, in *ValidateCouponReq, opts ...grpc.CallOption) (*ValidateCouponResp, error)
+	// 会员开通/续费/升级报价
+	QuoteMembership(ctx context.Context, in *QuoteMembershipReq, opts ...grpc.CallOption) (*QuoteMembershipResp, error)
+	// 兑换礼品码
+	RedeemGiftCode(ctx context.Context, in *RedeemGiftCodeReq, opts ...grpc.CallOption) (*RedeemGiftCodeResp, error)
+	// 获取已购商品的限时下载地址
+	GoodsDownload(ctx context.Context, in *GoodsDownloadReq, opts ...grpc.CallOption) (*GoodsDownloadResp, error)
+	// 发表商品评价（仅限已购买用户）
+	GoodsReviewCreate(ctx context.Context, in *GoodsReviewCreateReq, opts ...grpc.CallOption) (*GoodsReviewCreateResp, error)
 }
 
 type paymentClient struct {
@@ -203,6 +224,69 @@ func (c *paymentClient) Goods(ctx context.Context, in *GoodsReq, opts ...grpc.Ca
 	return out, nil
 }
 
+func (c *paymentClient) ReplayNotify(ctx context.Context, in *ReplayNotifyReq, opts ...grpc.CallOption) (*ReplayNotifyResp, error) {
+	out := new(ReplayNotifyResp)
+	err := c.cc.Invoke(ctx, Payment_ReplayNotify_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentClient) ReconcileBill(ctx context.Context, in *ReconcileBillReq, opts ...grpc.CallOption) (*ReconcileBillResp, error) {
+	out := new(ReconcileBillResp)
+	err := c.cc.Invoke(ctx, Payment_ReconcileBill_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentClient) ValidateCoupon(ctx context.Context, in *ValidateCouponReq, opts ...grpc.CallOption) (*ValidateCouponResp, error) {
+	out := new(ValidateCouponResp)
+	err := c.cc.Invoke(ctx, Payment_ValidateCoupon_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentClient) QuoteMembership(ctx context.Context, in *QuoteMembershipReq, opts ...grpc.CallOption) (*QuoteMembershipResp, error) {
+	out := new(QuoteMembershipResp)
+	err := c.cc.Invoke(ctx, Payment_QuoteMembership_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentClient) RedeemGiftCode(ctx context.Context, in *RedeemGiftCodeReq, opts ...grpc.CallOption) (*RedeemGiftCodeResp, error) {
+	out := new(RedeemGiftCodeResp)
+	err := c.cc.Invoke(ctx, Payment_RedeemGiftCode_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentClient) GoodsDownload(ctx context.Context, in *GoodsDownloadReq, opts ...grpc.CallOption) (*GoodsDownloadResp, error) {
+	out := new(GoodsDownloadResp)
+	err := c.cc.Invoke(ctx, Payment_GoodsDownload_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentClient) GoodsReviewCreate(ctx context.Context, in *GoodsReviewCreateReq, opts ...grpc.CallOption) (*GoodsReviewCreateResp, error) {
+	out := new(GoodsReviewCreateResp)
+	err := c.cc.Invoke(ctx, Payment_GoodsReviewCreate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PaymentServer is the server API for Payment service.
 // All implementations must embed UnimplementedPaymentServer
 // for forward compatibility
@@ -235,6 +319,20 @@ type PaymentServer interface {
 	GoodsList(context.Context, *GoodsListReq) (*GoodsListResp, error)
 	// 商品详情
 	Goods(context.Context, *GoodsReq) (*GoodsResp, error)
+	// 重放已保存的支付通知
+	ReplayNotify(context.Context, *ReplayNotifyReq) (*ReplayNotifyResp, error)
+	// 导入支付宝账单并与本地订单对账
+	ReconcileBill(context.Context, *ReconcileBillReq) (*ReconcileBillResp, error)
+	// 校验优惠码并计算优惠后金额
+	ValidateCoupon(context.Context, *ValidateCouponReq) (*ValidateCouponResp, error)
+	// 会员开通/续费/升级报价
+	QuoteMembership(context.Context, *QuoteMembershipReq) (*QuoteMembershipResp, error)
+	// 兑换礼品码
+	RedeemGiftCode(context.Context, *RedeemGiftCodeReq) (*RedeemGiftCodeResp, error)
+	// 获取已购商品的限时下载地址
+	GoodsDownload(context.Context, *GoodsDownloadReq) (*GoodsDownloadResp, error)
+	// 发表商品评价（仅限已购买用户）
+	GoodsReviewCreate(context.Context, *GoodsReviewCreateReq) (*GoodsReviewCreateResp, error)
 	mustEmbedUnimplementedPaymentServer()
 }
 
@@ -284,6 +382,27 @@ func (UnimplementedPaymentServer) GoodsList(context.Context, *GoodsListReq) (*Go
 func (UnimplementedPaymentServer) Goods(context.Context, *GoodsReq) (*GoodsResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Goods not implemented")
 }
+func (UnimplementedPaymentServer) ReplayNotify(context.Context, *ReplayNotifyReq) (*ReplayNotifyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplayNotify not implemented")
+}
+func (UnimplementedPaymentServer) ReconcileBill(context.Context, *ReconcileBillReq) (*ReconcileBillResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReconcileBill not implemented")
+}
+func (UnimplementedPaymentServer) ValidateCoupon(context.Context, *ValidateCouponReq) (*ValidateCouponResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateCoupon not implemented")
+}
+func (UnimplementedPaymentServer) QuoteMembership(context.Context, *QuoteMembershipReq) (*QuoteMembershipResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuoteMembership not implemented")
+}
+func (UnimplementedPaymentServer) RedeemGiftCode(context.Context, *RedeemGiftCodeReq) (*RedeemGiftCodeResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedeemGiftCode not implemented")
+}
+func (UnimplementedPaymentServer) GoodsDownload(context.Context, *GoodsDownloadReq) (*GoodsDownloadResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GoodsDownload not implemented")
+}
+func (UnimplementedPaymentServer) GoodsReviewCreate(context.Context, *GoodsReviewCreateReq) (*GoodsReviewCreateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GoodsReviewCreate not implemented")
+}
 func (UnimplementedPaymentServer) mustEmbedUnimplementedPaymentServer() {}
 
 // UnsafePaymentServer may be embedded to opt out of forward compatibility for this service.
@@ -549,6 +668,132 @@ func _Payment_Goods_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Payment_ReplayNotify_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayNotifyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServer).ReplayNotify(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Payment_ReplayNotify_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServer).ReplayNotify(ctx, req.(*ReplayNotifyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Payment_ReconcileBill_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReconcileBillReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServer).ReconcileBill(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Payment_ReconcileBill_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServer).ReconcileBill(ctx, req.(*ReconcileBillReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Payment_ValidateCoupon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateCouponReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServer).ValidateCoupon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Payment_ValidateCoupon_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServer).ValidateCoupon(ctx, req.(*ValidateCouponReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Payment_QuoteMembership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuoteMembershipReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServer).QuoteMembership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Payment_QuoteMembership_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServer).QuoteMembership(ctx, req.(*QuoteMembershipReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Payment_RedeemGiftCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedeemGiftCodeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServer).RedeemGiftCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Payment_RedeemGiftCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServer).RedeemGiftCode(ctx, req.(*RedeemGiftCodeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Payment_GoodsDownload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GoodsDownloadReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServer).GoodsDownload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Payment_GoodsDownload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServer).GoodsDownload(ctx, req.(*GoodsDownloadReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Payment_GoodsReviewCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GoodsReviewCreateReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServer).GoodsReviewCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Payment_GoodsReviewCreate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServer).GoodsReviewCreate(ctx, req.(*GoodsReviewCreateReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Payment_ServiceDesc is the grpc.ServiceDesc for Payment service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -612,6 +857,34 @@ var Payment_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Goods",
 			Handler:    _Payment_Goods_Handler,
 		},
+		{
+			MethodName: "ReplayNotify",
+			Handler:    _Payment_ReplayNotify_Handler,
+		},
+		{
+			MethodName: "ReconcileBill",
+			Handler:    _Payment_ReconcileBill_Handler,
+		},
+		{
+			MethodName: "ValidateCoupon",
+			Handler:    _Payment_ValidateCoupon_Handler,
+		},
+		{
+			MethodName: "QuoteMembership",
+			Handler:    _Payment_QuoteMembership_Handler,
+		},
+		{
+			MethodName: "RedeemGiftCode",
+			Handler:    _Payment_RedeemGiftCode_Handler,
+		},
+		{
+			MethodName: "GoodsDownload",
+			Handler:    _Payment_GoodsDownload_Handler,
+		},
+		{
+			MethodName: "GoodsReviewCreate",
+			Handler:    _Payment_GoodsReviewCreate_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "payment.proto",
@@ -34,4 +34,13 @@ type Config struct {
 		Domain    string `json:",env=Domain"`
 		Region    string `json:",env=Region"`
 	}
+
+	LegacyPasswordReset LegacyPasswordResetConfig // 旧版密码强制重置任务
+}
+
+// LegacyPasswordResetConfig 旧版 AES 密码强制重置任务配置
+type LegacyPasswordResetConfig struct {
+	Disabled bool   `json:",optional"`
+	Interval int    `json:",default=3600"` // 扫描间隔（秒）
+	Deadline string `json:",optional"`     // 升级截止日期（2006-01-02），之后仍未登录升级的账号标记为强制重置，为空时不处理
 }
@@ -0,0 +1,19 @@
+package job
+
+import (
+	"context"
+
+	"lxtian-blog/common/pkg/job"
+	userlogic "lxtian-blog/rpc/user/internal/logic/user"
+	"lxtian-blog/rpc/user/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/service"
+)
+
+// NewLegacyPasswordResetJob 定时将超过升级截止日期仍未登录的旧版密码账号标记为强制重置
+func NewLegacyPasswordResetJob(svcCtx *svc.ServiceContext) service.Service {
+	return job.NewTickerJob("legacy password reset", svcCtx.Config.LegacyPasswordReset.Interval, func(ctx context.Context) error {
+		_, err := userlogic.NewLegacyPasswordResetLogic(ctx, svcCtx).FlagLegacyPasswords()
+		return err
+	})
+}
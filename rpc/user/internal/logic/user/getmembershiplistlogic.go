@@ -3,6 +3,7 @@ package userlogic
 import (
 	"context"
 	"encoding/json"
+	"lxtian-blog/common/pkg/utils"
 	"lxtian-blog/common/repository/user_repo"
 	"lxtian-blog/rpc/user/internal/svc"
 	"lxtian-blog/rpc/user/user"
@@ -56,9 +57,9 @@ func (l *GetMembershipListLogic) GetMembershipList(in *user.GetMembershipListReq
 		respList = append(respList, &user.MembershipType{
 			Id:            mt.ID,
 			Name:          mt.Name,
-			Price:         mt.Price,
-			OriginalPrice: mt.OriginalPrice,
-			Discount:      mt.Discount,
+			Price:         utils.FormatAmount(mt.Price),
+			OriginalPrice: utils.FormatAmount(mt.OriginalPrice),
+			Discount:      utils.FormatAmount(mt.Discount),
 			Period:        mt.Period,
 			Popular:       mt.Popular == 1,
 			Permissions:   permissions,
@@ -0,0 +1,65 @@
+package userlogic
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"lxtian-blog/common/repository/user_repo"
+	"lxtian-blog/rpc/user/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// 每批标记的账号数量
+const legacyPasswordResetBatchSize = 500
+
+// LegacyPasswordResetLogic 旧版 AES 密码清理：截止日期后仍未登录升级的账号清空密文并标记为强制重置
+type LegacyPasswordResetLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+	userRepo user_repo.TxyUserRepository
+}
+
+func NewLegacyPasswordResetLogic(ctx context.Context, svcCtx *svc.ServiceContext) *LegacyPasswordResetLogic {
+	return &LegacyPasswordResetLogic{
+		ctx:      ctx,
+		svcCtx:   svcCtx,
+		Logger:   logx.WithContext(ctx),
+		userRepo: user_repo.NewTxyUserRepository(svcCtx.DB),
+	}
+}
+
+// FlagLegacyPasswords 截止日期之后分批标记旧版密码账号，返回本次标记数量
+func (l *LegacyPasswordResetLogic) FlagLegacyPasswords() (int64, error) {
+	deadline := l.svcCtx.Config.LegacyPasswordReset.Deadline
+	if deadline == "" {
+		return 0, nil
+	}
+	deadlineAt, err := time.ParseInLocation("2006-01-02", deadline, time.Local)
+	if err != nil {
+		return 0, fmt.Errorf("invalid legacy password reset deadline %q: %w", deadline, err)
+	}
+	if time.Now().Before(deadlineAt) {
+		return 0, nil
+	}
+
+	var flagged int64
+	for {
+		affected, err := l.userRepo.FlagLegacyPasswordReset(l.ctx, legacyPasswordResetBatchSize)
+		if err != nil {
+			l.Errorf("Failed to flag legacy passwords: %v", err)
+			return flagged, err
+		}
+		flagged += affected
+		if affected < legacyPasswordResetBatchSize {
+			break
+		}
+	}
+
+	if flagged > 0 {
+		l.Infof("Flagged legacy password accounts for reset: count=%d", flagged)
+	}
+	return flagged, nil
+}
@@ -2,13 +2,13 @@ package userlogic
 
 import (
 	"context"
-	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"lxtian-blog/common/model"
 	"lxtian-blog/common/pkg/define"
 	"lxtian-blog/common/pkg/oauth"
+	"lxtian-blog/common/pkg/password"
 	"lxtian-blog/common/pkg/utils"
 	"lxtian-blog/common/repository/user_repo"
 	"lxtian-blog/rpc/user/internal/svc"
@@ -108,7 +108,7 @@ func (l *LoginLogic) Login(in *user.LoginReq) (*user.LoginResp, error) {
 			membershipInfo = nil
 		}
 		// 组装用户json数据
-		res, err := utils.ConvertToLowercaseJSONTags(txyUser)
+		res, err := userInfoMap(&txyUser)
 		if err != nil {
 			return nil, err
 		}
@@ -141,19 +141,24 @@ func (l *LoginLogic) accountLogin(in *user.LoginReq) (*string, error) {
 	if txyUser.ID == 0 {
 		return nil, errors.New("用户名错误")
 	}
-	// 数据库密码解密
-	decodedBytes, err := base64.StdEncoding.DecodeString(txyUser.Password)
-	if err != nil {
-		return nil, err
+	// 旧版密码长期未登录升级，已被清空，需要重置后才能登录
+	if txyUser.PasswordResetRequired == 1 {
+		return nil, errors.New("密码已失效，请重置密码后登录")
 	}
-	decryptedText, err := utils.Decrypt(decodedBytes)
+	// 校验密码
+	ok, needsRehash, err := password.Verify(in.Password, txyUser.Password)
 	if err != nil {
-		return nil, err
+		l.Errorf("Failed to verify password: userId=%d, err=%v", txyUser.ID, err)
+		return nil, errors.New("密码错误！")
 	}
-	if in.Password != decryptedText {
+	if !ok {
 		return nil, errors.New("密码错误！")
 	}
-	res, err := utils.ConvertToLowercaseJSONTags(txyUser)
+	// 旧版 AES 密码或过时参数的哈希，登录成功后升级为新哈希
+	if needsRehash {
+		l.upgradePassword(&txyUser, in.Password)
+	}
+	res, err := userInfoMap(&txyUser)
 	if err != nil {
 		return nil, err
 	}
@@ -165,14 +170,42 @@ func (l *LoginLogic) accountLogin(in *user.LoginReq) (*string, error) {
 	return &str, nil
 }
 
-func (l *LoginLogic) getPassword(password string) string {
-	plaintext := []byte(password)
-	encryptPassword, err := utils.Encrypt(plaintext)
+// upgradePassword 将用户密码升级为新哈希，失败只记录日志，不影响本次登录
+func (l *LoginLogic) upgradePassword(txyUser *model.TxyUser, plain string) {
+	hash, err := password.Hash(plain)
+	if err != nil {
+		l.Errorf("Failed to hash password: userId=%d, err=%v", txyUser.ID, err)
+		return
+	}
+	userRepo := user_repo.NewTxyUserRepository(l.svcCtx.DB)
+	upgraded, err := userRepo.UpgradePassword(l.ctx, uint64(txyUser.ID), txyUser.Password, hash)
+	if err != nil {
+		l.Errorf("Failed to upgrade password: userId=%d, err=%v", txyUser.ID, err)
+		return
+	}
+	if upgraded {
+		txyUser.Password = hash
+		l.Infof("Upgraded password hash: userId=%d", txyUser.ID)
+	}
+}
+
+// userInfoMap 转换返回给客户端的用户信息，去掉密码哈希等敏感字段
+func userInfoMap(txyUser *model.TxyUser) (map[string]interface{}, error) {
+	res, err := utils.ConvertToLowercaseJSONTags(txyUser)
+	if err != nil {
+		return nil, err
+	}
+	delete(res, "password")
+	delete(res, "password_reset_required")
+	return res, nil
+}
+
+func (l *LoginLogic) getPassword(plain string) string {
+	hash, err := password.Hash(plain)
 	if err != nil {
 		return ""
 	}
-	passwordStr := base64.StdEncoding.EncodeToString(encryptPassword)
-	return passwordStr
+	return hash
 }
 
 // oauthLogin OAuth社会化登录统一处理
@@ -222,7 +255,7 @@ func (l *LoginLogic) oauthLogin(in *user.LoginReq, loginType uint32) (*user.Logi
 	}
 
 	// 返回用户信息
-	res, err := utils.ConvertToLowercaseJSONTags(txyUser)
+	res, err := userInfoMap(&txyUser)
 	if err != nil {
 		return nil, err
 	}
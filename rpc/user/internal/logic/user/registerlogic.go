@@ -2,12 +2,11 @@ package userlogic
 
 import (
 	"context"
-	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"gorm.io/gorm"
 	"lxtian-blog/common/model"
-	encrypt "lxtian-blog/common/pkg/utils"
+	"lxtian-blog/common/pkg/password"
 	"lxtian-blog/rpc/user/internal/svc"
 	"lxtian-blog/rpc/user/user"
 	"time"
@@ -43,12 +42,10 @@ func (l *RegisterLogic) Register(in *user.RegisterReq) (*user.RegisterResp, erro
 		return nil, errors.New("用户名已存在！")
 	}
 	// 插入数据
-	plaintext := []byte(in.Password)
-	encryptPassword, err := encrypt.Encrypt(plaintext)
+	passwordStr, err := password.Hash(in.Password)
 	if err != nil {
 		return nil, err
 	}
-	passwordStr := base64.StdEncoding.EncodeToString(encryptPassword)
 	now := time.Now()
 	userRes := model.TxyUser{
 		Username:  &in.Username,
@@ -9,6 +9,7 @@ import (
 	"os"
 
 	"lxtian-blog/rpc/user/internal/config"
+	"lxtian-blog/rpc/user/internal/job"
 	"lxtian-blog/rpc/user/internal/server/user"
 	"lxtian-blog/rpc/user/internal/svc"
 	"lxtian-blog/rpc/user/user"
@@ -41,8 +42,14 @@ func main() {
 			reflection.Register(grpcServer)
 		}
 	})
-	defer s.Stop()
+
+	group := service.NewServiceGroup()
+	defer group.Stop()
+	group.Add(s)
+	if !c.LegacyPasswordReset.Disabled {
+		group.Add(job.NewLegacyPasswordResetJob(ctx))
+	}
 
 	fmt.Printf("Starting rpc server at %s...\n", c.ListenOn)
-	s.Start()
+	group.Start()
 }
@@ -959,9 +959,9 @@ type MembershipType struct {
 
 	Id            int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	Name          string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Price         float64  `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"`
-	OriginalPrice float64  `protobuf:"fixed64,4,opt,name=original_price,json=originalPrice,proto3" json:"original_price,omitempty"`
-	Discount      float64  `protobuf:"fixed64,5,opt,name=discount,proto3" json:"discount,omitempty"`
+	Price         string   `protobuf:"bytes,3,opt,name=price,proto3" json:"price,omitempty"`                                      // 价格（元，如"88.88"）
+	OriginalPrice string   `protobuf:"bytes,4,opt,name=original_price,json=originalPrice,proto3" json:"original_price,omitempty"` // 原价
+	Discount      string   `protobuf:"bytes,5,opt,name=discount,proto3" json:"discount,omitempty"`                                // 折扣价
 	Period        string   `protobuf:"bytes,6,opt,name=period,proto3" json:"period,omitempty"`
 	Popular       bool     `protobuf:"varint,7,opt,name=popular,proto3" json:"popular,omitempty"`
 	Permissions   []string `protobuf:"bytes,8,rep,name=permissions,proto3" json:"permissions,omitempty"`
@@ -1014,25 +1014,25 @@ func (x *MembershipType) GetName() string {
 	return ""
 }
 
-func (x *MembershipType) GetPrice() float64 {
+func (x *MembershipType) GetPrice() string {
 	if x != nil {
 		return x.Price
 	}
-	return 0
+	return ""
 }
 
-func (x *MembershipType) GetOriginalPrice() float64 {
+func (x *MembershipType) GetOriginalPrice() string {
 	if x != nil {
 		return x.OriginalPrice
 	}
-	return 0
+	return ""
 }
 
-func (x *MembershipType) GetDiscount() float64 {
+func (x *MembershipType) GetDiscount() string {
 	if x != nil {
 		return x.Discount
 	}
-	return 0
+	return ""
 }
 
 func (x *MembershipType) GetPeriod() string {
@@ -1116,10 +1116,10 @@ type UpgradeMembershipReq struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId           uint64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	ToMembershipType string  `protobuf:"bytes,2,opt,name=to_membership_type,json=toMembershipType,proto3" json:"to_membership_type,omitempty"` // 目标会员类型 monthly, quarterly, yearly
-	OrderId          int64   `protobuf:"varint,3,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`                             // 订单ID
-	Amount           float64 `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`                                             // 支付金额
+	UserId           uint64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ToMembershipType string `protobuf:"bytes,2,opt,name=to_membership_type,json=toMembershipType,proto3" json:"to_membership_type,omitempty"` // 目标会员类型 monthly, quarterly, yearly
+	OrderId          int64  `protobuf:"varint,3,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`                             // 订单ID
+	Amount           string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`                                               // 支付金额（元，如"88.88"）
 }
 
 func (x *UpgradeMembershipReq) Reset() {
@@ -1175,11 +1175,11 @@ func (x *UpgradeMembershipReq) GetOrderId() int64 {
 	return 0
 }
 
-func (x *UpgradeMembershipReq) GetAmount() float64 {
+func (x *UpgradeMembershipReq) GetAmount() string {
 	if x != nil {
 		return x.Amount
 	}
-	return 0
+	return ""
 }
 
 type UpgradeMembershipResp struct {
@@ -1342,11 +1342,11 @@ var file_user_proto_rawDesc = []byte{
 	0x70, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
 	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69,
-	0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12,
+	0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12,
 	0x25, 0x0a, 0x0e, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x70, 0x72, 0x69, 0x63,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61,
 	0x6c, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75,
 	0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x06, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x6f,
 	0x70, 0x75, 0x6c, 0x61, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x6f, 0x70,
@@ -1367,7 +1367,7 @@ var file_user_proto_rawDesc = []byte{
 	0x62, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x54, 0x79, 0x70, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6f,
 	0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6f,
 	0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x7c,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x7c,
 	0x0a, 0x15, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73,
 	0x68, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
 	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
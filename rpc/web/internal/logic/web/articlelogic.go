@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"lxtian-blog/common/constant"
 	model "lxtian-blog/common/pkg/model/mongo"
 	"lxtian-blog/common/pkg/model/mysql"
 	redisutil "lxtian-blog/common/pkg/redis"
@@ -51,9 +52,13 @@ func (l *ArticleLogic) Article(in *web.ArticleReq) (*web.ArticleResp, error) {
 	cachedArticle, err := l.getArticleFromCache(l.ctx, articleID)
 	if err == nil && cachedArticle != "" {
 		logx.Infof("从缓存获取文章详情: %d", articleID)
-		// 将缓存数据转换为JSON字符串
+		// 缓存中为全文，按当前用户校验会员专享内容
+		data, err := applyContentAccessJSON(l.ctx, l.svcCtx, cachedArticle, constant.ContentTypeArticle, int64(in.Id), in.UserId)
+		if err != nil {
+			return nil, err
+		}
 		return &web.ArticleResp{
-			Data: cachedArticle,
+			Data: data,
 		}, nil
 	}
 
@@ -144,8 +149,12 @@ func (l *ArticleLogic) Article(in *web.ArticleReq) (*web.ArticleResp, error) {
 		}
 	}()
 
+	data, err := applyContentAccessJSON(l.ctx, l.svcCtx, string(jsonData), constant.ContentTypeArticle, int64(in.Id), in.UserId)
+	if err != nil {
+		return nil, err
+	}
 	return &web.ArticleResp{
-		Data: string(jsonData),
+		Data: data,
 	}, nil
 }
 
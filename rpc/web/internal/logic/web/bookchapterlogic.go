@@ -3,6 +3,7 @@ package weblogic
 import (
 	"context"
 	"encoding/json"
+	"lxtian-blog/common/constant"
 	"lxtian-blog/common/pkg/model/mysql"
 	"lxtian-blog/common/pkg/redis"
 	"lxtian-blog/common/pkg/utils"
@@ -33,9 +34,13 @@ func (l *BookChapterLogic) BookChapter(in *web.BookChapterReq) (*web.BookChapter
 	// 1. 查缓存
 	cacheStr, err := l.svcCtx.Rds.Get(cacheKey)
 	if err == nil && cacheStr != "" {
-		// 缓存命中，直接返回
+		// 缓存命中，按当前用户校验会员专享内容后返回
+		data, err := applyContentAccessJSON(l.ctx, l.svcCtx, cacheStr, constant.ContentTypeChapter, int64(in.Id), in.UserId)
+		if err != nil {
+			return nil, err
+		}
 		return &web.BookChapterResp{
-			Data: cacheStr,
+			Data: data,
 		}, nil
 	}
 
@@ -60,7 +65,11 @@ func (l *BookChapterLogic) BookChapter(in *web.BookChapterReq) (*web.BookChapter
 	if err != nil {
 		return nil, err
 	}
+	data, err := applyContentAccessJSON(l.ctx, l.svcCtx, string(jsonData), constant.ContentTypeChapter, int64(in.Id), in.UserId)
+	if err != nil {
+		return nil, err
+	}
 	return &web.BookChapterResp{
-		Data: string(jsonData),
+		Data: data,
 	}, nil
 }
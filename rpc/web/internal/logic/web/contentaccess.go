@@ -0,0 +1,126 @@
+package weblogic
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	redisutil "lxtian-blog/common/pkg/redis"
+	"lxtian-blog/common/repository/user_repo"
+	"lxtian-blog/common/repository/web_repo"
+	"lxtian-blog/rpc/web/internal/svc"
+
+	"github.com/leiphp/unit-go-sdk/pkg/gconv"
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+)
+
+const (
+	// 会员专享内容对无权限用户展示的预览字数
+	premiumPreviewRunes = 300
+	// 用户会员权限缓存时间（秒），会员到期时间更早时以到期时间为准
+	membershipPermissionCacheSeconds = 600
+	// 无权限用户看到的升级提示
+	premiumUpgradeHint = "该内容为会员专享，开通或升级会员后可阅读全文"
+)
+
+// applyContentAccess 校验会员专享内容的阅读权限，无权限时将 content 截断为预览并附带升级提示
+// 内容详情缓存中保存的是全文，每次请求按当前用户单独校验
+func applyContentAccess(ctx context.Context, svcCtx *svc.ServiceContext, detail map[string]interface{}, contentType string, contentId int64, userId int64) error {
+	permissionKey, err := web_repo.NewContentPermissionRepository(svcCtx.DB).GetPermissionKey(ctx, contentType, contentId)
+	if err != nil {
+		return err
+	}
+	detail["required_permission"] = permissionKey
+	detail["locked"] = false
+	if permissionKey == "" {
+		return nil
+	}
+
+	keys, err := userPermissionKeys(ctx, svcCtx, userId)
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		if key == permissionKey {
+			return nil
+		}
+	}
+
+	content := []rune(gconv.String(detail["content"]))
+	if len(content) > premiumPreviewRunes {
+		content = content[:premiumPreviewRunes]
+	}
+	detail["content"] = string(content)
+	detail["locked"] = true
+	detail["upgrade_hint"] = premiumUpgradeHint
+	return nil
+}
+
+// userPermissionKeys 获取用户当前有效会员的权限标识，未登录或非有效会员返回空，结果按用户缓存
+func userPermissionKeys(ctx context.Context, svcCtx *svc.ServiceContext, userId int64) ([]string, error) {
+	if userId == 0 {
+		return nil, nil
+	}
+
+	cacheKey := redisutil.ReturnRedisKey(redisutil.UserMembershipPermission, userId)
+	if svcCtx.Rds != nil {
+		cacheData, err := svcCtx.Rds.GetCtx(ctx, cacheKey)
+		if err != nil && err != redis.Nil {
+			logx.WithContext(ctx).Errorf("获取会员权限缓存失败: userId=%d, err=%v", userId, err)
+		}
+		if cacheData != "" {
+			var keys []string
+			if err := json.Unmarshal([]byte(cacheData), &keys); err == nil {
+				return keys, nil
+			}
+		}
+	}
+
+	keys := make([]string, 0)
+	ttl := membershipPermissionCacheSeconds
+	membership, err := user_repo.NewUserMembershipRepository(svcCtx.DB, svcCtx.Rds).GetActiveMembershipByUserId(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+	if membership != nil && gconv.Bool(membership["is_valid"]) {
+		endTime, err := time.ParseInLocation("2006-01-02 15:04:05", gconv.String(membership["end_time"]), time.Local)
+		if err == nil && endTime.After(time.Now()) {
+			keys, err = user_repo.NewMembershipPermissionRepository(svcCtx.DB).GetPermissionKeysByTypeId(ctx, gconv.Uint64(membership["type_id"]))
+			if err != nil {
+				return nil, err
+			}
+			if remaining := int(time.Until(endTime).Seconds()); remaining < ttl {
+				ttl = remaining + 1
+			}
+		}
+	}
+
+	if svcCtx.Rds != nil {
+		if data, err := json.Marshal(keys); err == nil {
+			if err := svcCtx.Rds.SetexCtx(ctx, cacheKey, string(data), ttl); err != nil {
+				logx.WithContext(ctx).Errorf("设置会员权限缓存失败: userId=%d, err=%v", userId, err)
+			}
+		}
+	}
+	return keys, nil
+}
+
+// applyContentAccessJSON 对 JSON 格式的内容详情做阅读权限校验，内容不存在时原样返回
+func applyContentAccessJSON(ctx context.Context, svcCtx *svc.ServiceContext, data string, contentType string, contentId int64, userId int64) (string, error) {
+	var detail map[string]interface{}
+	if err := json.Unmarshal([]byte(data), &detail); err != nil {
+		return "", err
+	}
+	if len(detail) == 0 {
+		return data, nil
+	}
+	if err := applyContentAccess(ctx, svcCtx, detail, contentType, contentId, userId); err != nil {
+		return "", err
+	}
+	jsonData, err := json.Marshal(detail)
+	if err != nil {
+		return "", err
+	}
+	return string(jsonData), nil
+}
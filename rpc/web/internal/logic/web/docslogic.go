@@ -3,6 +3,7 @@ package weblogic
 import (
 	"context"
 	"encoding/json"
+	"lxtian-blog/common/constant"
 	"lxtian-blog/common/repository/web_repo"
 	"lxtian-blog/rpc/web/internal/svc"
 	"lxtian-blog/rpc/web/web"
@@ -71,6 +72,10 @@ func (l *DocsLogic) Docs(in *web.DocsReq) (*web.DocsResp, error) {
 			// 如果 tags 为空或不存在，设置为空数组
 			docMap["tags"] = []string{}
 		}
+		// 校验会员专享内容的阅读权限
+		if err := applyContentAccess(l.ctx, l.svcCtx, docMap, constant.ContentTypeDocs, in.Id, in.UserId); err != nil {
+			return nil, err
+		}
 		// 将文档数据转换为JSON字符串
 		jsonData, err := json.Marshal(docMap)
 		if err != nil {
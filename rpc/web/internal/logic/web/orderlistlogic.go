@@ -26,7 +26,7 @@ func NewOrderListLogic(ctx context.Context, svcCtx *svc.ServiceContext) *OrderLi
 
 func (l *OrderListLogic) OrderList(in *web.OrderListReq) (*web.OrderListResp, error) {
 	where := map[string]interface{}{}
-	where["status"] = consts.PaymentStatusPaid
+	where["o.status"] = consts.PaymentStatusPaid
 	if in.Page == 0 {
 		in.Page = 1
 	}
@@ -37,7 +37,11 @@ func (l *OrderListLogic) OrderList(in *web.OrderListReq) (*web.OrderListResp, er
 	var results []map[string]interface{}
 	err := l.svcCtx.DB.
 		Table("txy_order as o").
-		Select("o.id,o.amount,o.pay_type,o.user_id,o.status,o.created_at,o.remark,u.nickname,u.head_img").
+		// 匿名捐赠不展示用户信息；未登录捐赠使用下单时填写的昵称
+		Select("o.id,o.amount,o.pay_type,o.status,o.created_at,o.remark,o.anonymous," +
+			"CASE WHEN o.anonymous = 1 THEN 0 ELSE o.user_id END AS user_id," +
+			"CASE WHEN o.anonymous = 1 THEN '匿名用户' ELSE COALESCE(NULLIF(u.nickname, ''), o.nickname) END AS nickname," +
+			"CASE WHEN o.anonymous = 1 THEN '' ELSE u.head_img END AS head_img").
 		Joins("left join txy_user u on u.id = o.user_id").
 		Where(where).
 		Limit(int(in.PageSize)).
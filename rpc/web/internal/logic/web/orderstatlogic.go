@@ -2,11 +2,14 @@ package weblogic
 
 import (
 	"context"
+	"encoding/json"
+	"lxtian-blog/common/pkg/utils"
 	"lxtian-blog/rpc/web/internal/consts"
 	"lxtian-blog/rpc/web/internal/svc"
 	"lxtian-blog/rpc/web/web"
 	"time"
 
+	"github.com/shopspring/decimal"
 	"github.com/zeromicro/go-zero/core/logx"
 )
 
@@ -25,14 +28,14 @@ func NewOrderStatLogic(ctx context.Context, svcCtx *svc.ServiceContext) *OrderSt
 }
 
 func (l *OrderStatLogic) OrderStat(in *web.OrderStatReq) (*web.OrderStatResp, error) {
-	var totalAmount float64
+	var totalAmount decimal.Decimal
 	var count int64
-	var monthAmount float64
+	var monthAmount decimal.Decimal
 
 	// 统计所有已支付订单的总金额和数量
 	var result struct {
-		TotalAmount float64 `gorm:"column:total_amount"`
-		Count       int64   `gorm:"column:count"`
+		TotalAmount decimal.Decimal `gorm:"column:total_amount"`
+		Count       int64           `gorm:"column:count"`
 	}
 	err := l.svcCtx.DB.Table("txy_order").
 		Where("status = ?", consts.PaymentStatusPaid).
@@ -58,9 +61,34 @@ func (l *OrderStatLogic) OrderStat(in *web.OrderStatReq) (*web.OrderStatResp, er
 		return nil, err
 	}
 
+	// 按月统计最近N个月已支付订单的金额和数量
+	months := int(in.Months)
+	if months <= 0 {
+		months = 12
+	}
+	var monthStats []struct {
+		Month  string          `gorm:"column:month" json:"month"`
+		Amount decimal.Decimal `gorm:"column:amount" json:"amount"`
+		Count  int64           `gorm:"column:count" json:"count"`
+	}
+	err = l.svcCtx.DB.Table("txy_order").
+		Where("status = ? AND created_at >= ?", consts.PaymentStatusPaid, startOfMonth.AddDate(0, 1-months, 0)).
+		Select("DATE_FORMAT(created_at, '%Y-%m') as month, COALESCE(SUM(amount), 0) as amount, COUNT(*) as count").
+		Group("month").
+		Order("month desc").
+		Scan(&monthStats).Error
+	if err != nil {
+		return nil, err
+	}
+	monthsJson, err := json.Marshal(monthStats)
+	if err != nil {
+		return nil, err
+	}
+
 	return &web.OrderStatResp{
-		TotalAmount: float32(totalAmount),
+		TotalAmount: utils.FormatAmount(totalAmount),
 		Count:       count,
-		MonthAmount: float32(monthAmount),
+		MonthAmount: utils.FormatAmount(monthAmount),
+		Months:      string(monthsJson),
 	}, nil
 }